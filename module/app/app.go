@@ -82,8 +82,11 @@ import (
 	"github.com/gorilla/mux"
 	gravityparams "github.com/peggyjv/gravity-bridge/module/v3/app/params"
 	v2 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v3"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity"
+	gravityante "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/ante"
 	gravityclient "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client"
+	gravitydocs "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client/docs"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/rakyll/statik/fs"
@@ -128,6 +131,7 @@ var (
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
 			gravityclient.ProposalHandler,
+			gravityclient.ForceSignerSetTxProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -151,11 +155,13 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		gravitytypes.RewardPoolName:    nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
-		distrtypes.ModuleName: true,
+		distrtypes.ModuleName:       true,
+		gravitytypes.RewardPoolName: true,
 	}
 
 	// verify app interface at compile time
@@ -412,7 +418,7 @@ func NewGravityApp(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
-		AddRoute(gravitytypes.RouterKey, gravity.NewCommunityPoolEthereumSpendProposalHandler(app.gravityKeeper))
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -584,13 +590,18 @@ func NewGravityApp(
 	app.MountTransientStores(tKeys)
 	app.MountMemoryStores(memKeys)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.accountKeeper,
-			BankKeeper:      app.bankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  nil,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := gravityante.NewAnteHandler(
+		gravityante.HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.accountKeeper,
+				BankKeeper:      app.bankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  nil,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			GravityKeeper:                  &app.gravityKeeper,
+			MaxFeeWaivedTxsPerBlock:        gravityante.DefaultMaxFeeWaivedTxsPerBlock,
+			MaxFeeWaivedTxsPerOrchestrator: gravityante.DefaultMaxFeeWaivedTxsPerOrchestrator,
 		},
 	)
 	if err != nil {
@@ -747,20 +758,25 @@ func (app *Gravity) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
-	// TODO: build the custom gravity swagger files and add here?
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
 }
 
-// RegisterSwaggerAPI registers swagger route with API Server
-// TODO: build the custom gravity swagger files and add here?
+// RegisterSwaggerAPI registers swagger route with API Server. The gravity
+// swagger is served at /swagger/gravity/query.swagger.json, next to the
+// Cosmos SDK swagger UI.
 func RegisterSwaggerAPI(ctx client.Context, rtr *mux.Router) {
 	statikFS, err := fs.New()
 	if err != nil {
 		panic(err)
 	}
 
+	rtr.Path("/swagger/gravity/query.swagger.json").HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(gravitydocs.SwaggerJSON)
+	})
+
 	staticServer := http.FileServer(statikFS)
 	rtr.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 }
@@ -840,4 +856,12 @@ func (app *Gravity) setupUpgradeHandlers() {
 			app.bankKeeper,
 		),
 	)
+
+	app.upgradeKeeper.SetUpgradeHandler(
+		v3.UpgradeName,
+		v3.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+		),
+	)
 }
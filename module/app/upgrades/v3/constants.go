@@ -0,0 +1,4 @@
+package v3
+
+// UpgradeName defines the on-chain upgrade name for the Gravity v3 upgrade
+const UpgradeName = "v3"
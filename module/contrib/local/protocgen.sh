@@ -11,15 +11,25 @@ for dir in $proto_dirs; do
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
 
-  # # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
-  # buf protoc \
-  # -I "proto" \
-  # -I "third_party/proto" \
-  # --grpc-gateway_out=logtostderr=true:. \
-  # $(find "${dir}" -maxdepth 1 -name '*.proto')
+  # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
+  buf protoc \
+  -I "proto" \
+  -I "third_party/proto" \
+  --grpc-gateway_out=logtostderr=true:. \
+  $(find "${dir}" -maxdepth 1 -name '*.proto')
 
 done
 
 # move proto files to the right places
 cp -r github.com/peggyjv/gravity-bridge/module/* ./
 rm -rf github.com
+
+# generate the swagger served by the API server for the gravity REST routes
+swagger_dir=$(mktemp -d)
+buf protoc \
+-I "proto" \
+-I "third_party/proto" \
+--swagger_out=logtostderr=true,fqn_for_swagger_name=true,simple_operation_ids=true:"${swagger_dir}" \
+proto/gravity/v1/query.proto
+cp "${swagger_dir}/gravity/v1/query.swagger.json" x/gravity/client/docs/
+rm -rf "${swagger_dir}"
@@ -29,6 +29,57 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	outgoingTxSlashing(ctx, k)
 	eventVoteRecordTally(ctx, k)
 	updateObservedEthereumHeight(ctx, k)
+	distributeRewardPool(ctx, k)
+	startBridgeStatsEpoch(ctx, k)
+	pruneEthereumEventVoteRecords(ctx, k)
+	pruneRemovedValidatorDelegateKeys(ctx, k)
+	pruneObservedSignerSetHistory(ctx, k)
+	pruneExecutedOutgoingTxs(ctx, k)
+	prunePastEthereumSignatureCheckpoints(ctx, k)
+}
+
+// prunePastEthereumSignatureCheckpoints deletes the checkpoints past the
+// retention window and raises the checkpoint floors over them
+func prunePastEthereumSignatureCheckpoints(ctx sdk.Context, k keeper.Keeper) {
+	k.PrunePastEthereumSignatureCheckpoints(ctx)
+}
+
+// pruneRemovedValidatorDelegateKeys deletes the delegate keys of validators
+// that no longer exist in the staking store
+func pruneRemovedValidatorDelegateKeys(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneRemovedValidatorDelegateKeys(ctx)
+}
+
+// pruneEthereumEventVoteRecords deletes the event vote records past the
+// retention window, it runs after any slashing that depends on them
+func pruneEthereumEventVoteRecords(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneEthereumEventVoteRecords(ctx)
+}
+
+// pruneExecutedOutgoingTxs deletes the executed outgoing txs archived before
+// the retention window
+func pruneExecutedOutgoingTxs(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneExecutedOutgoingTxs(ctx)
+}
+
+// pruneObservedSignerSetHistory deletes the observed signer sets that were
+// replaced before the retention window
+func pruneObservedSignerSetHistory(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneObservedSignerSetHistory(ctx)
+}
+
+// distributeRewardPool pays out the reward pool at the end of every epoch
+func distributeRewardPool(ctx sdk.Context, k keeper.Keeper) {
+	if epoch := k.GetParams(ctx).RewardPoolEpochBlocks; epoch > 0 && uint64(ctx.BlockHeight())%epoch == 0 {
+		k.DistributeRewardPool(ctx)
+	}
+}
+
+// startBridgeStatsEpoch resets the validator bridge stats at the end of every epoch
+func startBridgeStatsEpoch(ctx sdk.Context, k keeper.Keeper) {
+	if epoch := k.GetParams(ctx).ValidatorBridgeStatsEpochBlocks; epoch > 0 && uint64(ctx.BlockHeight())%epoch == 0 {
+		k.StartBridgeStatsEpoch(ctx)
+	}
 }
 
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
@@ -48,7 +99,7 @@ func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 
 		for _, c := range contracts {
 			// NOTE: this doesn't emit events which would be helpful for client processes
-			k.CreateBatchTx(ctx, common.HexToAddress(c), 100)
+			k.CreateBatchTx(ctx, common.HexToAddress(c), keeper.BatchTxSize)
 		}
 	}
 }
@@ -59,29 +110,49 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
+	// 3. If power change between validators of Current signer set and latest signer set request is above the
+	//    SignerSetTxPowerDiffThreshold param, or the latest signer set tx is SignerSetTxMaxAgeBlocks old, once it
+	//    is SignerSetTxMinBlocks old
+	// At most one signer set tx is created per block.
 	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
 	if latestSignerSetTx == nil {
-		k.CreateSignerSetTx(ctx)
+		k.ProduceSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_INITIAL)
 		return
 	}
 
+	params := k.GetParams(ctx)
 	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
 	blockHeight := uint64(ctx.BlockHeight())
-	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
+	age := blockHeight - latestSignerSetTx.Height
+	diff := k.GetSignerSetDiff(ctx)
+
+	reason := types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED
+	switch {
+	case age == 0:
+		// already created in this block
+	case lastUnbondingHeight == blockHeight:
+		reason = types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNBONDING
+	case age < params.SignerSetTxMinBlocks:
+		// held back until the latest signer set tx is old enough
+	case diff.PowerDiff.GT(diff.PowerDiffThreshold):
+		reason = types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE
+	case params.SignerSetTxMaxAgeBlocks != 0 && age >= params.SignerSetTxMaxAgeBlocks:
+		reason = types.SignerSetTxReason_SIGNER_SET_TX_REASON_MAX_AGE
+	}
 
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	shouldCreate := reason != types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
 		"lastUnbondingHeight", lastUnbondingHeight,
 		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
-		"powerDiff", powerDiff,
+		"latestSignerSetTx.Age", age,
+		"powerDiff", diff.PowerDiff,
 		"shouldCreate", shouldCreate,
 	)
 
 	if shouldCreate {
-		k.CreateSignerSetTx(ctx)
+		k.ProduceSignerSetTx(ctx, reason)
 	}
 }
 
@@ -93,61 +164,53 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	//
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
+	//
+	// A valset is also kept until a later one has been slashed over,
+	// outgoingTxSlashing reads the members of the valset current when each
+	// unslashed outgoing tx was created
 	lastObserved := k.GetLastObservedSignerSetTx(ctx)
+	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
 	currentBlock := uint64(ctx.BlockHeight())
 	tooEarly := currentBlock < params.SignedSignerSetTxsWindow
 	if lastObserved != nil && !tooEarly {
 		earliestToPrune := currentBlock - params.SignedSignerSetTxsWindow
-		for _, set := range k.GetSignerSetTxs(ctx) {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
+		signerSets := k.GetSignerSetTxs(ctx)
+		var lastSlashedNonce uint64
+		for _, set := range signerSets {
+			if set.Height <= lastSlashed && set.Nonce > lastSlashedNonce {
+				lastSlashedNonce = set.Nonce
+			}
+		}
+		for _, set := range signerSets {
+			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune && set.Nonce < lastSlashedNonce {
 				k.DeleteOutgoingTx(ctx, set.GetStoreIndex())
 			}
 		}
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
-// "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// Tally the attestations at the nonce following the last observed event and
+// "Observe" the one that has passed the threshold, if any. Repeat with the next
+// nonce until no attestation at the nonce passes the threshold.
+//
+// Only attestations in the pending index are visited: those which have not
+// been accepted and whose nonce has not been observed yet. There can be
+// several attestations at a nonce when validators disagree about what event
+// happened at that nonce, once one of them is observed the rest are dropped
+// from the index.
 func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetEthereumEventVoteRecordMapping(ctx)
-
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryEventVoteRecord(ctx, att)
+	for {
+		nonce := k.GetLastObservedEventNonce(ctx) + 1
+		for _, att := range k.GetPendingEthereumEventVoteRecords(ctx, nonce) {
+			k.TryEventVoteRecord(ctx, att)
+			if att.Accepted {
+				break
 			}
 		}
+
+		if k.GetLastObservedEventNonce(ctx) < nonce {
+			return
+		}
 	}
 }
 
@@ -225,10 +288,12 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
-// cleanupTimedOutBatchTxs deletes batches that have passed their expiration on Ethereum
+// cleanupTimedOutBatchTxs cancels batches that have passed their expiration on Ethereum
+// and returns their transactions to the pool
 // keep in mind several things when modifying this function
-// A) unlike nonces timeouts are not monotonically increasing, meaning batch 5 can have a later timeout than batch 6
-// this means that we MUST only cleanup a single batch at a time
+// A) unlike nonces timeouts are not monotonically increasing, meaning batch 5 can have a later timeout than batch 6,
+//
+//	which is why batches are found through the timeout index rather than by nonce
 //
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
@@ -238,22 +303,16 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 //	AND any deposit or withdraw has occurred to update the Ethereum block height.
 func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		btx, _ := otx.(*types.BatchTx)
-
-		if btx.Timeout < ethereumHeight {
-			k.CancelBatchTx(ctx, btx)
-		}
-
-		return false
-	})
+	for _, otx := range k.GetTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, ethereumHeight) {
+		k.CancelBatchTx(ctx, otx.(*types.BatchTx))
+	}
 }
 
-// cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum
+// cleanupTimedOutContractCallTxs cancels logic calls that have passed their expiration on Ethereum
 // keep in mind several things when modifying this function
-// A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6
+// A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6,
 //
-//	this means that we MUST only cleanup a single call at a time
+//	which is why calls are found through the timeout index rather than by nonce
 //
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
@@ -263,13 +322,9 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 //	AND any deposit or withdraw has occurred to update the Ethereum block height.
 func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		cctx, _ := otx.(*types.ContractCallTx)
-		if cctx.Timeout < ethereumHeight {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
-		}
-		return true
-	})
+	for _, otx := range k.GetTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, ethereumHeight) {
+		k.CancelContractCallTx(ctx, otx.(*types.ContractCallTx))
+	}
 }
 
 func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
@@ -335,12 +390,32 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
+	// with the signer sets capped by MaxSigners the validators left out of
+	// them are not expected to sign
+	var (
+		signerSets []*types.SignerSetTx
+		validators map[common.Address]sdk.ValAddress
+	)
+	if params.MaxSigners != 0 {
+		signerSets = k.GetSignerSetTxs(ctx)
+		validators = k.GetEthereumAddressValidators(ctx)
+	}
+
 	for _, otx := range usotxs {
 		// SLASH BONDED VALIDATORS who didn't sign batch txs
 		signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
+		var signers map[string]bool
+		if params.MaxSigners != 0 {
+			signers = outgoingTxSigners(signerSets, validators, otx)
+		}
 		for _, valInfo := range valInfos {
+			// Don't slash validators outside of the signer set
+			if signers != nil && !signers[valInfo.val.GetOperator().String()] {
+				continue
+			}
 			// Don't slash validators who joined after outgoingtx is created
 			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
+				k.IncrementExpectedConfirmations(ctx, valInfo.val.GetOperator())
 				if _, ok := signatures[valInfo.val.GetOperator().String()]; !ok {
 					if !valInfo.val.IsJailed() {
 						power := valInfo.val.ConsensusPower(k.PowerReduction)
@@ -369,11 +444,15 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 
 		if sstx, ok := otx.(*types.SignerSetTx); ok {
 			for _, valInfo := range unbondingValInfos {
+				if signers != nil && !signers[valInfo.val.GetOperator().String()] {
+					continue
+				}
 				// Only slash validators who joined after valset is created and they are
 				// unbonding and UNBOND_SLASHING_WINDOW didn't pass.
 				if valInfo.exist && valInfo.sigs.StartHeight < int64(sstx.Height) &&
 					valInfo.val.IsUnbonding() &&
 					sstx.Height < uint64(valInfo.val.UnbondingHeight)+params.UnbondSlashingSignerSetTxsWindow {
+					k.IncrementExpectedConfirmations(ctx, valInfo.val.GetOperator())
 					// check if validator has confirmed valset or not
 					if _, found := signatures[valInfo.val.GetOperator().String()]; !found {
 						if !valInfo.val.IsJailed() {
@@ -407,3 +486,47 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		k.SetLastSlashedOutgoingTxBlockHeight(ctx, otx.GetCosmosHeight())
 	}
 }
+
+// outgoingTxSigners returns the operator addresses of the validators in the
+// signer set tx that was current when otx was created. For a signer set tx
+// these are its own signers and the signers of the previous one, whose
+// signatures the contract checks to update to it. Signers are resolved to
+// their validator, including the keys being rotated out, so that a validator
+// rotating its key is still expected to sign. pruneSignerSetTxs keeps that
+// signer set tx until otx is slashed. When it is missing nonetheless, pruned
+// before the upgrade, nobody can be told to be in it and the result is empty.
+func outgoingTxSigners(signerSets []*types.SignerSetTx, validators map[common.Address]sdk.ValAddress, otx types.OutgoingTx) map[string]bool {
+	var current, previous *types.SignerSetTx
+	sstx, isSignerSet := otx.(*types.SignerSetTx)
+	if isSignerSet {
+		current = sstx
+	}
+	for _, set := range signerSets {
+		switch {
+		case isSignerSet:
+			if set.Nonce < sstx.Nonce && (previous == nil || set.Nonce > previous.Nonce) {
+				previous = set
+			}
+		case set.Height <= otx.GetCosmosHeight():
+			if current == nil || set.Nonce > current.Nonce {
+				current = set
+			}
+		}
+	}
+
+	signers := make(map[string]bool)
+	if current == nil {
+		return signers
+	}
+	for _, set := range []*types.SignerSetTx{current, previous} {
+		if set == nil {
+			continue
+		}
+		for _, signer := range set.Signers {
+			if val := validators[common.HexToAddress(signer.EthereumAddress)]; val != nil {
+				signers[val.String()] = true
+			}
+		}
+	}
+	return signers
+}
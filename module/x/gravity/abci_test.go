@@ -24,15 +24,16 @@ func TestSignerSetTxCreationIfNotAvailable(t *testing.T) {
 	gravity.BeginBlocker(ctx, gravityKeeper)
 	otx := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
 	require.NotNil(t, otx)
-	_, ok := otx.(*types.SignerSetTx)
+	signerSetTx, ok := otx.(*types.SignerSetTx)
 	require.True(t, ok)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_INITIAL, signerSetTx.Reason)
 	require.True(t, len(gravityKeeper.GetSignerSetTxs(ctx)) == 1)
 }
 
 func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
-	gravityKeeper.CreateSignerSetTx(ctx)
+	gravityKeeper.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 
 	input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	// begin unbonding
@@ -45,6 +46,7 @@ func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
 	gravity.BeginBlocker(input.Context, gravityKeeper)
 
 	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNBONDING, gravityKeeper.GetLatestSignerSetTx(ctx).Reason)
 }
 
 func TestSignerSetTxSlashing_SignerSetTxCreated_Before_ValidatorBonded(t *testing.T) {
@@ -54,7 +56,7 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_Before_ValidatorBonded(t *testin
 	pk := input.GravityKeeper
 	params := input.GravityKeeper.GetParams(ctx)
 
-	signerSet := pk.CreateSignerSetTx(ctx)
+	signerSet := pk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	height := uint64(ctx.BlockHeight()) - (params.SignedSignerSetTxsWindow + 1)
 	signerSet.Height = height
 	pk.SetOutgoingTx(ctx, signerSet)
@@ -74,7 +76,7 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 	params := input.GravityKeeper.GetParams(ctx)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedSignerSetTxsWindow) + 2)
-	signerSet := pk.CreateSignerSetTx(ctx)
+	signerSet := pk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	height := uint64(ctx.BlockHeight()) - (params.SignedSignerSetTxsWindow + 1)
 	signerSet.Height = height
 	pk.SetOutgoingTx(ctx, signerSet)
@@ -122,7 +124,7 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 
 	// Create signer set tx request
 	ctx = ctx.WithBlockHeight(signerSetTxHeight)
-	vs := gravityKeeper.CreateSignerSetTx(ctx)
+	vs := gravityKeeper.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	vs.Height = uint64(signerSetTxHeight)
 	vs.Nonce = uint64(signerSetTxHeight)
 	gravityKeeper.SetOutgoingTx(ctx, vs)
@@ -210,27 +212,239 @@ func TestBatchSlashing(t *testing.T) {
 	require.Equal(t, input.GravityKeeper.GetLastSlashedOutgoingTxBlockHeight(ctx), batch.Height)
 }
 
+func TestBatchSlashing_MaxSigners(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.MaxSigners = 3
+	keeper.InitGenesis(ctx, gk, types.GenesisState{Params: &params})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sstx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	require.Len(t, sstx.Signers, 3)
+	gk.SetLastSlashedOutgoingTxBlockHeight(ctx, sstx.Height)
+
+	// a validator rotating the key it has in the signer set is still expected to sign
+	var rotating int
+	for i, ethAddr := range keeper.EthAddrs {
+		if ethAddr.Hex() == sstx.Signers[0].EthereumAddress {
+			rotating = i
+		}
+	}
+	keeper.InitGenesis(ctx, gk, types.GenesisState{
+		Params: &params,
+		DelegateKeys: []*types.MsgDelegateKeys{{
+			ValidatorAddress:    keeper.ValAddrs[rotating].String(),
+			OrchestratorAddress: keeper.AccAddrs[rotating].String(),
+			EthereumAddress:     "0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09",
+			EthSignature:        []byte("unused"),
+		}},
+		EthereumKeyRotations: []types.EthereumKeyRotation{{
+			ValidatorAddress:       keeper.ValAddrs[rotating].String(),
+			OldEthereumAddress:     keeper.EthAddrs[rotating].Hex(),
+			OldOrchestratorAddress: keeper.AccAddrs[rotating].String(),
+			SignerSetNonce:         sstx.Nonce + 1,
+		}},
+	})
+
+	// the batch created after the signer set tx is left unsigned
+	height := ctx.BlockHeight() + 1
+	gk.SetOutgoingTx(ctx, &types.BatchTx{
+		BatchNonce:    1,
+		Transactions:  []*types.SendToEthereum{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Height:        uint64(height),
+	})
+
+	ctx = ctx.WithBlockHeight(height + int64(params.SignedBatchesWindow) + 1)
+	gravity.EndBlocker(ctx, gk)
+
+	// only the validators in the signer set are expected to sign
+	members := make(map[string]bool)
+	for _, signer := range sstx.Signers {
+		members[signer.EthereumAddress] = true
+	}
+	for i, val := range keeper.ValAddrs {
+		require.Equal(t, members[keeper.EthAddrs[i].Hex()], input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+}
+
+func TestBatchSlashing_MaxSignersPrunedSignerSet(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.MaxSigners = 3
+	keeper.InitGenesis(ctx, gk, types.GenesisState{Params: &params})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sstx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	gk.SetLastSlashedOutgoingTxBlockHeight(ctx, sstx.Height)
+
+	height := ctx.BlockHeight() + 1
+	gk.SetOutgoingTx(ctx, &types.BatchTx{
+		BatchNonce:    1,
+		Transactions:  []*types.SendToEthereum{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Height:        uint64(height),
+	})
+
+	// without the signer set tx the batch was created under nobody can be
+	// told to be in it, so nobody is slashed for it
+	gk.DeleteOutgoingTx(ctx, sstx.GetStoreIndex())
+	ctx = ctx.WithBlockHeight(height + int64(params.SignedBatchesWindow) + 1)
+	gravity.EndBlocker(ctx, gk)
+
+	for _, val := range keeper.ValAddrs {
+		require.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+	require.Equal(t, uint64(height), gk.GetLastSlashedOutgoingTxBlockHeight(ctx))
+}
+
+func TestBatchSlashing_MaxSignersLaterSignerSetObserved(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.MaxSigners = 3
+	params.SignedSignerSetTxsWindow = 2
+	keeper.InitGenesis(ctx, gk, types.GenesisState{Params: &params})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	sstx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	gk.SetLastSlashedOutgoingTxBlockHeight(ctx, sstx.Height)
+
+	height := ctx.BlockHeight() + 1
+	gk.SetOutgoingTx(ctx, &types.BatchTx{
+		BatchNonce:    1,
+		Transactions:  []*types.SendToEthereum{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Height:        uint64(height),
+	})
+
+	// a later signer set tx is observed before the batch can be slashed
+	ctx = ctx.WithBlockHeight(height + 1)
+	later := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		SignerSetTxNonce: later.Nonce,
+		EthereumHeight:   1,
+		Members:          later.Signers,
+	}))
+
+	// past the signed signer set txs window the signer set tx the batch was
+	// created under is kept until the batch is slashed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedSignerSetTxsWindow) + 1)
+	gravity.BeginBlocker(ctx, gk)
+	require.NotNil(t, gk.GetOutgoingTx(ctx, sstx.GetStoreIndex()))
+
+	ctx = ctx.WithBlockHeight(height + int64(params.SignedBatchesWindow) + 1)
+	gravity.EndBlocker(ctx, gk)
+
+	members := make(map[string]bool)
+	for _, signer := range sstx.Signers {
+		members[signer.EthereumAddress] = true
+	}
+	for i, val := range keeper.ValAddrs {
+		require.Equal(t, members[keeper.EthAddrs[i].Hex()], input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+
+	// once the later signer set tx is slashed over the earlier one is pruned
+	gk.SetLastSlashedOutgoingTxBlockHeight(ctx, later.Height)
+	gravity.BeginBlocker(ctx, gk)
+	require.Nil(t, gk.GetOutgoingTx(ctx, sstx.GetStoreIndex()))
+}
+
 func TestSignerSetTxEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
 
 	// Store a validator set with a power change as the most recent validator set
-	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	sstx := gravityKeeper.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	delta := float64(types.EthereumSigners(sstx.Signers).TotalPower()) * 0.05
 	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
 	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
 	gravityKeeper.SetOutgoingTx(ctx, sstx)
 
-	// BeginBlocker should set a new validator set
+	// BeginBlocker should set a new validator set in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	gravity.BeginBlocker(ctx, gravityKeeper)
 	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2)))
 	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE, gravityKeeper.GetLatestSignerSetTx(ctx).Reason)
+}
+
+func TestSignerSetTxCreationPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.SignerSetTxPowerDiffThreshold = sdk.NewDecWithPrec(1, 1)
+	params.SignerSetTxMinBlocks = 10
+	params.SignerSetTxMaxAgeBlocks = 100
+	keeper.InitGenesis(ctx, gk, types.GenesisState{Params: &params})
+
+	gravity.BeginBlocker(ctx, gk)
+	first := gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_INITIAL, first.Reason)
+
+	// a power change of 5% is now below the threshold
+	changePower := func(delta float64) {
+		sstx := gk.GetLatestSignerSetTx(ctx)
+		sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
+		sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
+		gk.SetOutgoingTx(ctx, sstx)
+	}
+	total := float64(types.EthereumSigners(first.Signers).TotalPower())
+	changePower(total * 0.05)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+	gravity.BeginBlocker(ctx, gk)
+	require.Equal(t, first.Nonce, gk.GetLatestSignerSetTxNonce(ctx))
+
+	// a larger one is held back until the latest signer set tx is old enough
+	changePower(total * 0.2)
+	sstx := gk.GetLatestSignerSetTx(ctx)
+	sstx.Height = uint64(ctx.BlockHeight())
+	gk.SetOutgoingTx(ctx, sstx)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	gravity.BeginBlocker(ctx, gk)
+	require.Equal(t, first.Nonce, gk.GetLatestSignerSetTxNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	gravity.BeginBlocker(ctx, gk)
+	latest := gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, first.Nonce+1, latest.Nonce)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE, latest.Reason)
+
+	// without any power change a signer set tx is created once the latest one is old
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 99)
+	gravity.BeginBlocker(ctx, gk)
+	require.Equal(t, latest.Nonce, gk.GetLatestSignerSetTxNonce(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.BeginBlocker(ctx, gk)
+	latest = gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, first.Nonce+2, latest.Nonce)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_MAX_AGE, latest.Reason)
+
+	// governance can force one right away, but still at most one per block
+	handler := gravity.NewGravityProposalHandler(gk)
+	require.NoError(t, handler(ctx, types.NewForceSignerSetTxProposal("force", "after the incident")))
+	forced := gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, latest.Nonce, forced.Nonce)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_GOVERNANCE, forced.Reason)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, handler(ctx, types.NewForceSignerSetTxProposal("force", "after the incident")))
+	forced = gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, latest.Nonce+1, forced.Nonce)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_GOVERNANCE, forced.Reason)
+	require.Len(t, gk.GetSignerSetTxs(ctx), 4)
 }
 
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.GravityKeeper
-	gk.CreateSignerSetTx(ctx)
+	gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	require.EqualValues(t, 1, len(gk.GetSignerSetTxs(ctx)))
 }
 
@@ -307,6 +521,37 @@ func TestBatchTxTimeout(t *testing.T) {
 	require.NotNil(t, gotThirdBatch)
 }
 
+func TestContractCallTxTimeout(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	scope := []byte("scope")
+
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 100)
+	c1 := gravityKeeper.CreateContractCallTx(ctx, 1, scope, common.Address{}, []byte{}, nil, nil)
+	c2 := gravityKeeper.CreateContractCallTx(ctx, 2, scope, common.Address{}, []byte{}, nil, nil)
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 200)
+	c3 := gravityKeeper.CreateContractCallTx(ctx, 3, scope, common.Address{}, []byte{}, nil, nil)
+	require.Less(t, c2.Timeout, c3.Timeout)
+
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, c2.Timeout+1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gravity.BeginBlocker(ctx, gravityKeeper)
+
+	// every expired call is canceled in the same block
+	require.Nil(t, gravityKeeper.GetOutgoingTx(ctx, c1.GetStoreIndex()))
+	require.Nil(t, gravityKeeper.GetOutgoingTx(ctx, c2.GetStoreIndex()))
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, c3.GetStoreIndex()))
+	require.Len(t, gravityKeeper.GetTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, c3.Timeout+1), 1)
+
+	var canceled int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeContractCallTxCanceled {
+			canceled++
+		}
+	}
+	require.Equal(t, 2, canceled)
+}
+
 func TestUpdateObservedEthereumHeight(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
@@ -0,0 +1,65 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options with the gravity keeper
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	GravityKeeper                  *keeper.Keeper
+	MaxFeeWaivedTxsPerBlock        uint64
+	MaxFeeWaivedTxsPerOrchestrator uint64
+}
+
+// NewAnteHandler returns the SDK's default AnteHandler with the
+// MempoolFeeDecorator replaced by an OrchestratorFeeDecorator. The fee
+// decorator runs after the signatures are verified and the sequence is
+// incremented so that only txs signed by the orchestrator count against the
+// fee waiver caps, a tx failing the fee check still leaves the check state
+// untouched since the ante handler's writes are discarded on error.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.GravityKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "gravity keeper is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewOrchestratorFeeDecorator(*options.GravityKeeper, options.MaxFeeWaivedTxsPerBlock, options.MaxFeeWaivedTxsPerOrchestrator),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+)
+
+const (
+	// DefaultMaxFeeWaivedTxsPerBlock is the number of fee-free txs accepted into
+	// the mempool per block across all orchestrators
+	DefaultMaxFeeWaivedTxsPerBlock = 500
+
+	// DefaultMaxFeeWaivedTxsPerOrchestrator is the number of fee-free txs
+	// accepted into the mempool per block from a single orchestrator
+	DefaultMaxFeeWaivedTxsPerOrchestrator = 10
+)
+
+// OrchestratorFeeDecorator waives the local minimum gas price for txs that
+// only carry mandatory bridge messages from registered orchestrators, see
+// keeper.MandatoryOrchestratorMsgsSigner. Every other tx, and every waivable
+// tx over the per-block caps, is checked by the wrapped MempoolFeeDecorator.
+//
+// Like the minimum gas price itself the waiver only applies to CheckTx, and
+// the caps are counted by this node for the block it is currently building on.
+type OrchestratorFeeDecorator struct {
+	keeper             keeper.Keeper
+	mempoolFee         ante.MempoolFeeDecorator
+	maxPerBlock        uint64
+	maxPerOrchestrator uint64
+
+	mtx     sync.Mutex
+	height  int64
+	total   uint64
+	waivers map[string]uint64
+}
+
+// NewOrchestratorFeeDecorator returns a new OrchestratorFeeDecorator with the given per-block caps
+func NewOrchestratorFeeDecorator(k keeper.Keeper, maxPerBlock, maxPerOrchestrator uint64) *OrchestratorFeeDecorator {
+	return &OrchestratorFeeDecorator{
+		keeper:             k,
+		mempoolFee:         ante.NewMempoolFeeDecorator(),
+		maxPerBlock:        maxPerBlock,
+		maxPerOrchestrator: maxPerOrchestrator,
+		waivers:            make(map[string]uint64),
+	}
+}
+
+func (ofd *OrchestratorFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !simulate {
+		if orchestrator := ofd.keeper.MandatoryOrchestratorMsgsSigner(ctx, tx.GetMsgs()); orchestrator != nil && ofd.waive(ctx.BlockHeight(), orchestrator) {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return ofd.mempoolFee.AnteHandle(ctx, tx, simulate, next)
+}
+
+// waive counts a fee waiver for the orchestrator, returning false if either
+// of the caps for the block has been reached
+func (ofd *OrchestratorFeeDecorator) waive(height int64, orchestrator sdk.AccAddress) bool {
+	ofd.mtx.Lock()
+	defer ofd.mtx.Unlock()
+
+	if height != ofd.height {
+		ofd.height = height
+		ofd.total = 0
+		ofd.waivers = make(map[string]uint64)
+	}
+
+	if ofd.total >= ofd.maxPerBlock || ofd.waivers[orchestrator.String()] >= ofd.maxPerOrchestrator {
+		return false
+	}
+
+	ofd.total++
+	ofd.waivers[orchestrator.String()]++
+	return true
+}
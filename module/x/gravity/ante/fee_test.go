@@ -0,0 +1,117 @@
+package ante
+
+import (
+	"testing"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestOrchestratorFeeDecorator_Caps(t *testing.T) {
+	var (
+		orch1 = sdk.AccAddress([]byte("orchestrator1_______"))
+		orch2 = sdk.AccAddress([]byte("orchestrator2_______"))
+		orch3 = sdk.AccAddress([]byte("orchestrator3_______"))
+	)
+
+	ofd := NewOrchestratorFeeDecorator(keeper.Keeper{}, 3, 2)
+
+	require.True(t, ofd.waive(1, orch1))
+	require.True(t, ofd.waive(1, orch1))
+	require.False(t, ofd.waive(1, orch1), "per orchestrator cap")
+	require.True(t, ofd.waive(1, orch2))
+	require.False(t, ofd.waive(1, orch3), "per block cap")
+
+	// the caps reset on the next block
+	require.True(t, ofd.waive(2, orch1))
+	require.True(t, ofd.waive(2, orch3))
+}
+
+func TestOrchestratorFeeDecorator_AnteHandle(t *testing.T) {
+	var (
+		env     = keeper.CreateTestEnv(t)
+		gk      = env.GravityKeeper
+		ctx     = env.Context.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.OneInt())))
+		priv    = secp256k1.GenPrivKey()
+		orch    = sdk.AccAddress(priv.PubKey().Address())
+		val     = sdk.ValAddress(orch)
+		txCfg   = authtx.NewTxConfig(env.Marshaler.(codec.ProtoCodecMarshaler), authtx.DefaultSignModes)
+		account = env.AccountKeeper.NewAccountWithAddress(ctx, orch)
+	)
+	env.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	env.AccountKeeper.SetAccount(ctx, account)
+	gk.StakingKeeper = keeper.NewStakingKeeperMock(val)
+	gk.SetOrchestratorValidatorAddress(ctx, val, orch)
+
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   env.AccountKeeper,
+			BankKeeper:      env.BankKeeper,
+			SignModeHandler: txCfg.SignModeHandler(),
+		},
+		GravityKeeper:                  &gk,
+		MaxFeeWaivedTxsPerBlock:        10,
+		MaxFeeWaivedTxsPerOrchestrator: 1,
+	})
+	require.NoError(t, err)
+
+	// heightVoteTx returns a fee-free height vote signed by the orchestrator,
+	// unsigned or with a corrupted signature
+	heightVoteTx := func(height uint64, sign, corrupt bool) sdk.Tx {
+		builder := txCfg.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&types.MsgEthereumHeightVote{EthereumHeight: height, Signer: orch.String()}))
+		builder.SetGasLimit(200000)
+		if !sign {
+			return builder.GetTx()
+		}
+
+		seq := env.AccountKeeper.GetAccount(ctx, orch).GetSequence()
+		signMode := txCfg.SignModeHandler().DefaultMode()
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: seq,
+		}))
+		sig, err := clienttx.SignWithPrivKey(signMode, authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: account.GetAccountNumber(),
+			Sequence:      seq,
+		}, builder, priv, txCfg, seq)
+		require.NoError(t, err)
+		if corrupt {
+			sig.Data.(*signing.SingleSignatureData).Signature[0] ^= 0xff
+		}
+		require.NoError(t, builder.SetSignatures(sig))
+		return builder.GetTx()
+	}
+
+	// runTx runs the ante handler the way CheckTx does, discarding its
+	// writes on error
+	runTx := func(tx sdk.Tx) error {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := anteHandler(cacheCtx, tx, false); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+
+	// neither an unsigned nor a forged tx uses up the orchestrator's waiver
+	require.Error(t, runTx(heightVoteTx(100, false, false)))
+	require.Error(t, runTx(heightVoteTx(100, true, true)))
+
+	require.NoError(t, runTx(heightVoteTx(100, true, false)))
+	require.ErrorIs(t, runTx(heightVoteTx(101, true, false)), sdkerrors.ErrInsufficientFee, "per orchestrator cap")
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -10,6 +11,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	flagSinceNonce        = "since-nonce"
+	flagUntilNonce        = "until-nonce"
+	flagTokenContract     = "token-contract"
+	flagInvalidationScope = "invalidation-scope"
+	flagMaxElements       = "max-elements"
 )
 
 func GetQueryCmd() *cobra.Command {
@@ -24,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdBatchTx(),
 		CmdBatchTxConfirmations(),
 		CmdBatchTxFees(),
+		CmdNextBatchPreview(),
 		CmdBatchTxs(),
 		CmdContractCallTx(),
 		CmdContractCallTxConfirmations(),
@@ -39,13 +50,30 @@ func GetQueryCmd() *cobra.Command {
 		CmdUnsignedBatchTxs(),
 		CmdUnsignedContractCallTxs(),
 		CmdUnsignedSignerSetTxs(),
+		CmdRelayableSignerSetTxs(),
+		CmdRelayableBatchTxs(),
+		CmdRelayableContractCallTxs(),
+		CmdRelayCalldata(),
+		CmdCheckpoint(),
 		CmdDenomToERC20(),
 		CmdUnbatchedSendToEthereums(),
+		CmdBatchedSendToEthereums(),
+		CmdSendToEthereumByID(),
 		CmdDelegateKeysByValidator(),
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
 		CmdLastObservedEthereumHeight(),
+		CmdEventVoteDisagreements(),
+		CmdSignerSetAtEthereumHeight(),
+		CmdObservedSignerSetHistory(),
+		CmdExecutedBatchTxs(),
+		CmdExecutedContractCallTxs(),
+		CmdBridgeHealth(),
+		CmdSignerSetDiff(),
+		CmdValidatorBridgeStats(),
+		CmdRewardPool(),
+		CmdValidatorRewards(),
 	)
 
 	return gravityQueryCmd
@@ -196,7 +224,16 @@ func CmdSignerSetTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{Pagination: pageReq})
+			sinceNonce, untilNonce, err := readNonceRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{
+				Pagination: pageReq,
+				SinceNonce: sinceNonce,
+				UntilNonce: untilNonce,
+			})
 			if err != nil {
 				return err
 			}
@@ -205,6 +242,7 @@ func CmdSignerSetTxs() *cobra.Command {
 		},
 	}
 
+	addNonceRangeFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "signer-set-txs")
 	return cmd
@@ -226,7 +264,22 @@ func CmdBatchTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq})
+			sinceNonce, untilNonce, err := readNonceRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
+				SinceNonce:    sinceNonce,
+				UntilNonce:    untilNonce,
+			})
 			if err != nil {
 				return err
 			}
@@ -235,6 +288,8 @@ func CmdBatchTxs() *cobra.Command {
 		},
 	}
 
+	addNonceRangeFlags(cmd)
+	cmd.Flags().String(flagTokenContract, "", "only return batches of this token contract")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "batch-txs")
 	return cmd
@@ -256,7 +311,22 @@ func CmdContractCallTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{Pagination: pageReq})
+			sinceNonce, untilNonce, err := readNonceRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			invalidationScope, err := cmd.Flags().GetString(flagInvalidationScope)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{
+				Pagination:        pageReq,
+				InvalidationScope: []byte(invalidationScope),
+				SinceNonce:        sinceNonce,
+				UntilNonce:        untilNonce,
+			})
 			if err != nil {
 				return err
 			}
@@ -265,6 +335,8 @@ func CmdContractCallTxs() *cobra.Command {
 		},
 	}
 
+	addNonceRangeFlags(cmd)
+	cmd.Flags().String(flagInvalidationScope, "", "only return calls of this invalidation scope")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract-call-txs")
 	return cmd
@@ -286,8 +358,14 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.SignerSetTxConfirmations(cmd.Context(), &types.SignerSetTxConfirmationsRequest{
 				SignerSetNonce: nonce,
+				Pagination:     pageReq,
 			})
 			if err != nil {
 				return err
@@ -298,6 +376,7 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signer-set-tx-ethereum-signatures")
 	return cmd
 }
 
@@ -322,9 +401,15 @@ func CmdBatchTxConfirmations() *cobra.Command {
 				return nil
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.BatchTxConfirmations(cmd.Context(), &types.BatchTxConfirmationsRequest{
 				BatchNonce:    nonce,
 				TokenContract: contractAddress,
+				Pagination:    pageReq,
 			})
 
 			if err != nil {
@@ -336,6 +421,7 @@ func CmdBatchTxConfirmations() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch-tx-ethereum-signatures")
 	return cmd
 }
 
@@ -358,9 +444,15 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.ContractCallTxConfirmations(cmd.Context(), &types.ContractCallTxConfirmationsRequest{
 				InvalidationNonce: invalidationNonce,
 				InvalidationScope: invalidationScope,
+				Pagination:        pageReq,
 			})
 
 			if err != nil {
@@ -372,6 +464,7 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-call-tx-ethereum-signatures")
 	return cmd
 }
 
@@ -391,8 +484,20 @@ func CmdUnsignedSignerSetTxs() *cobra.Command {
 				return err
 			}
 
+			sinceNonce, err := cmd.Flags().GetUint64(flagSinceNonce)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.UnsignedSignerSetTxs(cmd.Context(), &types.UnsignedSignerSetTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				SinceNonce: sinceNonce,
+				Pagination: pageReq,
 			})
 
 			if err != nil {
@@ -403,7 +508,9 @@ func CmdUnsignedSignerSetTxs() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagSinceNonce, 0, "only return transactions with a nonce greater than this one")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-signer-set-tx-ethereum-signatures")
 	return cmd
 }
 
@@ -423,8 +530,26 @@ func CmdUnsignedBatchTxs() *cobra.Command {
 				return err
 			}
 
+			sinceNonce, err := cmd.Flags().GetUint64(flagSinceNonce)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.UnsignedBatchTxs(cmd.Context(), &types.UnsignedBatchTxsRequest{
-				Address: address.String(),
+				Address:       address.String(),
+				SinceNonce:    sinceNonce,
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
 			})
 
 			if err != nil {
@@ -435,7 +560,10 @@ func CmdUnsignedBatchTxs() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagSinceNonce, 0, "only return transactions with a nonce greater than this one")
+	cmd.Flags().String(flagTokenContract, "", "only return batches of this token contract")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-batch-tx-ethereum-signatures")
 	return cmd
 }
 
@@ -455,8 +583,20 @@ func CmdUnsignedContractCallTxs() *cobra.Command {
 				return err
 			}
 
+			sinceNonce, err := cmd.Flags().GetUint64(flagSinceNonce)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.UnsignedContractCallTxs(cmd.Context(), &types.UnsignedContractCallTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				SinceNonce: sinceNonce,
+				Pagination: pageReq,
 			})
 
 			if err != nil {
@@ -467,24 +607,29 @@ func CmdUnsignedContractCallTxs() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagSinceNonce, 0, "only return transactions with a nonce greater than this one")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-contract-call-tx-ethereum-signatures")
 	return cmd
 }
 
-func CmdLatestSignerSetTx() *cobra.Command {
+func CmdRelayableSignerSetTxs() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "latest-signer-set-tx",
+		Use:   "relayable-signer-set-txs",
 		Args:  cobra.NoArgs,
-		Short: "query for the latest signer set from the chain",
+		Short: "query the signer set transactions signed by enough of the last observed signer set to be relayed, with their signatures ordered for Gravity.sol",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			req := &types.LatestSignerSetTxRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
-			res, err := queryClient.LatestSignerSetTx(cmd.Context(), req)
+			res, err := queryClient.RelayableSignerSetTxs(cmd.Context(), &types.RelayableSignerSetTxsRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -494,29 +639,27 @@ func CmdLatestSignerSetTx() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayable-signer-set-txs")
 	return cmd
 }
 
-func CmdLastSubmittedEthereumEvent() *cobra.Command {
+func CmdRelayableBatchTxs() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "last-submitted-ethereum-event [validator-or-orchestrator-acc-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query for the last event nonce that was submitted by a given validator",
+		Use:   "relayable-batch-txs",
+		Args:  cobra.NoArgs,
+		Short: "query the batch transactions signed by enough of the last observed signer set to be relayed, with their signatures ordered for Gravity.sol",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			address, err := sdk.AccAddressFromBech32(args[0])
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.LastSubmittedEthereumEvent(cmd.Context(), &types.LastSubmittedEthereumEventRequest{
-				Address: address.String(),
-			})
-
+			res, err := queryClient.RelayableBatchTxs(cmd.Context(), &types.RelayableBatchTxsRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -526,22 +669,27 @@ func CmdLastSubmittedEthereumEvent() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayable-batch-txs")
 	return cmd
 }
 
-// TODO: this looks broken
-func CmdBatchTxFees() *cobra.Command {
+func CmdRelayableContractCallTxs() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "batch-tx-fees",
+		Use:   "relayable-contract-call-txs",
 		Args:  cobra.NoArgs,
-		Short: "query amount of fees for any unrelayed batches",
+		Short: "query the contract call transactions signed by enough of the last observed signer set to be relayed, with their signatures ordered for Gravity.sol",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RelayableContractCallTxs(cmd.Context(), &types.RelayableContractCallTxsRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -551,34 +699,59 @@ func CmdBatchTxFees() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayable-contract-call-txs")
 	return cmd
 }
 
-func CmdERC20ToDenom() *cobra.Command {
+// relayCalldata is the relay calldata in the form Ethereum wallets take it
+type relayCalldata struct {
+	To   string `json:"to" yaml:"to"`
+	Data string `json:"data" yaml:"data"`
+}
+
+func printRelayCalldata(clientCtx client.Context, to string, calldata []byte) error {
+	return clientCtx.PrintObjectLegacy(relayCalldata{To: to, Data: "0x" + hex.EncodeToString(calldata)})
+}
+
+func CmdRelayCalldata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "erc20-to-denom [erc20]",
+		Use:                        "relay-calldata",
+		Short:                      "query the Gravity.sol calldata that relays an outgoing transaction with the signatures of the last observed signer set",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		CmdSignerSetTxRelayCalldata(),
+		CmdBatchTxRelayCalldata(),
+		CmdContractCallTxRelayCalldata(),
+	)
+
+	return cmd
+}
+
+func CmdSignerSetTxRelayCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set [nonce]",
 		Args:  cobra.ExactArgs(1),
-		Short: "given an erc20 contract address return the cosmos denom",
+		Short: "query the updateValset calldata for a signer set",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			contract, err := parseContractAddress(args[0])
+			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.ERC20ToDenom(cmd.Context(), &types.ERC20ToDenomRequest{
-				Erc20: contract,
-			})
-
+			res, err := queryClient.SignerSetTxRelayCalldata(cmd.Context(), &types.SignerSetTxRelayCalldataRequest{SignerSetNonce: nonce})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printRelayCalldata(clientCtx, res.BridgeEthereumAddress, res.Calldata)
 		},
 	}
 
@@ -586,31 +759,36 @@ func CmdERC20ToDenom() *cobra.Command {
 	return cmd
 }
 
-func CmdDenomToERC20Params() *cobra.Command {
+func CmdBatchTxRelayCalldata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "denom-to-erc20-params [denom]",
-		Args:  cobra.ExactArgs(1),
-		Short: "given a cosmos base denom return the correct erc20 name, symbol and decimals",
+		Use:   "batch [contract-address] [nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the submitBatch calldata for a batch",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			if err := sdk.ValidateDenom(args[0]); err != nil {
+			contractAddress, err := parseContractAddress(args[0])
+			if err != nil {
 				return err
 			}
 
-			req := &types.DenomToERC20ParamsRequest{
-				Denom: args[0],
+			nonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
 			}
 
-			res, err := queryClient.DenomToERC20Params(cmd.Context(), req)
+			res, err := queryClient.BatchTxRelayCalldata(cmd.Context(), &types.BatchTxRelayCalldataRequest{
+				TokenContract: contractAddress,
+				BatchNonce:    nonce,
+			})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printRelayCalldata(clientCtx, res.BridgeEthereumAddress, res.Calldata)
 		},
 	}
 
@@ -618,30 +796,31 @@ func CmdDenomToERC20Params() *cobra.Command {
 	return cmd
 }
 
-func CmdDenomToERC20() *cobra.Command {
+func CmdContractCallTxRelayCalldata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "denom-to-erc20 [denom]",
-		Args:  cobra.ExactArgs(1),
-		Short: "given a cosmos denom return an erc20 contract address",
+		Use:   "contract-call [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the submitLogicCall calldata for a contract call",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			if err := sdk.ValidateDenom(args[0]); err != nil {
+			invalidationNonce, err := parseNonce(args[1])
+			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.DenomToERC20(cmd.Context(), &types.DenomToERC20Request{
-				Denom: args[0],
+			res, err := queryClient.ContractCallTxRelayCalldata(cmd.Context(), &types.ContractCallTxRelayCalldataRequest{
+				InvalidationScope: []byte(args[0]),
+				InvalidationNonce: invalidationNonce,
 			})
-
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printRelayCalldata(clientCtx, res.BridgeEthereumAddress, res.Calldata)
 		},
 	}
 
@@ -649,70 +828,99 @@ func CmdDenomToERC20() *cobra.Command {
 	return cmd
 }
 
-func CmdUnbatchedSendToEthereums() *cobra.Command {
+// checkpoint is the checkpoint query response with its hashes in hex
+type checkpoint struct {
+	GravityID       string                  `json:"gravity_id" yaml:"gravity_id"`
+	Checkpoint      tmbytes.HexBytes        `json:"checkpoint" yaml:"checkpoint"`
+	SignatureDigest tmbytes.HexBytes        `json:"signature_digest" yaml:"signature_digest"`
+	Fields          []types.CheckpointField `json:"fields" yaml:"fields"`
+}
+
+func printCheckpoint(clientCtx client.Context, res *types.CheckpointResponse) error {
+	return clientCtx.PrintObjectLegacy(checkpoint{
+		GravityID:       res.GravityId,
+		Checkpoint:      res.Checkpoint,
+		SignatureDigest: res.SignatureDigest,
+		Fields:          res.Fields,
+	})
+}
+
+func CmdCheckpoint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unbatched-send-to-ethereums [sender-address]",
+		Use:                        "checkpoint",
+		Short:                      "query the checkpoint of an outgoing transaction, the digest its signatures are verified against and the fields it encodes",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		CmdSignerSetTxCheckpoint(),
+		CmdBatchTxCheckpoint(),
+		CmdContractCallTxCheckpoint(),
+	)
+
+	return cmd
+}
+
+func CmdSignerSetTxCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set [nonce]",
 		Args:  cobra.ExactArgs(1),
-		Short: "query all unbatched send to ethereum messages",
+		Short: "query the checkpoint of a signer set",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			sender, err := sdk.AccAddressFromBech32(args[0])
+			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
-				SenderAddress: sender.String(),
-				Pagination:    pageReq,
-			})
-
+			res, err := queryClient.Checkpoint(cmd.Context(), &types.CheckpointRequest{SignerSetNonce: nonce})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printCheckpoint(clientCtx, res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums")
 	return cmd
 }
 
-func CmdDelegateKeysByValidator() *cobra.Command {
+func CmdBatchTxCheckpoint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-keys-by-validator [validator-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query which public keys/addresses a validator has delegated to",
+		Use:   "batch [contract-address] [nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the checkpoint of a batch",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			contractAddress, err := parseContractAddress(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.DelegateKeysByValidator(cmd.Context(), &types.DelegateKeysByValidatorRequest{
-				ValidatorAddress: validatorAddress.String(),
-			})
+			nonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
 
+			res, err := queryClient.Checkpoint(cmd.Context(), &types.CheckpointRequest{
+				TokenContract: contractAddress,
+				BatchNonce:    nonce,
+			})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printCheckpoint(clientCtx, res)
 		},
 	}
 
@@ -720,29 +928,31 @@ func CmdDelegateKeysByValidator() *cobra.Command {
 	return cmd
 }
 
-func CmdDelegateKeysByEthereumSigner() *cobra.Command {
+func CmdContractCallTxCheckpoint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-keys-by-ethereum-signer [ethereum-signer]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query the valdiator and orchestartor keys for a given ethsigner",
+		Use:   "contract-call [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the checkpoint of a contract call",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			if !common.IsHexAddress(args[0]) {
-				return fmt.Errorf("address is not an etheruem address")
+			invalidationNonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
 			}
 
-			res, err := queryClient.DelegateKeysByEthereumSigner(cmd.Context(), &types.DelegateKeysByEthereumSignerRequest{
-				EthereumSigner: args[0],
+			res, err := queryClient.Checkpoint(cmd.Context(), &types.CheckpointRequest{
+				InvalidationScope: []byte(args[0]),
+				InvalidationNonce: invalidationNonce,
 			})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printCheckpoint(clientCtx, res)
 		},
 	}
 
@@ -750,26 +960,20 @@ func CmdDelegateKeysByEthereumSigner() *cobra.Command {
 	return cmd
 }
 
-func CmdDelegateKeysByOrchestrator() *cobra.Command {
+func CmdLatestSignerSetTx() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-keys-by-orchestrator [orchestrator-address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query the validator and eth signer keys for a given orchestrator address",
+		Use:   "latest-signer-set-tx",
+		Args:  cobra.NoArgs,
+		Short: "query for the latest signer set from the chain",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			orcAddr, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.DelegateKeysByOrchestrator(cmd.Context(), &types.DelegateKeysByOrchestratorRequest{
-				OrchestratorAddress: orcAddr.String(),
-			})
+			req := &types.LatestSignerSetTxRequest{}
 
+			res, err := queryClient.LatestSignerSetTx(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -782,42 +986,739 @@ func CmdDelegateKeysByOrchestrator() *cobra.Command {
 	return cmd
 }
 
-func CmdDelegateKeys() *cobra.Command {
+func CmdLastSubmittedEthereumEvent() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "all-delegate-keys",
-		Args:  cobra.NoArgs,
-		Short: "query all delegate keys tracked by the chain",
+		Use:   "last-submitted-ethereum-event [validator-or-orchestrator-acc-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query for the last event nonce that was submitted by a given validator",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.DelegateKeys(cmd.Context(), &types.DelegateKeysRequest{})
+			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
-		},
-	}
-
-	flags.AddQueryFlagsToCmd(cmd)
+			res, err := queryClient.LastSubmittedEthereumEvent(cmd.Context(), &types.LastSubmittedEthereumEventRequest{
+				Address: address.String(),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TODO: this looks broken
+func CmdBatchTxFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-tx-fees",
+		Args:  cobra.NoArgs,
+		Short: "query amount of fees for any unrelayed batches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{
+				TokenContract: tokenContract,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTokenContract, "", "only return the fees of batches of this token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch-tx-fees")
+	return cmd
+}
+
+func CmdNextBatchPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-batch-preview [token-contract]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the batch that would be created next for a token, without creating it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			maxElements, err := cmd.Flags().GetUint64(flagMaxElements)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.NextBatchPreview(cmd.Context(), &types.NextBatchPreviewRequest{
+				TokenContract: tokenContract,
+				MaxElements:   maxElements,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxElements, 0, "the most sends the batch may contain, defaults to the end blocker batch size")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC20ToDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-to-denom [erc20]",
+		Args:  cobra.ExactArgs(1),
+		Short: "given an erc20 contract address return the cosmos denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC20ToDenom(cmd.Context(), &types.ERC20ToDenomRequest{
+				Erc20: contract,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDenomToERC20Params() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-to-erc20-params [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "given a cosmos base denom return the correct erc20 name, symbol and decimals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			req := &types.DenomToERC20ParamsRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomToERC20Params(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDenomToERC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-to-erc20 [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "given a cosmos denom return an erc20 contract address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomToERC20(cmd.Context(), &types.DenomToERC20Request{
+				Denom: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnbatchedSendToEthereums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbatched-send-to-ethereums [sender-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query all unbatched send to ethereum messages",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
+				SenderAddress: sender.String(),
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTokenContract, "", "only return sends of this token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums")
+	return cmd
+}
+
+func CmdBatchedSendToEthereums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batched-send-to-ethereums [sender-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query all batched send to ethereum messages, paginating over batches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchedSendToEthereums(cmd.Context(), &types.BatchedSendToEthereumsRequest{
+				SenderAddress: sender.String(),
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagTokenContract, "", "only return sends of this token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batched-send-to-ethereums")
+	return cmd
+}
+
+func CmdSendToEthereumByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query a send to ethereum by id and the batch holding it, if any",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SendToEthereumByID(cmd.Context(), &types.SendToEthereumByIDRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeysByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-validator [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query which public keys/addresses a validator has delegated to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegateKeysByValidator(cmd.Context(), &types.DelegateKeysByValidatorRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeysByEthereumSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-ethereum-signer [ethereum-signer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the valdiator and orchestartor keys for a given ethsigner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("address is not an etheruem address")
+			}
+
+			res, err := queryClient.DelegateKeysByEthereumSigner(cmd.Context(), &types.DelegateKeysByEthereumSignerRequest{
+				EthereumSigner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeysByOrchestrator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-orchestrator [orchestrator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the validator and eth signer keys for a given orchestrator address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegateKeysByOrchestrator(cmd.Context(), &types.DelegateKeysByOrchestratorRequest{
+				OrchestratorAddress: orcAddr.String(),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-delegate-keys",
+		Args:  cobra.NoArgs,
+		Short: "query all delegate keys tracked by the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegateKeys(cmd.Context(), &types.DelegateKeysRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-delegate-keys")
+	return cmd
+}
+
+func CmdLastObservedEthereumHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-observed-ethereum-height",
+		Args:  cobra.NoArgs,
+		Short: "query the last observed ethereum and cosmos heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
-func CmdLastObservedEthereumHeight() *cobra.Command {
+func CmdEventVoteDisagreements() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "last-observed-ethereum-height",
+		Use:   "event-vote-disagreements",
 		Args:  cobra.NoArgs,
-		Short: "query the last observed ethereum and cosmos heights",
+		Short: "query the event nonces validators submitted competing ethereum events for",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EventVoteDisagreements(cmd.Context(), &types.EventVoteDisagreementsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "event-vote-disagreements")
+	return cmd
+}
+
+func CmdSignerSetAtEthereumHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-at-ethereum-height [ethereum-height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the observed signer set that controlled the bridge at an ethereum height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetAtEthereumHeight(cmd.Context(), &types.SignerSetAtEthereumHeightRequest{EthereumHeight: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdObservedSignerSetHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "observed-signer-set-history",
+		Args:  cobra.NoArgs,
+		Short: "query the history of signer sets observed on ethereum",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ObservedSignerSetHistory(cmd.Context(), &types.ObservedSignerSetHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "observed-signer-set-history")
+	return cmd
+}
+
+func CmdExecutedBatchTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-batch-txs",
+		Args:  cobra.NoArgs,
+		Short: "query the archived batch transactions executed on ethereum",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sinceNonce, untilNonce, err := readNonceRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := readTokenContractFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutedBatchTxs(cmd.Context(), &types.ExecutedBatchTxsRequest{
+				Pagination:    pageReq,
+				TokenContract: tokenContract,
+				SinceNonce:    sinceNonce,
+				UntilNonce:    untilNonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addNonceRangeFlags(cmd)
+	cmd.Flags().String(flagTokenContract, "", "only return batches of this token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-batch-txs")
+	return cmd
+}
+
+func CmdExecutedContractCallTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-contract-call-txs",
+		Args:  cobra.NoArgs,
+		Short: "query the archived contract call transactions executed on ethereum",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sinceNonce, untilNonce, err := readNonceRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			invalidationScope, err := cmd.Flags().GetString(flagInvalidationScope)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutedContractCallTxs(cmd.Context(), &types.ExecutedContractCallTxsRequest{
+				Pagination:        pageReq,
+				InvalidationScope: []byte(invalidationScope),
+				SinceNonce:        sinceNonce,
+				UntilNonce:        untilNonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addNonceRangeFlags(cmd)
+	cmd.Flags().String(flagInvalidationScope, "", "only return calls of this invalidation scope")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-contract-call-txs")
+	return cmd
+}
+
+func CmdValidatorBridgeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bridge-stats",
+		Args:  cobra.NoArgs,
+		Short: "query the bridge participation counters of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBridgeStats(cmd.Context(), &types.ValidatorBridgeStatsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-bridge-stats")
+	return cmd
+}
+
+func CmdRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Args:  cobra.NoArgs,
+		Short: "query the balance of the validator reward pool and the participation in the current epoch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardPool(cmd.Context(), &types.RewardPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the reward pool participation, accrued and distributed rewards of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRewards(cmd.Context(), &types.ValidatorRewardsRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
 			if err != nil {
 				return err
 			}
@@ -860,3 +1761,74 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func addNonceRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagSinceNonce, 0, "only return transactions with a nonce greater than this one")
+	cmd.Flags().Uint64(flagUntilNonce, 0, "only return transactions with a nonce up to this one")
+}
+
+func readNonceRangeFlags(cmd *cobra.Command) (sinceNonce, untilNonce uint64, err error) {
+	if sinceNonce, err = cmd.Flags().GetUint64(flagSinceNonce); err != nil {
+		return 0, 0, err
+	}
+	if untilNonce, err = cmd.Flags().GetUint64(flagUntilNonce); err != nil {
+		return 0, 0, err
+	}
+	return sinceNonce, untilNonce, nil
+}
+
+func readTokenContractFlag(cmd *cobra.Command) (string, error) {
+	tokenContract, err := cmd.Flags().GetString(flagTokenContract)
+	if err != nil || tokenContract == "" {
+		return "", err
+	}
+	return parseContractAddress(tokenContract)
+}
+
+func CmdBridgeHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-health",
+		Args:  cobra.NoArgs,
+		Short: "query the bridge health values and their severity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeHealth(cmd.Context(), &types.BridgeHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-diff",
+		Args:  cobra.NoArgs,
+		Short: "query the power changes between the current signer set and the latest signer set tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetDiff(cmd.Context(), &types.SignerSetDiffRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -31,6 +31,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEthereum(),
 		CmdCancelSendToEthereum(),
 		CmdSetDelegateKeys(),
+		CmdRotateEthereumKey(),
 	)
 
 	return gravityTxCmd
@@ -162,6 +163,59 @@ the validator's address and operator account current nonce.`,
 	return cmd
 }
 
+func CmdRotateEthereumKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-ethereum-key [validator-address] [orchestrator-address] [new-ethereum-address] [old-ethereum-signature] [new-ethereum-signature]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Rotate gravity delegate keys",
+		Long: `Replace a validator's Ethereum and orchestrator addresses. Both the current and
+the new Ethereum keys must sign over a binary Proto-encoded RotateEthereumKeySignMsg message.
+The current keys remain valid until the signer set containing the new Ethereum address
+is observed on Ethereum.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := parseContractAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			oldEthSig, err := hexutil.Decode(args[3])
+			if err != nil {
+				return err
+			}
+
+			newEthSig, err := hexutil.Decode(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateEthereumKey(valAddr, orcAddr, ethAddr, oldEthSig, newEthSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSubmitCommunityPoolEthereumSpendProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community-pool-ethereum-spend [proposal-file]",
@@ -248,3 +302,68 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func CmdSubmitForceSignerSetTxProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-signer-set-tx [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to create a new signer set tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to create a new signer set tx along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes a signer set tx
+of the current validator set is created right away, for instance to resume the bridge after an
+incident.
+
+Example:
+$ %s tx gov submit-proposal force-signer-set-tx <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Force Signer Set Tx",
+	"description": "Resume the bridge with the current validator set",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseForceSignerSetTxProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewForceSignerSetTxProposal(proposal.Title, proposal.Description)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
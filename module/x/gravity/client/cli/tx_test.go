@@ -32,3 +32,22 @@ func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.BridgeFee)
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+func TestParseForceSignerSetTxProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Force Signer Set Tx",
+  "description": "Resume the bridge with the current validator set",
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseForceSignerSetTxProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Force Signer Set Tx", proposal.Title)
+	require.Equal(t, "Resume the bridge with the current validator set", proposal.Description)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
@@ -22,3 +22,19 @@ func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseForceSignerSetTxProposal reads and parses a ForceSignerSetTxProposalForCLI from a file.
+func ParseForceSignerSetTxProposal(cdc codec.JSONCodec, proposalFile string) (types.ForceSignerSetTxProposalForCLI, error) {
+	proposal := types.ForceSignerSetTxProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,12 @@
+// Package docs embeds the swagger of the gravity REST routes, generated from
+// proto/gravity/v1/query.proto by contrib/local/protocgen.sh
+package docs
+
+import (
+	_ "embed"
+)
+
+// SwaggerJSON is the swagger of the gravity query service
+//
+//go:embed query.swagger.json
+var SwaggerJSON []byte
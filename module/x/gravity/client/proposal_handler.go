@@ -6,7 +6,9 @@ import (
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client/rest"
 )
 
-// ProposalHandler is the community Ethereum spend proposal handler.
+// ProposalHandler is the community Ethereum spend proposal handler, and
+// ForceSignerSetTxProposalHandler the force signer set tx proposal handler.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal, rest.ProposalRESTHandler)
+	ProposalHandler                 = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal, rest.ProposalRESTHandler)
+	ForceSignerSetTxProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitForceSignerSetTxProposal, rest.ForceSignerSetTxProposalRESTHandler)
 )
@@ -41,17 +41,28 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitBadSignatureEvidence:
+			res, err := msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRotateEthereumKey:
+			res, err := msgServer.RotateEthereumKey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
 
-func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
+// NewGravityProposalHandler returns the handler of the gravity governance proposals
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.ForceSignerSetTxProposal:
+			return k.HandleForceSignerSetTxProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
@@ -22,25 +22,22 @@ const BatchTxSize = 100
 //   - persist an OutgoingTx (BatchTx) object with an incrementing ID = nonce
 //   - emit an event
 func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	selectedStes := k.selectBatchTxs(ctx, contractAddress, maxElements)
+
 	// if there is a more profitable batch for this token type do not create a new batch
-	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
-		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
-			return nil
-		}
+	if !beatsLastBatch(k.getLastOutgoingBatchByTokenType(ctx, contractAddress), selectedStes) {
+		return nil
 	}
 
-	var selectedStes []*types.SendToEthereum
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
-		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
-		return len(selectedStes) == maxElements
-	})
-
 	// do not create batches that would contain no transactions, even if they are requested
 	if len(selectedStes) == 0 {
 		return nil
 	}
 
+	for _, ste := range selectedStes {
+		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
+	}
+
 	batch := &types.BatchTx{
 		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
 		Timeout:       k.getTimeoutHeight(ctx),
@@ -63,8 +60,9 @@ func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, m
 }
 
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It deletes all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
+// It deletes all the transactions in the batch, then cancels all earlier batches,
+// and moves the batch to the executed tx archive
+func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64, execution types.OutgoingTxExecution) {
 	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean batches",
@@ -81,40 +79,75 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		}
 		return false
 	})
+	k.fundRewardPoolFromBatchTx(ctx, batchTx)
+	k.archiveBatchTx(ctx, batchTx, execution)
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
 }
 
-// getBatchFeesByTokenType gets the fees the next batch of a given token type would
+// GetBatchFeesByTokenType gets the fees the next batch of a given token type would
 // have if created. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
-func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
-	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
-		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
+func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
+	return sumBatchFees(k.selectBatchTxs(ctx, tokenContractAddr, maxElements))
+}
+
+// selectBatchTxs returns the unbatched sends to Ethereum of a token the next batch
+// would contain, highest fee first, without removing them from the pool
+func (k Keeper) selectBatchTxs(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) (out []*types.SendToEthereum) {
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(ste *types.SendToEthereum) bool {
+		out = append(out, ste)
+		return len(out) == maxElements
 	})
+	return out
+}
 
-	return feeAmount
+// beatsLastBatch reports whether a batch of the sends would replace the last
+// unexecuted batch of their token, which it must pay more fees than
+func beatsLastBatch(lastBatch *types.BatchTx, stes []*types.SendToEthereum) bool {
+	return lastBatch == nil || lastBatch.GetFees().LT(sumBatchFees(stes))
 }
 
-// GetBatchFeesByTokenType gets the fees the next batch of a given token type would
-// have if created. This info is both presented to relayers for the purpose of determining
-// when to request batches and also used by the batch creation process to decide not to create
-// a new batch
-func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
+func sumBatchFees(stes []*types.SendToEthereum) sdk.Int {
 	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
-		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
-	})
+	for _, ste := range stes {
+		feeAmount = feeAmount.Add(ste.Erc20Fee.Amount)
+	}
 	return feeAmount
 }
 
+// GetNextBatchPreview returns the batch CreateBatchTx would create for the token
+// in this block, without writing any state
+func (k Keeper) GetNextBatchPreview(ctx sdk.Context, contractAddress common.Address, maxElements int) types.NextBatchPreviewResponse {
+	selectedStes := k.selectBatchTxs(ctx, contractAddress, maxElements)
+	batch := types.BatchTx{
+		BatchNonce:    k.getLastOutgoingBatchNonce(ctx) + 1,
+		Timeout:       k.getTimeoutHeight(ctx),
+		Transactions:  selectedStes,
+		TokenContract: contractAddress.Hex(),
+		Height:        uint64(ctx.BlockHeight()),
+	}
+
+	res := types.NextBatchPreviewResponse{
+		Batch:       batch,
+		Checkpoint:  batch.GetCheckpoint([]byte(k.getGravityID(ctx))),
+		TotalFee:    sumBatchFees(selectedStes),
+		TotalAmount: sdk.ZeroInt(),
+	}
+	for _, ste := range selectedStes {
+		res.SendToEthereumIds = append(res.SendToEthereumIds, ste.Id)
+		res.TotalAmount = res.TotalAmount.Add(ste.Erc20Token.Amount)
+	}
+
+	lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress)
+	if lastBatch != nil {
+		res.LastBatchNonce = lastBatch.BatchNonce
+	}
+	res.WouldCreate = len(selectedStes) > 0 && beatsLastBatch(lastBatch, selectedStes)
+
+	return res
+}
+
 // CancelBatchTx releases all TX in the batch and deletes the batch
 func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
 	// free transactions from batch and reindex them
@@ -177,15 +210,15 @@ func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out
 	return
 }
 
-func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
 	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+	return 0
+}
+
+func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(newId))
 	return newId
 }
@@ -100,7 +100,7 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, types.OutgoingTxExecution{})
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
@@ -259,7 +259,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, types.OutgoingTxExecution{})
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
@@ -340,11 +340,15 @@ func TestPoolTxRefund(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	batch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
 
 	// try to refund a tx that's in a batch
 	err := input.GravityKeeper.cancelSendToEthereum(ctx, 2, mySender.String())
-	require.Error(t, err)
+	require.ErrorIs(t, err, types.ErrSendToEthereumBatched)
+
+	// try to refund a tx that never existed
+	err = input.GravityKeeper.cancelSendToEthereum(ctx, 100, mySender.String())
+	require.ErrorIs(t, err, types.ErrSendToEthereumNotFound)
 
 	// try to refund a tx that's in the pool
 	err = input.GravityKeeper.cancelSendToEthereum(ctx, 4, mySender.String())
@@ -353,6 +357,14 @@ func TestPoolTxRefund(t *testing.T) {
 	// make sure refund was issued
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
+
+	// the refunded tx is gone from the id index
+	err = input.GravityKeeper.cancelSendToEthereum(ctx, 4, mySender.String())
+	require.ErrorIs(t, err, types.ErrSendToEthereumNotFound)
+
+	// once the batch is canceled its txs can be refunded
+	input.GravityKeeper.CancelBatchTx(ctx, batch)
+	require.NoError(t, input.GravityKeeper.cancelSendToEthereum(ctx, 2, mySender.String()))
 }
 
 func TestEmptyBatch(t *testing.T) {
@@ -380,3 +392,55 @@ func TestEmptyBatch(t *testing.T) {
 
 	require.Nil(t, batchTx)
 }
+
+func TestNextBatchPreview(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// nothing to batch yet
+	preview := gk.GetNextBatchPreview(ctx, myTokenContractAddr, 2)
+	require.False(t, preview.WouldCreate)
+	require.Empty(t, preview.SendToEthereumIds)
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+
+	preview = gk.GetNextBatchPreview(ctx, myTokenContractAddr, 2)
+	require.True(t, preview.WouldCreate)
+	require.Equal(t, []uint64{2, 3}, preview.SendToEthereumIds)
+	require.Equal(t, sdk.NewInt(5), preview.TotalFee)
+	require.Equal(t, sdk.NewInt(101+102), preview.TotalAmount)
+	require.Equal(t, uint64(0), preview.LastBatchNonce)
+
+	// the preview writes nothing, so previewing again gives the same batch
+	require.Equal(t, preview, gk.GetNextBatchPreview(ctx, myTokenContractAddr, 2))
+	require.Equal(t, sdk.NewInt(5), gk.GetBatchFeesByTokenType(ctx, myTokenContractAddr, 2))
+
+	// and it is the batch that is then created
+	batch := gk.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	require.Equal(t, preview.Batch, *batch)
+	require.Equal(t, preview.Checkpoint, batch.GetCheckpoint([]byte(gk.getGravityID(ctx))))
+
+	preview = gk.GetNextBatchPreview(ctx, myTokenContractAddr, 2)
+	require.Equal(t, []uint64{1, 4}, preview.SendToEthereumIds)
+	require.Equal(t, batch.BatchNonce, preview.LastBatchNonce)
+	require.Equal(t, batch.BatchNonce+1, preview.Batch.BatchNonce)
+	require.Equal(t, preview.WouldCreate, gk.CreateBatchTx(ctx, myTokenContractAddr, 2) != nil)
+
+	_, err := gk.NextBatchPreview(sdk.WrapSDKContext(ctx), &types.NextBatchPreviewRequest{TokenContract: "not-an-address"})
+	require.Error(t, err)
+}
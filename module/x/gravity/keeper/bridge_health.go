@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"sort"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetBridgeHealth computes the bridge health values and their severity under
+// the bridge health thresholds param
+func (k Keeper) GetBridgeHealth(ctx sdk.Context) types.BridgeHealthResponse {
+	thresholds := k.GetParams(ctx).BridgeHealthThresholds
+	height := uint64(ctx.BlockHeight())
+	res := types.BridgeHealthResponse{}
+
+	stall := height - k.GetLastObservedEventHeight(ctx)
+	res.EventStall = types.HeightHealth{
+		Blocks:   stall,
+		Severity: severityAbove(stall, thresholds.EventStallBlocksWarning, thresholds.EventStallBlocksCritical),
+	}
+
+	var lag uint64
+	if median, lastObserved := k.medianEthereumHeightVote(ctx), k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight; median > lastObserved {
+		lag = median - lastObserved
+	}
+	res.EthereumHeightLag = types.HeightHealth{
+		Blocks:   lag,
+		Severity: severityAbove(lag, thresholds.EthereumHeightLagWarning, thresholds.EthereumHeightLagCritical),
+	}
+
+	for _, oldest := range k.oldestUnrelayedOutgoingTxs(ctx) {
+		age := height - oldest.otx.GetCosmosHeight()
+		res.OldestOutgoingTxs = append(res.OldestOutgoingTxs, types.OutgoingTxAgeHealth{
+			TxType:     oldest.txType,
+			StoreIndex: oldest.otx.GetStoreIndex(),
+			AgeBlocks:  age,
+			Severity:   severityAbove(age, thresholds.OutgoingTxAgeBlocksWarning, thresholds.OutgoingTxAgeBlocksCritical),
+		})
+	}
+
+	res.LatestSignerSetSignedPower = types.SignerSetSignedPowerHealth{SignedPower: sdk.ZeroDec()}
+	if latest := k.GetLatestSignerSetTx(ctx); latest != nil {
+		signed := k.signedPowerFraction(ctx, latest)
+		res.LatestSignerSetSignedPower = types.SignerSetSignedPowerHealth{
+			SignerSetNonce: latest.Nonce,
+			SignedPower:    signed,
+			Severity:       severityBelow(signed, thresholds.SignerSetSignedPowerWarning, thresholds.SignerSetSignedPowerCritical),
+		}
+	}
+
+	for _, depth := range k.poolDepths(ctx) {
+		depth.Severity = severityAbove(depth.Count, thresholds.PoolDepthWarning, thresholds.PoolDepthCritical)
+		res.PoolDepths = append(res.PoolDepths, depth)
+	}
+
+	res.Severity = res.EventStall.Severity
+	raise := func(severity types.HealthSeverity) {
+		if severity > res.Severity {
+			res.Severity = severity
+		}
+	}
+	raise(res.EthereumHeightLag.Severity)
+	raise(res.LatestSignerSetSignedPower.Severity)
+	for _, oldest := range res.OldestOutgoingTxs {
+		raise(oldest.Severity)
+	}
+	for _, depth := range res.PoolDepths {
+		raise(depth.Severity)
+	}
+
+	return res
+}
+
+// severityAbove grades a value that is worse the higher it is, a zero
+// threshold is never reached
+func severityAbove(value, warning, critical uint64) types.HealthSeverity {
+	switch {
+	case critical != 0 && value >= critical:
+		return types.HealthSeverity_HEALTH_SEVERITY_CRITICAL
+	case warning != 0 && value >= warning:
+		return types.HealthSeverity_HEALTH_SEVERITY_WARNING
+	default:
+		return types.HealthSeverity_HEALTH_SEVERITY_OK
+	}
+}
+
+// severityBelow grades a value that is worse the lower it is, a zero
+// threshold is never reached
+func severityBelow(value, warning, critical sdk.Dec) types.HealthSeverity {
+	switch {
+	case value.LT(critical):
+		return types.HealthSeverity_HEALTH_SEVERITY_CRITICAL
+	case value.LT(warning):
+		return types.HealthSeverity_HEALTH_SEVERITY_WARNING
+	default:
+		return types.HealthSeverity_HEALTH_SEVERITY_OK
+	}
+}
+
+// medianEthereumHeightVote returns the Ethereum height voted by the
+// validators holding the median unit of power among the height votes
+func (k Keeper) medianEthereumHeightVote(ctx sdk.Context) uint64 {
+	type vote struct {
+		height uint64
+		power  int64
+	}
+	var (
+		votes []vote
+		total int64
+	)
+	k.IterateEthereumHeightVotes(ctx, func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+		if power := k.StakingKeeper.GetLastValidatorPower(ctx, val); power > 0 {
+			votes = append(votes, vote{height.EthereumHeight, power})
+			total += power
+		}
+		return false
+	})
+	sort.Slice(votes, func(i, j int) bool { return votes[i].height < votes[j].height })
+
+	var cumulative int64
+	for _, v := range votes {
+		cumulative += v.power
+		if 2*cumulative >= total {
+			return v.height
+		}
+	}
+	return 0
+}
+
+type unrelayedOutgoingTx struct {
+	txType string
+	otx    types.OutgoingTx
+}
+
+// oldestUnrelayedOutgoingTxs returns, for each type of outgoing tx, the oldest
+// one that has not been relayed yet: signer set txs above the last observed
+// one, and the batch and contract call txs still in the store
+func (k Keeper) oldestUnrelayedOutgoingTxs(ctx sdk.Context) (out []unrelayedOutgoingTx) {
+	var lastObservedNonce uint64
+	if lastObserved := k.GetLastObservedSignerSetTx(ctx); lastObserved != nil {
+		lastObservedNonce = lastObserved.Nonce
+	}
+
+	for _, outgoing := range []struct {
+		txType     string
+		prefixByte byte
+		relayed    func(types.OutgoingTx) bool
+	}{
+		{"signer_set", types.SignerSetTxPrefixByte, func(otx types.OutgoingTx) bool {
+			return otx.(*types.SignerSetTx).Nonce <= lastObservedNonce
+		}},
+		{"batch", types.BatchTxPrefixByte, func(types.OutgoingTx) bool { return false }},
+		{"contract_call", types.ContractCallTxPrefixByte, func(types.OutgoingTx) bool { return false }},
+	} {
+		var oldest types.OutgoingTx
+		k.iterateOutgoingTxsByTypeAscending(ctx, outgoing.prefixByte, func(otx types.OutgoingTx) {
+			if !outgoing.relayed(otx) && (oldest == nil || otx.GetCosmosHeight() < oldest.GetCosmosHeight()) {
+				oldest = otx
+			}
+		})
+		if oldest != nil {
+			out = append(out, unrelayedOutgoingTx{outgoing.txType, oldest})
+		}
+	}
+	return out
+}
+
+func (k Keeper) iterateOutgoingTxsByTypeAscending(ctx sdk.Context, prefixByte byte, cb func(types.OutgoingTx)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte})).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var any cdctypes.Any
+		k.cdc.MustUnmarshal(iter.Value(), &any)
+		var otx types.OutgoingTx
+		if err := k.cdc.UnpackAny(&any, &otx); err != nil {
+			panic(err)
+		}
+		cb(otx)
+	}
+}
+
+// signedPowerFraction returns the share of the power of a signer set tx's
+// signers that has signed it. Signatures are attributed by recovering their
+// Ethereum address, as in relaySignatures.
+func (k Keeper) signedPowerFraction(ctx sdk.Context, signerSet *types.SignerSetTx) sdk.Dec {
+	checkpoint := signerSet.GetCheckpoint([]byte(k.getGravityID(ctx)))
+
+	signed := make(map[common.Address]bool)
+	k.iterateEthereumSignatures(ctx, signerSet.GetStoreIndex(), func(_ sdk.ValAddress, signature []byte) bool {
+		if addr, err := types.EthereumAddressFromSignature(checkpoint, signature); err == nil {
+			signed[addr] = true
+		}
+		return false
+	})
+
+	var power, total uint64
+	for _, signer := range signerSet.Signers {
+		total += signer.Power
+		if signed[common.HexToAddress(signer.EthereumAddress)] {
+			power += signer.Power
+		}
+	}
+	if total == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(power)).QuoInt(sdk.NewIntFromUint64(total))
+}
+
+// poolDepths returns the number and total amount of the unbatched sends to
+// Ethereum of each token, in token contract order
+func (k Keeper) poolDepths(ctx sdk.Context) (out []types.PoolDepthHealth) {
+	depths := make(map[string]*types.PoolDepthHealth)
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		depth, ok := depths[ste.Erc20Token.Contract]
+		if !ok {
+			depth = &types.PoolDepthHealth{TokenContract: ste.Erc20Token.Contract, Amount: sdk.ZeroInt()}
+			depths[ste.Erc20Token.Contract] = depth
+		}
+		depth.Count++
+		depth.Amount = depth.Amount.Add(ste.Erc20Token.Amount)
+		return false
+	})
+
+	for _, depth := range depths {
+		out = append(out, *depth)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].TokenContract < out[j].TokenContract })
+	return out
+}
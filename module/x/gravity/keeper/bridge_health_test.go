@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_BridgeHealth(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	params := gk.GetParams(ctx)
+	params.BridgeHealthThresholds = types.BridgeHealthThresholds{
+		EventStallBlocksWarning:      10,
+		EventStallBlocksCritical:     20,
+		EthereumHeightLagWarning:     10,
+		EthereumHeightLagCritical:    30,
+		OutgoingTxAgeBlocksWarning:   50,
+		OutgoingTxAgeBlocksCritical:  100,
+		SignerSetSignedPowerWarning:  sdk.NewDecWithPrec(8, 1),
+		SignerSetSignedPowerCritical: sdk.NewDecWithPrec(5, 1),
+		PoolDepthWarning:             2,
+		PoolDepthCritical:            0,
+	}
+	gk.setParams(ctx, params)
+
+	// the event nonce last advanced at height 100
+	ctx = ctx.WithBlockHeight(100)
+	gk.setLastObservedEventNonce(ctx, 1)
+
+	// the validators have equal power, the median vote is the third one
+	gk.SetLastObservedEthereumBlockHeight(ctx, 100)
+	for i, height := range []uint64{140, 100, 120, 110, 130} {
+		gk.SetEthereumHeightVote(ctx, ValAddrs[i], height)
+	}
+
+	var (
+		keys    []*ecdsa.PrivateKey
+		signers types.EthereumSigners
+	)
+	for _, power := range []uint64{1000, 2000, 1000} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		signers = append(signers, &types.EthereumSigner{Power: power, EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()})
+	}
+	signerSet := &types.SignerSetTx{Nonce: gk.incrementLatestSignerSetTxNonce(ctx), Height: 100, Signers: signers}
+	gk.SetOutgoingTx(ctx, signerSet)
+	for _, key := range keys[:2] {
+		signature, err := types.NewEthereumSignature(signerSet.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		val := sdk.ValAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+		gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{SignerSetNonce: signerSet.Nonce, Signature: signature}, val)
+	}
+
+	tokenA := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	tokenB := common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	for _, token := range []common.Address{tokenA, tokenB} {
+		require.NoError(t, input.AddBalanceToBank(ctx, AccAddrs[0], sdk.NewCoins(types.NewERC20Token(99999, token).GravityCoin())))
+	}
+	input.AddSendToEthTxsToPool(t, ctx, tokenA, AccAddrs[0], EthAddrs[1], 1, 2, 3)
+	input.AddSendToEthTxsToPool(t, ctx, tokenB, AccAddrs[0], EthAddrs[1], 1)
+
+	ctx = ctx.WithBlockHeight(115)
+	res, err := gk.BridgeHealth(sdk.WrapSDKContext(ctx), &types.BridgeHealthRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, types.HeightHealth{Blocks: 15, Severity: types.HealthSeverity_HEALTH_SEVERITY_WARNING}, res.EventStall)
+	require.Equal(t, types.HeightHealth{Blocks: 20, Severity: types.HealthSeverity_HEALTH_SEVERITY_WARNING}, res.EthereumHeightLag)
+	require.Equal(t, []types.OutgoingTxAgeHealth{{
+		TxType:     "signer_set",
+		StoreIndex: signerSet.GetStoreIndex(),
+		AgeBlocks:  15,
+		Severity:   types.HealthSeverity_HEALTH_SEVERITY_OK,
+	}}, res.OldestOutgoingTxs)
+	require.Equal(t, signerSet.Nonce, res.LatestSignerSetSignedPower.SignerSetNonce)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), res.LatestSignerSetSignedPower.SignedPower)
+	require.Equal(t, types.HealthSeverity_HEALTH_SEVERITY_WARNING, res.LatestSignerSetSignedPower.Severity)
+	require.Equal(t, []types.PoolDepthHealth{
+		{TokenContract: tokenA.Hex(), Count: 3, Amount: sdk.NewInt(100 + 101 + 102), Severity: types.HealthSeverity_HEALTH_SEVERITY_WARNING},
+		{TokenContract: tokenB.Hex(), Count: 1, Amount: sdk.NewInt(100), Severity: types.HealthSeverity_HEALTH_SEVERITY_OK},
+	}, res.PoolDepths)
+	require.Equal(t, types.HealthSeverity_HEALTH_SEVERITY_WARNING, res.Severity)
+
+	// the event nonce has not advanced for the critical number of blocks
+	ctx = ctx.WithBlockHeight(120)
+	require.Equal(t, types.HealthSeverity_HEALTH_SEVERITY_CRITICAL, gk.GetBridgeHealth(ctx).EventStall.Severity)
+	require.Equal(t, types.HealthSeverity_HEALTH_SEVERITY_CRITICAL, gk.GetBridgeHealth(ctx).Severity)
+
+	// a newly observed event resets the stall
+	gk.setLastObservedEventNonce(ctx, 2)
+	require.Equal(t, types.HeightHealth{}, gk.GetBridgeHealth(ctx).EventStall)
+}
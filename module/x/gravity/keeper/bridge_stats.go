@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetValidatorBridgeStats returns the bridge participation counters of a
+// validator over the current epoch
+func (k Keeper) GetValidatorBridgeStats(ctx sdk.Context, val sdk.ValAddress) types.ValidatorBridgeStats {
+	stats := types.ValidatorBridgeStats{ValidatorAddress: val.String()}
+	if bz := ctx.KVStore(k.storeKey).Get(types.MakeValidatorBridgeStatsKey(val)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+
+	return currentValidatorBridgeStats(k.GetBridgeStatsEpoch(ctx), stats)
+}
+
+// currentValidatorBridgeStats resets counters counted over a past epoch and
+// derives the observed events from the epoch's counter
+func currentValidatorBridgeStats(epoch types.BridgeStatsEpoch, stats types.ValidatorBridgeStats) types.ValidatorBridgeStats {
+	if stats.EpochStartHeight != epoch.StartHeight {
+		stats = types.ValidatorBridgeStats{
+			ValidatorAddress:       stats.ValidatorAddress,
+			EpochStartHeight:       epoch.StartHeight,
+			ObservedEventsBaseline: epoch.StartObservedEvents,
+		}
+	}
+	stats.ObservedEvents = epoch.ObservedEvents - stats.ObservedEventsBaseline
+
+	return stats
+}
+
+func (k Keeper) setValidatorBridgeStats(ctx sdk.Context, val sdk.ValAddress, stats types.ValidatorBridgeStats) {
+	ctx.KVStore(k.storeKey).Set(types.MakeValidatorBridgeStatsKey(val), k.cdc.MustMarshal(&stats))
+}
+
+// iterateValidatorBridgeStats iterates over the stored counters of every
+// validator, including the ones counted over a past epoch
+func (k Keeper) iterateValidatorBridgeStats(ctx sdk.Context, cb func(stats types.ValidatorBridgeStats) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorBridgeStatsKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats types.ValidatorBridgeStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// updateValidatorBridgeStats applies cb to the stored counters of a validator
+func (k Keeper) updateValidatorBridgeStats(ctx sdk.Context, val sdk.ValAddress, cb func(stats *types.ValidatorBridgeStats)) {
+	stats := k.GetValidatorBridgeStats(ctx, val)
+	cb(&stats)
+	k.setValidatorBridgeStats(ctx, val, stats)
+}
+
+// IncrementExpectedConfirmations records that the signing window of an outgoing
+// tx the validator was required to sign has passed
+func (k Keeper) IncrementExpectedConfirmations(ctx sdk.Context, val sdk.ValAddress) {
+	k.updateValidatorBridgeStats(ctx, val, func(stats *types.ValidatorBridgeStats) {
+		stats.ExpectedConfirmations++
+	})
+}
+
+// GetBridgeStatsEpoch returns the epoch the validator bridge stats are counted over
+func (k Keeper) GetBridgeStatsEpoch(ctx sdk.Context) (epoch types.BridgeStatsEpoch) {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.BridgeStatsEpochKey}); bz != nil {
+		k.cdc.MustUnmarshal(bz, &epoch)
+	}
+	return
+}
+
+func (k Keeper) setBridgeStatsEpoch(ctx sdk.Context, epoch types.BridgeStatsEpoch) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.BridgeStatsEpochKey}, k.cdc.MustMarshal(&epoch))
+}
+
+// incrementObservedEvents records an observed event, every validator's observed
+// events are derived from this single counter
+func (k Keeper) incrementObservedEvents(ctx sdk.Context) {
+	epoch := k.GetBridgeStatsEpoch(ctx)
+	epoch.ObservedEvents++
+	k.setBridgeStatsEpoch(ctx, epoch)
+}
+
+// StartBridgeStatsEpoch starts a new epoch, the validators' counters are reset
+// as they are next read
+func (k Keeper) StartBridgeStatsEpoch(ctx sdk.Context) {
+	epoch := k.GetBridgeStatsEpoch(ctx)
+	epoch.StartHeight = uint64(ctx.BlockHeight())
+	epoch.StartObservedEvents = epoch.ObservedEvents
+	k.setBridgeStatsEpoch(ctx, epoch)
+}
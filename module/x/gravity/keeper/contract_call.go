@@ -3,12 +3,14 @@ package keeper
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
-func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) {
+func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64, execution types.OutgoingTxExecution) {
 	otx := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean contract calls",
@@ -28,5 +30,22 @@ func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte,
 		return false
 	})
 
+	k.archiveContractCallTx(ctx, completedCallTx, execution)
 	k.DeleteOutgoingTx(ctx, completedCallTx.GetStoreIndex())
 }
+
+// CancelContractCallTx deletes a contract call that can no longer be executed on Ethereum
+func (k Keeper) CancelContractCallTx(ctx sdk.Context, call *types.ContractCallTx) {
+	k.DeleteOutgoingTx(ctx, call.GetStoreIndex())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallTxCanceled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(call.InvalidationScope)),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
+		),
+	)
+}
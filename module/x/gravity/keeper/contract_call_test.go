@@ -70,11 +70,17 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx2.Tokens, erc20Tokens)
 	assert.Equal(t, cctx2.Fees, erc20Tokens)
 
-	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2)
+	execution := types.OutgoingTxExecution{EventNonce: 3, EthereumHeight: 1001, CosmosHeight: 5, SignerSetNonce: 1}
+	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2, execution)
 
 	otx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
 	otx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
 
 	assert.Nil(t, otx1)
 	assert.Nil(t, otx2)
+
+	// only the executed call is archived, the earlier one was never executed
+	assert.Nil(t, input.GravityKeeper.GetExecutedContractCallTx(ctx, scope, nonce1))
+	assert.Equal(t, &types.ExecutedContractCallTx{LogicCall: *cctx2, Execution: execution},
+		input.GravityKeeper.GetExecutedContractCallTx(ctx, scope, nonce2))
 }
@@ -54,7 +54,8 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 		return nil
 
 	case *types.BatchExecutedEvent:
-		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce)
+		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce,
+			k.newOutgoingTxExecution(ctx, event.EventNonce, event.EthereumHeight))
 		k.AfterBatchExecutedEvent(ctx, *event)
 		return nil
 
@@ -69,7 +70,8 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 		return nil
 
 	case *types.ContractCallExecutedEvent:
-		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce)
+		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce,
+			k.newOutgoingTxExecution(ctx, event.EventNonce, event.EthereumHeight))
 		k.AfterContractCallExecutedEvent(ctx, *event)
 		return nil
 
@@ -81,6 +83,13 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
 		})
+		k.setObservedSignerSet(ctx, types.ObservedSignerSet{
+			SignerSetNonce: event.SignerSetTxNonce,
+			Signers:        event.Members,
+			EthereumHeight: event.EthereumHeight,
+			CosmosHeight:   uint64(ctx.BlockHeight()),
+		})
+		k.completeEthereumKeyRotations(ctx, event.SignerSetTxNonce)
 		k.AfterSignerSetExecutedEvent(ctx, *event)
 		return nil
 
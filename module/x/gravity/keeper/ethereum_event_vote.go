@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
@@ -45,6 +48,20 @@ func (k Keeper) recordEventVote(
 			Accepted: false,
 			Event:    any,
 		}
+
+		// validators only disagree once a second record shows up at the nonce,
+		// later ones add to a disagreement that was already reported
+		if hashes := k.ethereumEventVoteRecordHashes(ctx, event.GetEventNonce()); len(hashes) == 1 {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeEthereumEventDisagreement,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventHash, hex.EncodeToString(event.Hash())),
+				sdk.NewAttribute(types.AttributeKeyConflictingEthereumEventHash, hex.EncodeToString(hashes[0])),
+				sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			))
+		}
 	}
 
 	// Add the validator's vote to this EthereumEventVoteRecord
@@ -52,6 +69,13 @@ func (k Keeper) recordEventVote(
 
 	k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
 	k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
+	k.updateValidatorBridgeStats(ctx, val, func(stats *types.ValidatorBridgeStats) {
+		stats.EventVotes++
+	})
+	// votes for an event that was already observed are still correct attestations
+	if eventVoteRecord.Accepted {
+		k.incrementRewardPoolParticipation(ctx, val)
+	}
 
 	return eventVoteRecord, nil
 }
@@ -91,7 +115,14 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 				k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
 
 				eventVoteRecord.Accepted = true
+				eventVoteRecord.Height = uint64(ctx.BlockHeight())
 				k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+				k.deletePendingEthereumEventVoteRecords(ctx, event.GetEventNonce())
+				k.incrementObservedEvents(ctx)
+				for _, voter := range eventVoteRecord.Votes {
+					voterAddr, _ := sdk.ValAddressFromBech32(voter)
+					k.incrementRewardPoolParticipation(ctx, voterAddr)
+				}
 
 				k.processEthereumEvent(ctx, event)
 				ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -135,9 +166,47 @@ func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent)
 	}
 }
 
-// setEthereumEventVoteRecord sets the attestation in the store
+// setEthereumEventVoteRecord sets the attestation in the store and keeps it in
+// the pending index for as long as it can still be observed
 func (k Keeper) setEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte, eventVoteRecord *types.EthereumEventVoteRecord) {
-	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+
+	pendingKey := types.MakePendingEthereumEventVoteRecordKey(eventNonce, claimHash)
+	if !eventVoteRecord.Accepted && eventNonce > k.GetLastObservedEventNonce(ctx) {
+		store.Set(pendingKey, []byte{0x1})
+	} else {
+		store.Delete(pendingKey)
+	}
+}
+
+// GetPendingEthereumEventVoteRecords returns the vote records at the nonce that
+// have not been accepted, provided the nonce has not been observed yet
+func (k Keeper) GetPendingEthereumEventVoteRecords(ctx sdk.Context, eventNonce uint64) (out []*types.EthereumEventVoteRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakePendingEthereumEventVoteRecordKey(eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, k.GetEthereumEventVoteRecord(ctx, eventNonce, iter.Key()))
+	}
+	return out
+}
+
+// deletePendingEthereumEventVoteRecords removes every vote record at the nonce
+// from the pending index, once one of them has been observed none of the
+// others can be
+func (k Keeper) deletePendingEthereumEventVoteRecords(ctx sdk.Context, eventNonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakePendingEthereumEventVoteRecordKey(eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
 
 // GetEthereumEventVoteRecord return a vote record given a nonce
@@ -169,6 +238,115 @@ func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context) (out map[uint
 	return
 }
 
+// ethereumEventVoteRecordHashes returns the event hashes of the vote records
+// stored at the nonce
+func (k Keeper) ethereumEventVoteRecordHashes(ctx sdk.Context, eventNonce uint64) (out [][]byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumEventVoteRecordKey(eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, iter.Key())
+	}
+	return out
+}
+
+// PaginateEventVoteDisagreements pages through, in nonce order, the event
+// nonces that have more than one vote record, with the current power behind
+// each record. The page is taken over the vote records, each disagreement being
+// counted at the first record of its nonce.
+func (k Keeper) PaginateEventVoteDisagreements(ctx sdk.Context, pageReq *query.PageRequest) (out []types.EventVoteDisagreement, pageRes *query.PageResponse, err error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	pageRes, err = query.FilteredPaginate(prefixStore, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		nonce := binary.BigEndian.Uint64(key[:8])
+		hashes := k.ethereumEventVoteRecordHashes(ctx, nonce)
+		if len(hashes) < 2 || !bytes.Equal(hashes[0], key[8:]) {
+			return false, nil
+		}
+
+		if accumulate {
+			out = append(out, k.eventVoteDisagreement(ctx, nonce))
+		}
+		return true, nil
+	})
+
+	return out, pageRes, err
+}
+
+// eventVoteDisagreement returns the vote records stored at the nonce with the
+// current power of each of their voters
+func (k Keeper) eventVoteDisagreement(ctx sdk.Context, eventNonce uint64) types.EventVoteDisagreement {
+	disagreement := types.EventVoteDisagreement{EventNonce: eventNonce}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumEventVoteRecordKey(eventNonce, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+
+		disagreementRecord := types.EventVoteDisagreementRecord{
+			EventHash: iter.Key(),
+			Event:     record.Event,
+			Accepted:  record.Accepted,
+		}
+		for _, vote := range record.Votes {
+			val, _ := sdk.ValAddressFromBech32(vote)
+			power := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+			disagreementRecord.Voters = append(disagreementRecord.Voters, types.EventVoter{ValidatorAddress: vote, Power: power})
+			disagreementRecord.Power += power
+		}
+		disagreement.Records = append(disagreement.Records, disagreementRecord)
+	}
+
+	return disagreement
+}
+
+// PruneEthereumEventVoteRecords deletes the accepted vote records that are older
+// than the retention window, along with the losing records at the same nonce,
+// and any losing record at an observed nonce whose accepted record is gone.
+// Records are accepted in nonce order, so the scan stops at the first accepted
+// record still within the window.
+func (k Keeper) PruneEthereumEventVoteRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).EventVoteRecordRetentionBlocks
+	if retention == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(k.GetLastObservedEventNonce(ctx)+1))
+	defer iter.Close()
+
+	var (
+		expired [][]byte
+		group   [][]byte
+		nonce   uint64
+		keep    bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		if n := binary.BigEndian.Uint64(iter.Key()[:8]); n != nonce {
+			if keep {
+				break
+			}
+			expired = append(expired, group...)
+			group, nonce = nil, n
+		}
+
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if record.Accepted && record.Height+retention > uint64(ctx.BlockHeight()) {
+			keep = true
+		}
+		group = append(group, iter.Key())
+	}
+	if !keep {
+		expired = append(expired, group...)
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
 // iterateEthereumEventVoteRecords iterates through all attestations
 func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
@@ -227,58 +405,75 @@ func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, et
 	store.Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
 }
 
-// setLastObservedEventNonce sets the latest observed event nonce
+// setLastObservedEventNonce sets the latest observed event nonce, and the
+// current height as the height it was observed at
 func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(nonce))
+	store.Set([]byte{types.LastObservedEventHeightKey}, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// GetLastObservedEventHeight returns the cosmos height at which the last
+// observed event nonce was set
+func (k Keeper) GetLastObservedEventHeight(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastObservedEventHeightKey})
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
 }
 
-// getLastEventNonceByValidator returns the latest event nonce for a given validator
+// getLastEventNonceByValidator returns the latest event nonce for a given
+// validator, see seedLastEventNonceByValidator for validators that have yet
+// to submit an event
 func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get(types.MakeLastEventNonceByValidatorKey(validator))
 
 	if len(bytes) == 0 {
-		// in the case that we have no existing value this is the first
-		// time a validator is submitting a claim. Since we don't want to force
-		// them to replay the entire history of all events ever we can't start
-		// at zero
-		//
-		// We could start at the LastObservedEventNonce but if we do that this
-		// validator will be slashed, because they are responsible for making a claim
-		// on any attestation that has not yet passed the slashing window.
-		//
-		// Therefore we need to return to them the lowest attestation that is still within
-		// the slashing window. Since we delete attestations after the slashing window that's
-		// just the lowest observed event in the store. If no claims have been submitted in for
-		// params.SignedClaimsWindow we may have no attestations in our nonce. At which point
-		// the last observed which is a persistent and never cleaned counter will suffice.
-		lowestObserved := k.GetLastObservedEventNonce(ctx)
-		attmap := k.GetEthereumEventVoteRecordMapping(ctx)
-		// no new claims in params.SignedClaimsWindow, we can return the current value
-		// because the validator can't be slashed for an event that has already passed.
-		// so they only have to worry about the *next* event to occur
-		if len(attmap) == 0 {
-			return lowestObserved
-		}
-		for nonce, atts := range attmap {
-			for att := range atts {
-				if atts[att].Accepted && nonce < lowestObserved {
-					lowestObserved = nonce
-				}
-			}
-		}
-		// return the latest event minus one so that the validator
-		// can submit that event and avoid slashing. special case
-		// for zero
-		if lowestObserved > 0 {
-			return lowestObserved - 1
-		}
 		return 0
 	}
 	return binary.BigEndian.Uint64(bytes)
 }
 
+// seedLastEventNonceByValidator sets the latest event nonce of a validator
+// registering its delegate keys that has no latest event nonce yet.
+//
+// Since we don't want to force them to replay the entire history of all
+// events ever we can't start at zero. We could start at the
+// LastObservedEventNonce but if we do that this validator will be slashed,
+// because they are responsible for making a claim on any attestation that has
+// not yet passed the slashing window.
+//
+// Therefore we start them at the lowest accepted event still in the store.
+// If there is none, the last observed event nonce, which is a persistent and
+// never cleaned counter, will suffice.
+func (k Keeper) seedLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) {
+	if ctx.KVStore(k.storeKey).Has(types.MakeLastEventNonceByValidatorKey(validator)) {
+		return
+	}
+
+	lowestObserved := k.GetLastObservedEventNonce(ctx)
+	found := false
+	// the records are stored in event nonce order
+	k.iterateEthereumEventVoteRecords(ctx, func(key []byte, record *types.EthereumEventVoteRecord) bool {
+		if record.Accepted {
+			if nonce := binary.BigEndian.Uint64(key[:8]); nonce < lowestObserved {
+				lowestObserved = nonce
+			}
+			found = true
+		}
+		return found
+	})
+
+	// return the lowest accepted event minus one so that the validator can
+	// submit that event and avoid slashing. special case for zero
+	if found && lowestObserved > 0 {
+		lowestObserved--
+	}
+	k.setLastEventNonceByValidator(ctx, validator, lowestObserved)
+}
+
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
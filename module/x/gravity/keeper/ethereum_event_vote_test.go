@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_PruneEthereumEventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	setRecord := func(event types.EthereumEvent, accepted bool, height uint64) {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		gk.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), &types.EthereumEventVoteRecord{
+			Event:    any,
+			Accepted: accepted,
+			Height:   height,
+		})
+	}
+
+	setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 1}, true, 10)
+	setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 2}, false, 0)
+	setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: 2}, true, 60)
+	setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 3, SignerSetTxNonce: 3}, false, 0)
+	gk.setLastObservedEventNonce(ctx, 2)
+
+	// both accepted records are within the retention window
+	ctx = ctx.WithBlockHeight(100)
+	gk.PruneEthereumEventVoteRecords(ctx)
+	require.Len(t, gk.GetEthereumEventVoteRecordMapping(ctx)[1], 2)
+
+	// the first nonce, winner and loser, falls out of the window
+	ctx = ctx.WithBlockHeight(110)
+	gk.PruneEthereumEventVoteRecords(ctx)
+	records := gk.GetEthereumEventVoteRecordMapping(ctx)
+	require.Empty(t, records[1])
+	require.Len(t, records[2], 1)
+	require.Len(t, records[3], 1)
+
+	// records at unobserved nonces are never pruned
+	ctx = ctx.WithBlockHeight(1000)
+	gk.PruneEthereumEventVoteRecords(ctx)
+	records = gk.GetEthereumEventVoteRecordMapping(ctx)
+	require.Empty(t, records[2])
+	require.Len(t, records[3], 1)
+}
+
+func TestKeeper_EventVoteDisagreements(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	countDisagreementEvents := func() (n int) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeEthereumEventDisagreement {
+				n++
+			}
+		}
+		return n
+	}
+
+	agreed := &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 1}
+	competing := &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 2}
+	third := &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 3}
+
+	for _, val := range ValAddrs[:2] {
+		_, err := gk.recordEventVote(ctx, agreed, val)
+		require.NoError(t, err)
+	}
+	require.Zero(t, countDisagreementEvents())
+	disagreements, _, err := gk.PaginateEventVoteDisagreements(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, disagreements)
+
+	// the second record at the nonce is reported, later ones are not
+	_, err = gk.recordEventVote(ctx, competing, ValAddrs[2])
+	require.NoError(t, err)
+	require.Equal(t, 1, countDisagreementEvents())
+	_, err = gk.recordEventVote(ctx, third, ValAddrs[3])
+	require.NoError(t, err)
+	require.Equal(t, 1, countDisagreementEvents())
+
+	// a nonce everyone agrees on is not a disagreement
+	_, err = gk.recordEventVote(ctx, &types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: 2}, ValAddrs[0])
+	require.NoError(t, err)
+
+	disagreements, _, err = gk.PaginateEventVoteDisagreements(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, disagreements, 1)
+	require.Equal(t, uint64(1), disagreements[0].EventNonce)
+	require.Len(t, disagreements[0].Records, 3)
+
+	power := gk.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+	for _, record := range disagreements[0].Records {
+		event, err := types.UnpackEvent(record.Event)
+		require.NoError(t, err)
+		require.Equal(t, event.Hash().Bytes(), []byte(record.EventHash))
+
+		switch event.Hash().String() {
+		case agreed.Hash().String():
+			require.Equal(t, []types.EventVoter{
+				{ValidatorAddress: ValAddrs[0].String(), Power: power},
+				{ValidatorAddress: ValAddrs[1].String(), Power: power},
+			}, record.Voters)
+			require.Equal(t, 2*power, record.Power)
+		case competing.Hash().String():
+			require.Equal(t, []types.EventVoter{{ValidatorAddress: ValAddrs[2].String(), Power: power}}, record.Voters)
+		default:
+			require.Equal(t, []types.EventVoter{{ValidatorAddress: ValAddrs[3].String(), Power: power}}, record.Voters)
+		}
+	}
+
+	// disagreements are paged one per nonce
+	_, err = gk.recordEventVote(ctx, &types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: 3}, ValAddrs[1])
+	require.NoError(t, err)
+
+	disagreements, pageRes, err := gk.PaginateEventVoteDisagreements(ctx, &query.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, disagreements, 1)
+	require.Equal(t, uint64(1), disagreements[0].EventNonce)
+
+	disagreements, _, err = gk.PaginateEventVoteDisagreements(ctx, &query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, disagreements, 1)
+	require.Equal(t, uint64(2), disagreements[0].EventNonce)
+	require.Len(t, disagreements[0].Records, 2)
+}
+
+func TestKeeper_SeedLastEventNonceByValidator(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	// without events the validator starts at the last observed nonce
+	gk.setLastObservedEventNonce(ctx, 3)
+	gk.seedLastEventNonceByValidator(ctx, ValAddrs[0])
+	require.EqualValues(t, 3, gk.getLastEventNonceByValidator(ctx, ValAddrs[0]))
+
+	// otherwise before the lowest accepted event still in the store
+	for nonce, accepted := range map[uint64]bool{1: false, 2: true, 3: true} {
+		event := &types.SendToCosmosEvent{EventNonce: nonce, TokenContract: TokenContractAddrs[0], Amount: sdk.NewInt(1), EthereumHeight: 10}
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		gk.setEthereumEventVoteRecord(ctx, nonce, event.Hash(), &types.EthereumEventVoteRecord{Event: any, Accepted: accepted})
+	}
+	gk.seedLastEventNonceByValidator(ctx, ValAddrs[1])
+	require.EqualValues(t, 1, gk.getLastEventNonceByValidator(ctx, ValAddrs[1]))
+
+	// and a validator's latest event nonce is never reset
+	gk.seedLastEventNonceByValidator(ctx, ValAddrs[0])
+	require.EqualValues(t, 3, gk.getLastEventNonceByValidator(ctx, ValAddrs[0]))
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// checkBadSignatureEvidence verifies that the signature was produced over the
+// checkpoint of an outgoing tx that was never created by this chain and, if
+// so, slashes and jails the validator owning the signing Ethereum key.
+//
+// NOTE: slashed tokens are burned by the staking module so the submitter of
+// the evidence is not rewarded.
+func (k Keeper) checkBadSignatureEvidence(ctx sdk.Context, subject types.OutgoingTx, signature []byte) (sdk.ValAddress, []byte, error) {
+	checkpoint := subject.GetCheckpoint([]byte(k.getGravityID(ctx)))
+
+	// the checkpoint must never have been produced, neither by a tx that's
+	// still in the store nor by one that has since been pruned
+	if otx := k.GetOutgoingTx(ctx, subject.GetStoreIndex()); otx != nil {
+		if string(otx.GetCheckpoint([]byte(k.getGravityID(ctx)))) == string(checkpoint) {
+			return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, "outgoing tx exists in the store")
+		}
+	}
+	if k.getPastEthereumSignatureCheckpoint(ctx, subject) {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, "checkpoint was produced by the chain")
+	}
+	if k.predatesPastEthereumSignatureCheckpoints(ctx, subject) {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, "outgoing tx nonce predates the checkpoint index")
+	}
+
+	ethAddress, err := types.EthereumAddressFromSignature(checkpoint, signature)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, err.Error())
+	}
+
+	valAddr := k.GetValidatorForEthereumAddress(ctx, ethAddress)
+	if valAddr == nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "no validator for ethereum address %s", ethAddress.Hex())
+	}
+
+	if err = types.ValidateEthereumSignature(checkpoint, signature, k.GetValidatorEthereumAddress(ctx, valAddr)); err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, err.Error())
+	}
+
+	if k.getBadSignatureEvidence(ctx, checkpoint, ethAddress) {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, "evidence already submitted")
+	}
+
+	val, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "validator %s not found", valAddr)
+	}
+	// the staking module can't slash or jail unbonded validators, their delegate
+	// keys are kept so their signatures may still be submitted
+	if val.IsUnbonded() {
+		return nil, nil, sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "validator %s is unbonded", valAddr)
+	}
+
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrBadSignatureEvidence, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	power := val.ConsensusPower(k.PowerReduction)
+	k.StakingKeeper.Slash(
+		ctx,
+		consAddr,
+		ctx.BlockHeight(),
+		power,
+		params.SlashFractionConflictingEthereumSignature,
+	)
+	if !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.setBadSignatureEvidence(ctx, checkpoint, ethAddress, uint64(ctx.BlockHeight()))
+
+	k.Logger(ctx).Info(
+		"slashed validator for bad ethereum signature",
+		"validator", valAddr.String(),
+		"eth addr", ethAddress.Hex(),
+		"checkpoint", hex.EncodeToString(checkpoint),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			slashingtypes.EventTypeSlash,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeBadEthereumSignature),
+			sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+
+	return valAddr, checkpoint, nil
+}
+
+// setPastEthereumSignatureCheckpoint records the checkpoint of an outgoing tx
+// produced by the chain at the height
+func (k Keeper) setPastEthereumSignatureCheckpoint(ctx sdk.Context, otx types.OutgoingTx, height uint64) {
+	txType, nonce := types.OutgoingTxTypeAndNonce(otx)
+	key := types.MakePastEthereumSignatureCheckpointKey(txType, nonce, otx.GetCheckpoint([]byte(k.getGravityID(ctx))))
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(height))
+}
+
+// getPastEthereumSignatureCheckpoint returns true if the checkpoint of the
+// outgoing tx was produced by the chain and is still indexed
+func (k Keeper) getPastEthereumSignatureCheckpoint(ctx sdk.Context, otx types.OutgoingTx) bool {
+	txType, nonce := types.OutgoingTxTypeAndNonce(otx)
+	key := types.MakePastEthereumSignatureCheckpointKey(txType, nonce, otx.GetCheckpoint([]byte(k.getGravityID(ctx))))
+	return ctx.KVStore(k.storeKey).Has(key)
+}
+
+// PrunePastEthereumSignatureCheckpoints deletes, in nonce order for each
+// outgoing tx type, the checkpoints produced more than the retention window
+// ago. The floor of each type is raised to the pruned nonces so that
+// signatures over the pruned checkpoints are still never accepted as bad
+// signature evidence.
+func (k Keeper) PrunePastEthereumSignatureCheckpoints(ctx sdk.Context) {
+	retention := k.GetParams(ctx).PastEthereumSignatureCheckpointRetentionBlocks
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+
+	floors := k.getPastEthereumSignatureCheckpointFloors(ctx)
+	for _, txType := range []byte{types.SignerSetTxPrefixByte, types.BatchTxPrefixByte, types.ContractCallTxPrefixByte} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PastEthereumSignatureCheckpointKey, txType})
+		iter := store.Iterator(nil, nil)
+		var expired [][]byte
+		for ; iter.Valid(); iter.Next() {
+			if binary.BigEndian.Uint64(iter.Value())+retention >= uint64(ctx.BlockHeight()) {
+				break
+			}
+			expired = append(expired, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range expired {
+			store.Delete(key)
+			floors.Raise(txType, binary.BigEndian.Uint64(key[:8]))
+		}
+	}
+	k.setPastEthereumSignatureCheckpointFloors(ctx, floors)
+}
+
+// predatesPastEthereumSignatureCheckpoints returns true if the nonce of the
+// outgoing tx is at or below the floor of its type. The checkpoints of those
+// txs were produced before the chain indexed them, so pruned ones can't be
+// told apart from checkpoints the chain never produced.
+func (k Keeper) predatesPastEthereumSignatureCheckpoints(ctx sdk.Context, subject types.OutgoingTx) bool {
+	floors := k.getPastEthereumSignatureCheckpointFloors(ctx)
+	txType, nonce := types.OutgoingTxTypeAndNonce(subject)
+	return nonce <= floors.Floor(txType)
+}
+
+// getPastEthereumSignatureCheckpointFloors returns the nonces at or below which checkpoints were not indexed
+func (k Keeper) getPastEthereumSignatureCheckpointFloors(ctx sdk.Context) (floors types.PastEthereumSignatureCheckpointFloors) {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.PastEthereumSignatureCheckpointFloorsKey}); bz != nil {
+		k.cdc.MustUnmarshal(bz, &floors)
+	}
+	return
+}
+
+// setPastEthereumSignatureCheckpointFloors sets the nonces at or below which checkpoints were not indexed
+func (k Keeper) setPastEthereumSignatureCheckpointFloors(ctx sdk.Context, floors types.PastEthereumSignatureCheckpointFloors) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.PastEthereumSignatureCheckpointFloorsKey}, k.cdc.MustMarshal(&floors))
+}
+
+// iteratePastEthereumSignatureCheckpoints iterates over the indexed checkpoints the chain has produced
+func (k Keeper) iteratePastEthereumSignatureCheckpoints(ctx sdk.Context, cb func(past types.PastEthereumSignatureCheckpoint) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PastEthereumSignatureCheckpointKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// keys are the tx type, the 8 byte nonce and the checkpoint
+		key := iter.Key()
+		past := types.PastEthereumSignatureCheckpoint{
+			TxType:     uint32(key[0]),
+			Nonce:      binary.BigEndian.Uint64(key[1:9]),
+			Checkpoint: key[9:],
+			Height:     binary.BigEndian.Uint64(iter.Value()),
+		}
+		if cb(past) {
+			break
+		}
+	}
+}
+
+// iterateBadSignatureEvidence iterates over the punished bad signatures
+func (k Keeper) iterateBadSignatureEvidence(ctx sdk.Context, cb func(evidence *types.BadSignatureEvidence) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BadSignatureEvidenceKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// keys end with the 20 byte ethereum address
+		key := iter.Key()
+		evidence := &types.BadSignatureEvidence{
+			Checkpoint:      key[:len(key)-common.AddressLength],
+			EthereumAddress: common.BytesToAddress(key[len(key)-common.AddressLength:]).Hex(),
+			Height:          binary.BigEndian.Uint64(iter.Value()),
+		}
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
+// setBadSignatureEvidence records that a signature by the eth address over the checkpoint was punished
+func (k Keeper) setBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, ethAddr common.Address, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeBadSignatureEvidenceKey(checkpoint, ethAddr), sdk.Uint64ToBigEndian(height))
+}
+
+// getBadSignatureEvidence returns true if the eth address was already punished for signing the checkpoint
+func (k Keeper) getBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, ethAddr common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeBadSignatureEvidenceKey(checkpoint, ethAddr))
+}
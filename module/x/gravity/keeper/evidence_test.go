@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_PrunePastEthereumSignatureCheckpoints(t *testing.T) {
+	env := CreateTestEnv(t)
+	gk := env.GravityKeeper
+	ctx := env.Context.WithBlockHeight(100)
+
+	params := gk.GetParams(ctx)
+	params.PastEthereumSignatureCheckpointRetentionBlocks = 50
+	gk.setParams(ctx, params)
+
+	old := &types.SignerSetTx{Nonce: 1, Height: 10}
+	recent := &types.SignerSetTx{Nonce: 2, Height: 60}
+	oldBatch := &types.BatchTx{BatchNonce: 4, Timeout: 1000, TokenContract: TokenContractAddrs[0]}
+	gk.setPastEthereumSignatureCheckpoint(ctx, old, 10)
+	gk.setPastEthereumSignatureCheckpoint(ctx, recent, 60)
+	gk.setPastEthereumSignatureCheckpoint(ctx, oldBatch, 20)
+
+	gk.PrunePastEthereumSignatureCheckpoints(ctx)
+
+	// the pruned checkpoints are covered by the raised floors
+	require.False(t, gk.getPastEthereumSignatureCheckpoint(ctx, old))
+	require.False(t, gk.getPastEthereumSignatureCheckpoint(ctx, oldBatch))
+	require.True(t, gk.getPastEthereumSignatureCheckpoint(ctx, recent))
+	require.True(t, gk.predatesPastEthereumSignatureCheckpoints(ctx, old))
+	require.True(t, gk.predatesPastEthereumSignatureCheckpoints(ctx, oldBatch))
+	require.False(t, gk.predatesPastEthereumSignatureCheckpoints(ctx, recent))
+	require.Equal(t, types.PastEthereumSignatureCheckpointFloors{SignerSetNonce: 1, BatchNonce: 4}, gk.getPastEthereumSignatureCheckpointFloors(ctx))
+
+	ctx = ctx.WithBlockHeight(111)
+	gk.PrunePastEthereumSignatureCheckpoints(ctx)
+	require.False(t, gk.getPastEthereumSignatureCheckpoint(ctx, recent))
+	require.Equal(t, uint64(2), gk.getPastEthereumSignatureCheckpointFloors(ctx).SignerSetNonce)
+}
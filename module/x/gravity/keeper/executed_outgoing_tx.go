@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// newOutgoingTxExecution records the execution of an outgoing tx reported by
+// the event at the nonce, under the signer set observed so far
+func (k Keeper) newOutgoingTxExecution(ctx sdk.Context, eventNonce, ethereumHeight uint64) types.OutgoingTxExecution {
+	execution := types.OutgoingTxExecution{
+		EventNonce:     eventNonce,
+		EthereumHeight: ethereumHeight,
+		CosmosHeight:   uint64(ctx.BlockHeight()),
+	}
+	if signerSet := k.GetLastObservedSignerSetTx(ctx); signerSet != nil {
+		execution.SignerSetNonce = signerSet.Nonce
+	}
+	return execution
+}
+
+// archiveBatchTx keeps an executed batch in the archive
+func (k Keeper) archiveBatchTx(ctx sdk.Context, batch *types.BatchTx, execution types.OutgoingTxExecution) {
+	executed := types.ExecutedBatchTx{Batch: *batch, Execution: execution}
+	k.setExecutedOutgoingTx(ctx, batch.GetStoreIndex(), execution.CosmosHeight, k.cdc.MustMarshal(&executed))
+}
+
+// archiveContractCallTx keeps an executed contract call in the archive
+func (k Keeper) archiveContractCallTx(ctx sdk.Context, call *types.ContractCallTx, execution types.OutgoingTxExecution) {
+	executed := types.ExecutedContractCallTx{LogicCall: *call, Execution: execution}
+	k.setExecutedOutgoingTx(ctx, call.GetStoreIndex(), execution.CosmosHeight, k.cdc.MustMarshal(&executed))
+}
+
+func (k Keeper) setExecutedOutgoingTx(ctx sdk.Context, storeIndex []byte, cosmosHeight uint64, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeExecutedOutgoingTxKey(storeIndex), bz)
+	store.Set(types.MakeExecutedOutgoingTxHeightKey(cosmosHeight, storeIndex), []byte{0x1})
+}
+
+// GetExecutedBatchTx returns an archived batch, or nil if it is not in the archive
+func (k Keeper) GetExecutedBatchTx(ctx sdk.Context, tokenContract common.Address, nonce uint64) *types.ExecutedBatchTx {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeExecutedOutgoingTxKey(types.MakeBatchTxKey(tokenContract, nonce)))
+	if bz == nil {
+		return nil
+	}
+
+	var out types.ExecutedBatchTx
+	k.cdc.MustUnmarshal(bz, &out)
+	return &out
+}
+
+// GetExecutedContractCallTx returns an archived contract call, or nil if it is not in the archive
+func (k Keeper) GetExecutedContractCallTx(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) *types.ExecutedContractCallTx {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeExecutedOutgoingTxKey(types.MakeContractCallTxKey(invalidationScope, invalidationNonce)))
+	if bz == nil {
+		return nil
+	}
+
+	var out types.ExecutedContractCallTx
+	k.cdc.MustUnmarshal(bz, &out)
+	return &out
+}
+
+// iterateExecutedBatchTxs iterates through the archived batches in store index order
+func (k Keeper) iterateExecutedBatchTxs(ctx sdk.Context, cb func(types.ExecutedBatchTx) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeExecutedOutgoingTxKey([]byte{types.BatchTxPrefixByte}))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var executed types.ExecutedBatchTx
+		k.cdc.MustUnmarshal(iter.Value(), &executed)
+		if cb(executed) {
+			return
+		}
+	}
+}
+
+// iterateExecutedContractCallTxs iterates through the archived contract calls in store index order
+func (k Keeper) iterateExecutedContractCallTxs(ctx sdk.Context, cb func(types.ExecutedContractCallTx) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeExecutedOutgoingTxKey([]byte{types.ContractCallTxPrefixByte}))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var executed types.ExecutedContractCallTx
+		k.cdc.MustUnmarshal(iter.Value(), &executed)
+		if cb(executed) {
+			return
+		}
+	}
+}
+
+// PruneExecutedOutgoingTxs deletes the outgoing txs archived more than the
+// retention window ago
+func (k Keeper) PruneExecutedOutgoingTxs(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ExecutedOutgoingTxRetentionBlocks
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, []byte{types.ExecutedOutgoingTxHeightKey})
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())-retention))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(types.MakeExecutedOutgoingTxKey(key[8:]))
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_ExecutedBatchTxs(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	tokenA := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	tokenB := common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{Nonce: 4})
+
+	var eventNonce uint64
+	execute := func(cosmosHeight int64, token common.Address, nonce uint64) *types.BatchTx {
+		batch := &types.BatchTx{
+			BatchNonce:    nonce,
+			TokenContract: token.Hex(),
+			Transactions: []*types.SendToEthereum{
+				types.NewSendToEthereumTx(nonce, token, AccAddrs[0], EthAddrs[0], 100, 1),
+			},
+		}
+		gk.SetOutgoingTx(ctx, batch)
+
+		eventNonce++
+		ctx = ctx.WithBlockHeight(cosmosHeight)
+		require.NoError(t, gk.Handle(ctx, &types.BatchExecutedEvent{
+			TokenContract:  token.Hex(),
+			EventNonce:     eventNonce,
+			EthereumHeight: 100 + eventNonce,
+			BatchNonce:     nonce,
+		}))
+		require.Nil(t, gk.GetOutgoingTx(ctx, batch.GetStoreIndex()))
+		return batch
+	}
+	first := execute(10, tokenA, 1)
+	execute(20, tokenA, 2)
+	execute(30, tokenB, 3)
+
+	executed := gk.GetExecutedBatchTx(ctx, tokenA, 1)
+	require.NotNil(t, executed)
+	require.Equal(t, types.ExecutedBatchTx{
+		Batch: *first,
+		Execution: types.OutgoingTxExecution{
+			EventNonce:     1,
+			EthereumHeight: 101,
+			CosmosHeight:   10,
+			SignerSetNonce: 4,
+		},
+	}, *executed)
+
+	nonces := func(req *types.ExecutedBatchTxsRequest) (out []uint64) {
+		res, err := gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		for _, executed := range res.Batches {
+			out = append(out, executed.Batch.BatchNonce)
+		}
+		return out
+	}
+	require.Equal(t, []uint64{1, 2, 3}, nonces(&types.ExecutedBatchTxsRequest{}))
+	require.Equal(t, []uint64{1, 2}, nonces(&types.ExecutedBatchTxsRequest{TokenContract: tokenA.Hex()}))
+	require.Equal(t, []uint64{2}, nonces(&types.ExecutedBatchTxsRequest{TokenContract: tokenA.Hex(), SinceNonce: 1}))
+	require.Equal(t, []uint64{1, 2}, nonces(&types.ExecutedBatchTxsRequest{UntilNonce: 2}))
+
+	_, err := gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), &types.ExecutedBatchTxsRequest{TokenContract: "not-an-address"})
+	require.Error(t, err)
+
+	// a zero retention keeps the whole archive
+	ctx = ctx.WithBlockHeight(1000)
+	gk.PruneExecutedOutgoingTxs(ctx)
+	require.Equal(t, []uint64{1, 2, 3}, nonces(&types.ExecutedBatchTxsRequest{}))
+
+	params := gk.GetParams(ctx)
+	params.ExecutedOutgoingTxRetentionBlocks = 10
+	gk.setParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(31)
+	gk.PruneExecutedOutgoingTxs(ctx)
+	require.Equal(t, []uint64{3}, nonces(&types.ExecutedBatchTxsRequest{}))
+	require.Nil(t, gk.GetExecutedBatchTx(ctx, tokenA, 1))
+}
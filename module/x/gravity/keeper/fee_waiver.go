@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MandatoryOrchestratorMsgsSigner returns the orchestrator signing the msgs if
+// every one of them is a mandatory bridge message (an ethereum tx
+// confirmation, an ethereum event or an ethereum height vote) submitted by the
+// registered orchestrator of a bonded validator, and every one of them moves
+// that validator's bridge state forward: a valid signature of a stored
+// outgoing tx the validator has not yet submitted, the next expected event
+// nonce or a higher ethereum height. Otherwise nil is returned.
+func (k Keeper) MandatoryOrchestratorMsgsSigner(ctx sdk.Context, msgs []sdk.Msg) sdk.AccAddress {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	var (
+		orchestrator sdk.AccAddress
+		val          sdk.ValAddress
+		nextNonce    uint64
+		lastHeight   uint64
+		gravityID    []byte
+		signed       = make(map[string]bool)
+	)
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil
+		}
+
+		if orchestrator == nil {
+			orchestrator = signers[0]
+			if val = k.GetOrchestratorValidatorAddress(ctx, orchestrator); val == nil {
+				return nil
+			}
+			if validator := k.StakingKeeper.Validator(ctx, val); validator == nil || !validator.IsBonded() {
+				return nil
+			}
+			nextNonce = k.getLastEventNonceByValidator(ctx, val) + 1
+			lastHeight = k.GetEthereumHeightVote(ctx, val).EthereumHeight
+			gravityID = []byte(k.getGravityID(ctx))
+		} else if !orchestrator.Equals(signers[0]) {
+			return nil
+		}
+
+		switch msg := msg.(type) {
+		case *types.MsgSubmitEthereumTxConfirmation:
+			confirmation, err := types.UnpackConfirmation(msg.Confirmation)
+			if err != nil {
+				return nil
+			}
+			storeIndex := confirmation.GetStoreIndex()
+			if signed[string(storeIndex)] || k.getEthereumSignature(ctx, storeIndex, val) != nil {
+				return nil
+			}
+			otx := k.GetOutgoingTx(ctx, storeIndex)
+			if otx == nil {
+				return nil
+			}
+			ethAddress, ok := k.confirmationEthereumSigner(ctx, val, confirmation)
+			if !ok || types.ValidateEthereumSignature(otx.GetCheckpoint(gravityID), confirmation.GetSignature(), ethAddress) != nil {
+				return nil
+			}
+			signed[string(storeIndex)] = true
+
+		case *types.MsgSubmitEthereumEvent:
+			event, err := types.UnpackEvent(msg.Event)
+			if err != nil || event.GetEventNonce() != nextNonce {
+				return nil
+			}
+			nextNonce++
+
+		case *types.MsgEthereumHeightVote:
+			if msg.EthereumHeight <= lastHeight {
+				return nil
+			}
+			lastHeight = msg.EthereumHeight
+
+		default:
+			return nil
+		}
+	}
+
+	return orchestrator
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_MandatoryOrchestratorMsgsSigner(t *testing.T) {
+	ethPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+
+		// a bonded validator without a registered orchestrator
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr2    = sdk.ValAddress(orcAddr2)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	confirmation := &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		EthereumSigner: ethAddr1.Hex(),
+		Signature:      signature,
+	}
+	packedConfirmation, err := types.PackConfirmation(confirmation)
+	require.NoError(t, err)
+	confirmationMsg := &types.MsgSubmitEthereumTxConfirmation{Confirmation: packedConfirmation, Signer: orcAddr1.String()}
+
+	eventMsg := func(signer sdk.AccAddress, nonce uint64) sdk.Msg {
+		event, err := types.PackEvent(&types.SignerSetTxExecutedEvent{
+			EventNonce:       nonce,
+			SignerSetTxNonce: signerSetTx.Nonce,
+			EthereumHeight:   100,
+		})
+		require.NoError(t, err)
+		return &types.MsgSubmitEthereumEvent{Event: event, Signer: signer.String()}
+	}
+	heightMsg := &types.MsgEthereumHeightVote{EthereumHeight: 100, Signer: orcAddr1.String()}
+
+	require.Equal(t, orcAddr1, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{confirmationMsg, eventMsg(orcAddr1, 1), eventMsg(orcAddr1, 2), heightMsg}))
+
+	// not a registered orchestrator
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{eventMsg(orcAddr2, 1)}))
+	// out of order or repeated event nonces
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{eventMsg(orcAddr1, 2)}))
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{eventMsg(orcAddr1, 1), eventMsg(orcAddr1, 1)}))
+	// a confirmation not signed by the validator's Ethereum key
+	otherEthPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	badSignature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), otherEthPrivKey)
+	require.NoError(t, err)
+	badConfirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		EthereumSigner: ethAddr1.Hex(),
+		Signature:      badSignature,
+	})
+	require.NoError(t, err)
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{&types.MsgSubmitEthereumTxConfirmation{Confirmation: badConfirmation, Signer: orcAddr1.String()}}))
+	// the same confirmation twice
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{confirmationMsg, confirmationMsg}))
+	// any other message
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{heightMsg, types.NewMsgCancelSendToEthereum(1, orcAddr1)}))
+
+	// once submitted, the same messages are duplicates
+	gk.SetEthereumSignature(ctx, confirmation, valAddr1)
+	gk.setLastEventNonceByValidator(ctx, valAddr1, 1)
+	gk.SetEthereumHeightVote(ctx, valAddr1, 100)
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{confirmationMsg}))
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{eventMsg(orcAddr1, 1)}))
+	require.Nil(t, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{heightMsg}))
+	require.Equal(t, orcAddr1, gk.MandatoryOrchestratorMsgsSigner(ctx, []sdk.Msg{eventMsg(orcAddr1, 2)}))
+}
@@ -19,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setUnbatchedSendToEthereum(ctx, tx)
 	}
 
+	// reset last observed event nonce, before the vote records so that only
+	// the ones that may still be observed are indexed as pending
+	k.setLastObservedEventNonce(ctx, data.LastObservedEventNonce)
+
 	// reset ethereum event vote records in state
 	for _, evr := range data.EthereumEventVoteRecords {
 		event, err := types.UnpackEvent(evr.Event)
@@ -31,9 +35,6 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), evr)
 	}
 
-	// reset last observed event nonce
-	k.setLastObservedEventNonce(ctx, data.LastObservedEventNonce)
-
 	// reset attestation state of all validators
 	for _, eventVoteRecord := range data.EthereumEventVoteRecords {
 		event, _ := types.UnpackEvent(eventVoteRecord.Event)
@@ -64,6 +65,25 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// set the ethereum address
 		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
 		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+		// validators that haven't voted on any of the events
+		k.seedLastEventNonceByValidator(ctx, val)
+	}
+
+	// reset pending key rotations along with the keys they replace, which stay
+	// valid until the rotation completes
+	for _, rotation := range data.EthereumKeyRotations {
+		val, err := sdk.ValAddressFromBech32(rotation.ValidatorAddress)
+		if err != nil {
+			panic(fmt.Sprintf("invalid validator address in key rotation: %s", err))
+		}
+		oldOrch, err := sdk.AccAddressFromBech32(rotation.OldOrchestratorAddress)
+		if err != nil {
+			panic(fmt.Sprintf("invalid orchestrator address in key rotation: %s", err))
+		}
+
+		k.SetOrchestratorValidatorAddress(ctx, val, oldOrch)
+		k.setEthereumOrchestratorAddress(ctx, common.HexToAddress(rotation.OldEthereumAddress), oldOrch)
+		k.setEthereumKeyRotation(ctx, val, rotation)
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
@@ -80,6 +100,54 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOutgoingTx(ctx, otx)
 	}
 
+	// reset the checkpoints produced by the chain, including those of pruned
+	// outgoing txs, and the nonces at or below which they were not indexed
+	store := ctx.KVStore(k.storeKey)
+	for _, past := range data.PastEthereumSignatureCheckpoints {
+		key := types.MakePastEthereumSignatureCheckpointKey(byte(past.TxType), past.Nonce, past.Checkpoint)
+		store.Set(key, sdk.Uint64ToBigEndian(past.Height))
+	}
+	k.setPastEthereumSignatureCheckpointFloors(ctx, data.PastEthereumSignatureCheckpointFloors)
+
+	// reset the executed tx archive, which also rebuilds its height index
+	for i := range data.ExecutedBatchTxs {
+		executed := data.ExecutedBatchTxs[i]
+		k.archiveBatchTx(ctx, &executed.Batch, executed.Execution)
+	}
+	for i := range data.ExecutedContractCallTxs {
+		executed := data.ExecutedContractCallTxs[i]
+		k.archiveContractCallTx(ctx, &executed.LogicCall, executed.Execution)
+	}
+
+	// reset the history of signer sets observed on Ethereum
+	for _, observed := range data.ObservedSignerSets {
+		k.setObservedSignerSet(ctx, observed)
+	}
+
+	// reset validator bridge stats
+	for _, stats := range data.ValidatorBridgeStats {
+		val, err := sdk.ValAddressFromBech32(stats.ValidatorAddress)
+		if err != nil {
+			panic(fmt.Sprintf("invalid validator address in bridge stats: %s", err))
+		}
+		k.setValidatorBridgeStats(ctx, val, stats)
+	}
+	k.setBridgeStatsEpoch(ctx, data.BridgeStatsEpoch)
+
+	// reset the reward pool participation and payouts of validators
+	for _, rewards := range data.ValidatorRewards {
+		val, err := sdk.ValAddressFromBech32(rewards.ValidatorAddress)
+		if err != nil {
+			panic(fmt.Sprintf("invalid validator address in validator rewards: %s", err))
+		}
+		k.setValidatorRewards(ctx, val, rewards)
+	}
+
+	// reset punished bad signatures
+	for _, evidence := range data.BadSignatureEvidence {
+		k.setBadSignatureEvidence(ctx, evidence.Checkpoint, common.HexToAddress(evidence.EthereumAddress), evidence.Height)
+	}
+
 	// reset signatures in state
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
@@ -106,6 +174,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms            []*types.ERC20ToDenom
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		pastCheckpoints          []types.PastEthereumSignatureCheckpoint
+		badSignatureEvidence     []*types.BadSignatureEvidence
+		validatorBridgeStats     []types.ValidatorBridgeStats
+		ethereumKeyRotations     []types.EthereumKeyRotation
+		validatorRewards         []types.ValidatorRewards
+		observedSignerSets       []types.ObservedSignerSet
+		executedBatchTxs         []types.ExecutedBatchTx
+		executedContractCallTxs  []types.ExecutedContractCallTx
 	)
 
 	// export ethereumEventVoteRecords from state
@@ -159,19 +235,74 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// export the checkpoints produced by the chain and the punished bad signatures
+	k.iteratePastEthereumSignatureCheckpoints(ctx, func(past types.PastEthereumSignatureCheckpoint) bool {
+		pastCheckpoints = append(pastCheckpoints, past)
+		return false
+	})
+	k.iterateBadSignatureEvidence(ctx, func(evidence *types.BadSignatureEvidence) bool {
+		badSignatureEvidence = append(badSignatureEvidence, evidence)
+		return false
+	})
+
+	// export the executed tx archive
+	k.iterateExecutedBatchTxs(ctx, func(executed types.ExecutedBatchTx) bool {
+		executedBatchTxs = append(executedBatchTxs, executed)
+		return false
+	})
+	k.iterateExecutedContractCallTxs(ctx, func(executed types.ExecutedContractCallTx) bool {
+		executedContractCallTxs = append(executedContractCallTxs, executed)
+		return false
+	})
+
+	// export the history of signer sets observed on Ethereum
+	k.IterateObservedSignerSets(ctx, func(observed types.ObservedSignerSet) bool {
+		observedSignerSets = append(observedSignerSets, observed)
+		return false
+	})
+
+	// export the validator bridge stats as stored, the ones counted over a past
+	// epoch are reset when they are next read
+	k.iterateValidatorBridgeStats(ctx, func(stats types.ValidatorBridgeStats) bool {
+		validatorBridgeStats = append(validatorBridgeStats, stats)
+		return false
+	})
+
+	// export the reward pool participation and payouts of validators
+	k.iterateValidatorRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorRewards) bool {
+		validatorRewards = append(validatorRewards, rewards)
+		return false
+	})
+
+	// export the pending key rotations
+	k.iterateEthereumKeyRotations(ctx, func(_ sdk.ValAddress, rotation types.EthereumKeyRotation) bool {
+		ethereumKeyRotations = append(ethereumKeyRotations, rotation)
+		return false
+	})
+
 	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
 	for _, delegate := range delegates {
 		delegate.EthSignature = []byte("unused")
 	}
 
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                                &p,
+		LastObservedEventNonce:                lastobserved,
+		OutgoingTxs:                           outgoingTxs,
+		Confirmations:                         ethereumTxConfirmations,
+		EthereumEventVoteRecords:              ethereumEventVoteRecords,
+		DelegateKeys:                          delegates,
+		Erc20ToDenoms:                         erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:            unbatchedTransfers,
+		PastEthereumSignatureCheckpoints:      pastCheckpoints,
+		PastEthereumSignatureCheckpointFloors: k.getPastEthereumSignatureCheckpointFloors(ctx),
+		BadSignatureEvidence:                  badSignatureEvidence,
+		ValidatorBridgeStats:                  validatorBridgeStats,
+		BridgeStatsEpoch:                      k.GetBridgeStatsEpoch(ctx),
+		EthereumKeyRotations:                  ethereumKeyRotations,
+		ValidatorRewards:                      validatorRewards,
+		ObservedSignerSets:                    observedSignerSets,
+		ExecutedBatchTxs:                      executedBatchTxs,
+		ExecutedContractCallTxs:               executedContractCallTxs,
 	}
 }
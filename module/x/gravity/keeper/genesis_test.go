@@ -6,6 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -34,3 +37,167 @@ func TestExportAndImport(t *testing.T) {
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
 }
+
+func TestExportAndImportPastEthereumSignatureCheckpoints(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context.WithBlockHeight(10)
+	keeper := env.GravityKeeper
+
+	floors := types.PastEthereumSignatureCheckpointFloors{SignerSetNonce: 3, BatchNonce: 5, ContractCallInvalidationNonce: 7}
+	keeper.setPastEthereumSignatureCheckpointFloors(ctx, floors)
+	pruned := &types.BatchTx{BatchNonce: 6, Timeout: 1000, TokenContract: EthAddrs[1].Hex()}
+	keeper.setPastEthereumSignatureCheckpoint(ctx, pruned, 10)
+	badCheckpoint := []byte("bad checkpoint")
+	keeper.setBadSignatureEvidence(ctx, badCheckpoint, EthAddrs[0], 10)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, floors, newKeeper.getPastEthereumSignatureCheckpointFloors(newCtx))
+	require.True(t, newKeeper.getPastEthereumSignatureCheckpoint(newCtx, pruned))
+	require.Equal(t, exportedGenesis.PastEthereumSignatureCheckpoints, ExportGenesis(newCtx, newKeeper).PastEthereumSignatureCheckpoints)
+	require.True(t, newKeeper.getBadSignatureEvidence(newCtx, badCheckpoint, EthAddrs[0]))
+	require.Equal(t, exportedGenesis.BadSignatureEvidence, ExportGenesis(newCtx, newKeeper).BadSignatureEvidence)
+}
+
+func TestExportAndImportValidatorBridgeStats(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context.WithBlockHeight(10)
+	keeper := env.GravityKeeper
+
+	keeper.incrementObservedEvents(ctx)
+	keeper.StartBridgeStatsEpoch(ctx)
+	keeper.incrementObservedEvents(ctx)
+	keeper.IncrementExpectedConfirmations(ctx, ValAddrs[0])
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context.WithBlockHeight(10)
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, keeper.GetBridgeStatsEpoch(ctx), newKeeper.GetBridgeStatsEpoch(newCtx))
+	require.Equal(t, keeper.GetValidatorBridgeStats(ctx, ValAddrs[0]), newKeeper.GetValidatorBridgeStats(newCtx, ValAddrs[0]))
+	require.Equal(t, uint64(1), newKeeper.GetValidatorBridgeStats(newCtx, ValAddrs[0]).ObservedEvents)
+}
+
+func TestExportAndImportEthereumKeyRotations(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	keeper := input.GravityKeeper
+
+	// rotate the first validator to a new Ethereum key and orchestrator
+	newEthAddr := common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+	newOrchAddr := sdk.AccAddress("rotated_orchestrator")
+	keeper.SetOrchestratorValidatorAddress(ctx, ValAddrs[0], newOrchAddr)
+	keeper.setValidatorEthereumAddress(ctx, ValAddrs[0], newEthAddr)
+	keeper.setEthereumOrchestratorAddress(ctx, newEthAddr, newOrchAddr)
+	rotation := types.EthereumKeyRotation{
+		ValidatorAddress:       ValAddrs[0].String(),
+		OldEthereumAddress:     EthAddrs[0].Hex(),
+		OldOrchestratorAddress: AccAddrs[0].String(),
+		SignerSetNonce:         7,
+	}
+	keeper.setEthereumKeyRotation(ctx, ValAddrs[0], rotation)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, []types.EthereumKeyRotation{rotation}, exportedGenesis.EthereumKeyRotations)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, &rotation, newKeeper.GetEthereumKeyRotation(newCtx, ValAddrs[0]))
+	require.Equal(t, newEthAddr, newKeeper.GetValidatorEthereumAddress(newCtx, ValAddrs[0]))
+	// the replaced keys still belong to the validator until the rotation completes
+	require.Equal(t, AccAddrs[0], newKeeper.GetEthereumOrchestratorAddress(newCtx, EthAddrs[0]))
+	require.Equal(t, ValAddrs[0], newKeeper.GetOrchestratorValidatorAddress(newCtx, AccAddrs[0]))
+	require.Equal(t, ValAddrs[0], newKeeper.GetValidatorForEthereumAddress(newCtx, EthAddrs[0]))
+
+	newKeeper.completeEthereumKeyRotations(newCtx, 7)
+	require.Nil(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, EthAddrs[0]))
+	require.Nil(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, AccAddrs[0]))
+}
+
+func TestExportAndImportValidatorRewards(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	distributed := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	keeper.incrementRewardPoolParticipation(ctx, ValAddrs[0])
+	keeper.incrementRewardPoolParticipation(ctx, ValAddrs[0])
+	keeper.setValidatorRewards(ctx, ValAddrs[1], types.ValidatorRewards{
+		ValidatorAddress: ValAddrs[1].String(),
+		Participation:    1,
+		Distributed:      distributed,
+	})
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.EqualValues(t, 3, newKeeper.GetRewardPoolTotalParticipation(newCtx))
+	require.Equal(t, keeper.GetValidatorRewards(ctx, ValAddrs[0]), newKeeper.GetValidatorRewards(newCtx, ValAddrs[0]))
+	require.Equal(t, distributed, newKeeper.GetValidatorRewards(newCtx, ValAddrs[1]).Distributed)
+}
+
+func TestExportAndImportObservedSignerSets(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	signers := types.EthereumSigners{{Power: 100, EthereumAddress: EthAddrs[0].Hex()}}
+	first := types.ObservedSignerSet{SignerSetNonce: 1, Signers: signers, EthereumHeight: 100, CosmosHeight: 10}
+	second := types.ObservedSignerSet{SignerSetNonce: 2, Signers: signers, EthereumHeight: 200, CosmosHeight: 20}
+	keeper.setObservedSignerSet(ctx, first)
+	keeper.setObservedSignerSet(ctx, second)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, []types.ObservedSignerSet{first, second}, exportedGenesis.ObservedSignerSets)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, &first, newKeeper.GetObservedSignerSetAtEthereumHeight(newCtx, 150))
+	require.Equal(t, &second, newKeeper.GetObservedSignerSetAtEthereumHeight(newCtx, 200))
+}
+
+func TestExportAndImportExecutedOutgoingTxs(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	batch := &types.BatchTx{BatchNonce: 1, Timeout: 1000, TokenContract: tokenContract.Hex(), Height: 5}
+	call := &types.ContractCallTx{InvalidationScope: []byte("scope"), InvalidationNonce: 2, Address: tokenContract.Hex(), Timeout: 1000}
+	keeper.archiveBatchTx(ctx, batch, types.OutgoingTxExecution{EventNonce: 1, EthereumHeight: 100, CosmosHeight: 10})
+	keeper.archiveContractCallTx(ctx, call, types.OutgoingTxExecution{EventNonce: 2, EthereumHeight: 200, CosmosHeight: 20})
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	require.Len(t, exportedGenesis.ExecutedBatchTxs, 1)
+	require.Len(t, exportedGenesis.ExecutedContractCallTxs, 1)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	require.Equal(t, keeper.GetExecutedBatchTx(ctx, tokenContract, 1), newKeeper.GetExecutedBatchTx(newCtx, tokenContract, 1))
+	require.Equal(t, keeper.GetExecutedContractCallTx(ctx, []byte("scope"), 2), newKeeper.GetExecutedContractCallTx(newCtx, []byte("scope"), 2))
+
+	// the height index is rebuilt so the archive is still pruned
+	params := newKeeper.GetParams(newCtx)
+	params.ExecutedOutgoingTxRetentionBlocks = 5
+	newKeeper.setParams(newCtx, params)
+	newKeeper.PruneExecutedOutgoingTxs(newCtx.WithBlockHeight(20))
+	require.Nil(t, newKeeper.GetExecutedBatchTx(newCtx, tokenContract, 1))
+	require.NotNil(t, newKeeper.GetExecutedContractCallTx(newCtx, []byte("scope"), 2))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -100,17 +101,25 @@ func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxReque
 	return &types.ContractCallTxResponse{LogicCall: cctx}, nil
 }
 
+// inNonceRange reports whether a nonce is above since and, if until is set, not above until
+func inNonceRange(nonce, since, until uint64) bool {
+	return nonce > since && (until == 0 || nonce <= until)
+}
+
 func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest) (*types.SignerSetTxsResponse, error) {
 	var signers []*types.SignerSetTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
-		signer, ok := otx.(*types.SignerSetTx)
-		if !ok {
-			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for %s", otx))
-		}
-		signers = append(signers, signer)
-
-		return true
-	})
+	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte,
+		func(otx types.OutgoingTx) bool {
+			signer, ok := otx.(*types.SignerSetTx)
+			if !ok {
+				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for %s", otx))
+			}
+			return inNonceRange(signer.Nonce, req.SinceNonce, req.UntilNonce)
+		},
+		func(_ []byte, otx types.OutgoingTx) {
+			signers = append(signers, otx.(*types.SignerSetTx))
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -118,16 +127,29 @@ func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest)
 	return &types.SignerSetTxsResponse{SignerSets: signers, Pagination: pageRes}, nil
 }
 
+func (k Keeper) SignerSetDiff(c context.Context, req *types.SignerSetDiffRequest) (*types.SignerSetDiffResponse, error) {
+	res := k.GetSignerSetDiff(sdk.UnwrapSDKContext(c))
+	return &res, nil
+}
+
 func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.BatchTxsResponse, error) {
+	if req.TokenContract != "" && !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
 	var batches []*types.BatchTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
-		batch, ok := otx.(*types.BatchTx)
-		if !ok {
-			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
-		}
-		batches = append(batches, batch)
-		return true
-	})
+	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.BatchTxPrefixByte,
+		func(otx types.OutgoingTx) bool {
+			batch, ok := otx.(*types.BatchTx)
+			if !ok {
+				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
+			}
+			return matchesTokenContract(batch.TokenContract, req.TokenContract) && inNonceRange(batch.BatchNonce, req.SinceNonce, req.UntilNonce)
+		},
+		func(_ []byte, otx types.OutgoingTx) {
+			batches = append(batches, otx.(*types.BatchTx))
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -137,14 +159,21 @@ func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.
 
 func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsRequest) (*types.ContractCallTxsResponse, error) {
 	var calls []*types.ContractCallTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
-		call, ok := otx.(*types.ContractCallTx)
-		if !ok {
-			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
-		}
-		calls = append(calls, call)
-		return true
-	})
+	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte,
+		func(otx types.OutgoingTx) bool {
+			call, ok := otx.(*types.ContractCallTx)
+			if !ok {
+				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
+			}
+			if len(req.InvalidationScope) != 0 && !bytes.Equal(call.InvalidationScope, req.InvalidationScope) {
+				return false
+			}
+			return inNonceRange(call.InvalidationNonce, req.SinceNonce, req.UntilNonce)
+		},
+		func(_ []byte, otx types.OutgoingTx) {
+			calls = append(calls, otx.(*types.ContractCallTx))
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -157,16 +186,18 @@ func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSet
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
 
 	var out []*types.SignerSetTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	pageRes, err := k.paginateEthereumSignatures(ctx, key, req.Pagination, func(val sdk.ValAddress, sig []byte) {
 		out = append(out, &types.SignerSetTxConfirmation{
 			SignerSetNonce: req.SignerSetNonce,
 			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
 			Signature:      sig,
 		})
-		return false
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.SignerSetTxConfirmationsResponse{Signatures: out}, nil
+	return &types.SignerSetTxConfirmationsResponse{Signatures: out, Pagination: pageRes}, nil
 }
 
 func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfirmationsRequest) (*types.BatchTxConfirmationsResponse, error) {
@@ -174,16 +205,19 @@ func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfir
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
 
 	var out []*types.BatchTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	pageRes, err := k.paginateEthereumSignatures(ctx, key, req.Pagination, func(val sdk.ValAddress, sig []byte) {
 		out = append(out, &types.BatchTxConfirmation{
 			TokenContract:  req.TokenContract,
 			BatchNonce:     req.BatchNonce,
 			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
 			Signature:      sig,
 		})
-		return false
 	})
-	return &types.BatchTxConfirmationsResponse{Signatures: out}, nil
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BatchTxConfirmationsResponse{Signatures: out, Pagination: pageRes}, nil
 }
 
 func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.ContractCallTxConfirmationsRequest) (*types.ContractCallTxConfirmationsResponse, error) {
@@ -191,16 +225,19 @@ func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.Contra
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
 
 	var out []*types.ContractCallTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	pageRes, err := k.paginateEthereumSignatures(ctx, key, req.Pagination, func(val sdk.ValAddress, sig []byte) {
 		out = append(out, &types.ContractCallTxConfirmation{
 			InvalidationScope: req.InvalidationScope,
 			InvalidationNonce: req.InvalidationNonce,
 			EthereumSigner:    k.GetValidatorEthereumAddress(ctx, val).Hex(),
 			Signature:         sig,
 		})
-		return false
 	})
-	return &types.ContractCallTxConfirmationsResponse{Signatures: out}, nil
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ContractCallTxConfirmationsResponse{Signatures: out, Pagination: pageRes}, nil
 }
 
 func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSignerSetTxsRequest) (*types.UnsignedSignerSetTxsResponse, error) {
@@ -209,19 +246,15 @@ func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSigne
 	if err != nil {
 		return nil, err
 	}
+	otxs, pageRes, err := k.paginatePendingOutgoingTxs(ctx, val, []byte{types.SignerSetTxPrefixByte}, sdk.Uint64ToBigEndian(req.SinceNonce), req.Pagination, nil)
+	if err != nil {
+		return nil, err
+	}
 	var signerSets []*types.SignerSetTx
-	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
-		if len(sig) == 0 { // it's pending
-			signerSet, ok := otx.(*types.SignerSetTx)
-			if !ok {
-				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for %s", otx))
-			}
-			signerSets = append(signerSets, signerSet)
-		}
-		return false
-	})
-	return &types.UnsignedSignerSetTxsResponse{SignerSets: signerSets}, nil
+	for _, otx := range otxs {
+		signerSets = append(signerSets, otx.(*types.SignerSetTx))
+	}
+	return &types.UnsignedSignerSetTxsResponse{SignerSets: signerSets, Pagination: pageRes}, nil
 }
 
 func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsRequest) (*types.UnsignedBatchTxsResponse, error) {
@@ -230,19 +263,31 @@ func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsR
 	if err != nil {
 		return nil, err
 	}
+	if req.TokenContract != "" && !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+	// batches are indexed by contract then nonce, so the since nonce can
+	// only be seeked to once the query is narrowed to a single contract
+	var (
+		otxs    []types.OutgoingTx
+		pageRes *query.PageResponse
+	)
+	if req.TokenContract != "" {
+		indexPrefix := append([]byte{types.BatchTxPrefixByte}, common.HexToAddress(req.TokenContract).Bytes()...)
+		otxs, pageRes, err = k.paginatePendingOutgoingTxs(ctx, val, indexPrefix, sdk.Uint64ToBigEndian(req.SinceNonce), req.Pagination, nil)
+	} else {
+		otxs, pageRes, err = k.paginatePendingOutgoingTxs(ctx, val, []byte{types.BatchTxPrefixByte}, nil, req.Pagination, func(otx types.OutgoingTx) bool {
+			return otx.(*types.BatchTx).BatchNonce > req.SinceNonce
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
 	var batches []*types.BatchTx
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
-		if len(sig) == 0 { // it's pending
-			batch, ok := otx.(*types.BatchTx)
-			if !ok {
-				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
-			}
-			batches = append(batches, batch)
-		}
-		return false
-	})
-	return &types.UnsignedBatchTxsResponse{Batches: batches}, nil
+	for _, otx := range otxs {
+		batches = append(batches, otx.(*types.BatchTx))
+	}
+	return &types.UnsignedBatchTxsResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
 func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedContractCallTxsRequest) (*types.UnsignedContractCallTxsResponse, error) {
@@ -251,19 +296,186 @@ func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedCo
 	if err != nil {
 		return nil, err
 	}
+	otxs, pageRes, err := k.paginatePendingOutgoingTxs(ctx, val, []byte{types.ContractCallTxPrefixByte}, nil, req.Pagination, func(otx types.OutgoingTx) bool {
+		// calls are indexed by scope then nonce, so the since nonce is filtered
+		return otx.(*types.ContractCallTx).InvalidationNonce > req.SinceNonce
+	})
+	if err != nil {
+		return nil, err
+	}
 	var calls []*types.ContractCallTx
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
-		if len(sig) == 0 { // it's pending
-			call, ok := otx.(*types.ContractCallTx)
-			if !ok {
-				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
+	for _, otx := range otxs {
+		calls = append(calls, otx.(*types.ContractCallTx))
+	}
+	return &types.UnsignedContractCallTxsResponse{Calls: calls, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RelayableSignerSetTxs(c context.Context, req *types.RelayableSignerSetTxsRequest) (*types.RelayableSignerSetTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	current := k.currentRelaySignerSet(ctx)
+	if current == nil {
+		return &types.RelayableSignerSetTxsResponse{}, nil
+	}
+
+	res := &types.RelayableSignerSetTxsResponse{CurrentSignerSet: current}
+	pageRes, err := k.paginateRelayableOutgoingTxs(ctx, current, types.SignerSetTxPrefixByte, req.Pagination,
+		func(otx types.OutgoingTx) bool {
+			return otx.(*types.SignerSetTx).Nonce > current.Nonce
+		},
+		func(otx types.OutgoingTx, signatures types.RelaySignatures) {
+			res.SignerSets = append(res.SignerSets, types.RelayableSignerSetTx{SignerSet: otx.(*types.SignerSetTx), Signatures: signatures})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) RelayableBatchTxs(c context.Context, req *types.RelayableBatchTxsRequest) (*types.RelayableBatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	current := k.currentRelaySignerSet(ctx)
+	if current == nil {
+		return &types.RelayableBatchTxsResponse{}, nil
+	}
+
+	res := &types.RelayableBatchTxsResponse{CurrentSignerSet: current}
+	pageRes, err := k.paginateRelayableOutgoingTxs(ctx, current, types.BatchTxPrefixByte, req.Pagination,
+		func(types.OutgoingTx) bool { return true },
+		func(otx types.OutgoingTx, signatures types.RelaySignatures) {
+			res.Batches = append(res.Batches, types.RelayableBatchTx{Batch: otx.(*types.BatchTx), Signatures: signatures})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) RelayableContractCallTxs(c context.Context, req *types.RelayableContractCallTxsRequest) (*types.RelayableContractCallTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	current := k.currentRelaySignerSet(ctx)
+	if current == nil {
+		return &types.RelayableContractCallTxsResponse{}, nil
+	}
+
+	res := &types.RelayableContractCallTxsResponse{CurrentSignerSet: current}
+	pageRes, err := k.paginateRelayableOutgoingTxs(ctx, current, types.ContractCallTxPrefixByte, req.Pagination,
+		func(types.OutgoingTx) bool { return true },
+		func(otx types.OutgoingTx, signatures types.RelaySignatures) {
+			res.Calls = append(res.Calls, types.RelayableContractCallTx{LogicCall: otx.(*types.ContractCallTx), Signatures: signatures})
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) SignerSetTxRelayCalldata(c context.Context, req *types.SignerSetTxRelayCalldataRequest) (*types.SignerSetTxRelayCalldataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	calldata, err := k.relayCalldata(ctx, types.MakeSignerSetTxKey(req.SignerSetNonce),
+		func(otx types.OutgoingTx, current *types.SignerSetTx, signatures types.RelaySignatures) ([]byte, error) {
+			signerSet := otx.(*types.SignerSetTx)
+			if signerSet.Nonce <= current.Nonce {
+				return nil, status.Errorf(codes.FailedPrecondition, "signer set %d is not newer than the observed signer set %d", signerSet.Nonce, current.Nonce)
 			}
-			calls = append(calls, call)
+			return types.UpdateValsetCalldata(signerSet, current, signatures)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignerSetTxRelayCalldataResponse{
+		BridgeEthereumAddress: k.GetParams(ctx).BridgeEthereumAddress,
+		Calldata:              calldata,
+	}, nil
+}
+
+func (k Keeper) BatchTxRelayCalldata(c context.Context, req *types.BatchTxRelayCalldataRequest) (*types.BatchTxRelayCalldataResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	calldata, err := k.relayCalldata(ctx, types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce),
+		func(otx types.OutgoingTx, current *types.SignerSetTx, signatures types.RelaySignatures) ([]byte, error) {
+			return types.SubmitBatchCalldata(otx.(*types.BatchTx), current, signatures)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BatchTxRelayCalldataResponse{
+		BridgeEthereumAddress: k.GetParams(ctx).BridgeEthereumAddress,
+		Calldata:              calldata,
+	}, nil
+}
+
+func (k Keeper) ContractCallTxRelayCalldata(c context.Context, req *types.ContractCallTxRelayCalldataRequest) (*types.ContractCallTxRelayCalldataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	calldata, err := k.relayCalldata(ctx, types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce),
+		func(otx types.OutgoingTx, current *types.SignerSetTx, signatures types.RelaySignatures) ([]byte, error) {
+			return types.SubmitLogicCallCalldata(otx.(*types.ContractCallTx), current, signatures)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ContractCallTxRelayCalldataResponse{
+		BridgeEthereumAddress: k.GetParams(ctx).BridgeEthereumAddress,
+		Calldata:              calldata,
+	}, nil
+}
+
+func (k Keeper) Checkpoint(c context.Context, req *types.CheckpointRequest) (*types.CheckpointResponse, error) {
+	var (
+		key      []byte
+		selected int
+	)
+	if req.SignerSetNonce != 0 {
+		key = types.MakeSignerSetTxKey(req.SignerSetNonce)
+		selected++
+	}
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
 		}
-		return false
-	})
-	return &types.UnsignedContractCallTxsResponse{Calls: calls}, nil
+		key = types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
+		selected++
+	}
+	if len(req.InvalidationScope) != 0 {
+		key = types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
+		selected++
+	}
+	if selected != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of a signer set, batch or contract call must be given")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	otx := k.GetOutgoingTx(ctx, key)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for %X", key)
+	}
+
+	gravityID := k.getGravityID(ctx)
+	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+
+	return &types.CheckpointResponse{
+		GravityId:       gravityID,
+		Checkpoint:      checkpoint,
+		SignatureDigest: types.EthereumSignatureDigest(checkpoint),
+		Fields:          types.CheckpointFields(otx, []byte(gravityID)),
+	}, nil
 }
 
 func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSubmittedEthereumEventRequest) (*types.LastSubmittedEthereumEventResponse, error) {
@@ -280,24 +492,49 @@ func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSub
 }
 
 func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*types.BatchTxFeesResponse, error) {
+	if req.TokenContract != "" && !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	res := &types.BatchTxFeesResponse{}
 
 	// TODO: is this what we want here?
 	// Should this calculation return a
 	// map[contract_address]fees or something similar?
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		btx, _ := otx.(*types.BatchTx)
-		for _, tx := range btx.Transactions {
-			_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(tx.Erc20Fee.Contract))
-			res.Fees = append(res.Fees, sdk.NewCoin(denom, tx.Erc20Fee.Amount))
-		}
-		return false
-	})
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, req.Pagination, types.BatchTxPrefixByte,
+		func(otx types.OutgoingTx) bool {
+			return matchesTokenContract(otx.(*types.BatchTx).TokenContract, req.TokenContract)
+		},
+		func(_ []byte, otx types.OutgoingTx) {
+			for _, tx := range otx.(*types.BatchTx).Transactions {
+				_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(tx.Erc20Fee.Contract))
+				res.Fees = append(res.Fees, sdk.NewCoin(denom, tx.Erc20Fee.Amount))
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
 
 	return res, nil
 }
 
+func (k Keeper) NextBatchPreview(c context.Context, req *types.NextBatchPreviewRequest) (*types.NextBatchPreviewResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	maxElements := BatchTxSize
+	if req.MaxElements != 0 {
+		maxElements = int(req.MaxElements)
+	}
+
+	res := k.GetNextBatchPreview(sdk.UnwrapSDKContext(c), common.HexToAddress(req.TokenContract), maxElements)
+	return &res, nil
+}
+
 func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest) (*types.ERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
@@ -366,37 +603,73 @@ func (k Keeper) DenomToERC20(c context.Context, req *types.DenomToERC20Request)
 	return res, nil
 }
 
+// matchesSendToEthereum reports whether a send passes the optional sender and token contract filters
+func matchesSendToEthereum(ste *types.SendToEthereum, sender, tokenContract string) bool {
+	return (sender == "" || ste.Sender == sender) && matchesTokenContract(ste.Erc20Token.Contract, tokenContract)
+}
+
+// matchesTokenContract reports whether a token contract passes an optional filter
+func matchesTokenContract(contract, filter string) bool {
+	return filter == "" || common.HexToAddress(contract) == common.HexToAddress(filter)
+}
+
 func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSendToEthereumsRequest) (*types.BatchedSendToEthereumsResponse, error) {
+	if req.TokenContract != "" && !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	res := &types.BatchedSendToEthereumsResponse{}
 
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, outgoing types.OutgoingTx) bool {
-		batchTx := outgoing.(*types.BatchTx)
-		for _, ste := range batchTx.Transactions {
-			if ste.Sender == req.SenderAddress {
-				res.SendToEthereums = append(res.SendToEthereums, ste)
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, req.Pagination, types.BatchTxPrefixByte,
+		func(otx types.OutgoingTx) bool {
+			for _, ste := range otx.(*types.BatchTx).Transactions {
+				if matchesSendToEthereum(ste, req.SenderAddress, req.TokenContract) {
+					return true
+				}
 			}
-		}
-
-		return false
-	})
+			return false
+		},
+		func(_ []byte, otx types.OutgoingTx) {
+			for _, ste := range otx.(*types.BatchTx).Transactions {
+				if matchesSendToEthereum(ste, req.SenderAddress, req.TokenContract) {
+					res.SendToEthereums = append(res.SendToEthereums, ste)
+				}
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
 
 	return res, nil
 }
 
 func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.UnbatchedSendToEthereumsRequest) (*types.UnbatchedSendToEthereumsResponse, error) {
+	storePrefix := []byte{types.SendToEthereumKey}
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		storePrefix = append(storePrefix, common.HexToAddress(req.TokenContract).Bytes()...)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	res := &types.UnbatchedSendToEthereumsResponse{}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var ste types.SendToEthereum
 		k.cdc.MustUnmarshal(value, &ste)
-		if ste.Sender == req.SenderAddress {
+		if !matchesSendToEthereum(&ste, req.SenderAddress, "") {
+			return false, nil
+		}
+
+		if accumulate {
 			res.SendToEthereums = append(res.SendToEthereums, &ste)
-			return true, nil
 		}
-		return false, nil
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -406,6 +679,15 @@ func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.Unbatched
 	return res, nil
 }
 
+func (k Keeper) SendToEthereumByID(c context.Context, req *types.SendToEthereumByIDRequest) (*types.SendToEthereumByIDResponse, error) {
+	send, batchNonce := k.getSendToEthereum(sdk.UnwrapSDKContext(c), req.Id)
+	if send == nil {
+		return nil, sdkerrors.Wrapf(types.ErrSendToEthereumNotFound, "id %d", req.Id)
+	}
+
+	return &types.SendToEthereumByIDResponse{SendToEthereum: send, BatchNonce: batchNonce}, nil
+}
+
 func (k Keeper) DelegateKeysByValidator(c context.Context, req *types.DelegateKeysByValidatorRequest) (*types.DelegateKeysByValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
@@ -453,11 +735,23 @@ func (k Keeper) DelegateKeysByOrchestrator(c context.Context, req *types.Delegat
 
 func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest) (*types.DelegateKeysResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	delegateKeys := k.getDelegateKeys(ctx)
-
-	res := &types.DelegateKeysResponse{
-		DelegateKeys: delegateKeys,
+	res := &types.DelegateKeysResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorEthereumAddressKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		ethAddr := common.BytesToAddress(value)
+		res.DelegateKeys = append(res.DelegateKeys, &types.MsgDelegateKeys{
+			ValidatorAddress:    sdk.ValAddress(key).String(),
+			OrchestratorAddress: k.GetEthereumOrchestratorAddress(ctx, ethAddr).String(),
+			EthereumAddress:     ethAddr.Hex(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	res.Pagination = pageRes
+
 	return res, nil
 }
 
@@ -471,3 +765,156 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 	return res, nil
 }
+
+func (k Keeper) EventVoteDisagreements(c context.Context, req *types.EventVoteDisagreementsRequest) (*types.EventVoteDisagreementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	disagreements, pageRes, err := k.PaginateEventVoteDisagreements(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EventVoteDisagreementsResponse{Disagreements: disagreements, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SignerSetAtEthereumHeight(c context.Context, req *types.SignerSetAtEthereumHeightRequest) (*types.SignerSetAtEthereumHeightResponse, error) {
+	observed := k.GetObservedSignerSetAtEthereumHeight(sdk.UnwrapSDKContext(c), req.EthereumHeight)
+	if observed == nil {
+		return nil, status.Errorf(codes.NotFound, "no observed signer set at or below ethereum height %d", req.EthereumHeight)
+	}
+
+	return &types.SignerSetAtEthereumHeightResponse{ObservedSignerSet: observed}, nil
+}
+
+func (k Keeper) ObservedSignerSetHistory(c context.Context, req *types.ObservedSignerSetHistoryRequest) (*types.ObservedSignerSetHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ObservedSignerSetHistoryResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var observed types.ObservedSignerSet
+		k.cdc.MustUnmarshal(value, &observed)
+		res.ObservedSignerSets = append(res.ObservedSignerSets, observed)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) ExecutedBatchTxs(c context.Context, req *types.ExecutedBatchTxsRequest) (*types.ExecutedBatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	storeIndexPrefix := []byte{types.BatchTxPrefixByte}
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		storeIndexPrefix = append(storeIndexPrefix, common.HexToAddress(req.TokenContract).Bytes()...)
+	}
+
+	res := &types.ExecutedBatchTxsResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeExecutedOutgoingTxKey(storeIndexPrefix))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var executed types.ExecutedBatchTx
+		k.cdc.MustUnmarshal(value, &executed)
+		if !inNonceRange(executed.Batch.BatchNonce, req.SinceNonce, req.UntilNonce) {
+			return false, nil
+		}
+
+		if accumulate {
+			res.Batches = append(res.Batches, executed)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) ExecutedContractCallTxs(c context.Context, req *types.ExecutedContractCallTxsRequest) (*types.ExecutedContractCallTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.ExecutedContractCallTxsResponse{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeExecutedOutgoingTxKey([]byte{types.ContractCallTxPrefixByte}))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var executed types.ExecutedContractCallTx
+		k.cdc.MustUnmarshal(value, &executed)
+		if len(req.InvalidationScope) != 0 && !bytes.Equal(executed.LogicCall.InvalidationScope, req.InvalidationScope) {
+			return false, nil
+		}
+		if !inNonceRange(executed.LogicCall.InvalidationNonce, req.SinceNonce, req.UntilNonce) {
+			return false, nil
+		}
+
+		if accumulate {
+			res.Calls = append(res.Calls, executed)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) BridgeHealth(c context.Context, req *types.BridgeHealthRequest) (*types.BridgeHealthResponse, error) {
+	res := k.GetBridgeHealth(sdk.UnwrapSDKContext(c))
+	return &res, nil
+}
+
+func (k Keeper) ValidatorBridgeStats(c context.Context, req *types.ValidatorBridgeStatsRequest) (*types.ValidatorBridgeStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ValidatorBridgeStatsResponse{Epoch: k.GetBridgeStatsEpoch(ctx)}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorBridgeStatsKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var stats types.ValidatorBridgeStats
+		k.cdc.MustUnmarshal(value, &stats)
+		stats = currentValidatorBridgeStats(res.Epoch, stats)
+		stats.LastHeightVote = k.GetEthereumHeightVote(ctx, sdk.ValAddress(key))
+		res.Stats = append(res.Stats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) RewardPool(c context.Context, req *types.RewardPoolRequest) (*types.RewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.RewardPoolResponse{
+		Balance:            k.GetRewardPoolBalance(ctx),
+		TotalParticipation: k.GetRewardPoolTotalParticipation(ctx),
+	}
+
+	if epoch := k.GetParams(ctx).RewardPoolEpochBlocks; epoch > 0 {
+		res.NextDistributionHeight = (uint64(ctx.BlockHeight())/epoch + 1) * epoch
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ValidatorRewards(c context.Context, req *types.ValidatorRewardsRequest) (*types.ValidatorRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := k.GetValidatorRewards(ctx, val)
+	return &types.ValidatorRewardsResponse{
+		Rewards: rewards,
+		Accrued: rewardPoolShare(k.GetRewardPoolBalance(ctx), rewards.Participation, k.GetRewardPoolTotalParticipation(ctx)),
+	}, nil
+}
@@ -1,9 +1,16 @@
 package keeper
 
 import (
+	"crypto/ecdsa"
+	"sort"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/bytes"
@@ -36,7 +43,7 @@ func TestKeeper_LatestSignerSetTx(t *testing.T) {
 		ctx := env.Context
 		gk := env.GravityKeeper
 		{ // setup
-			sstx := gk.CreateSignerSetTx(env.Context)
+			sstx := gk.CreateSignerSetTx(env.Context, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 			require.NotNil(t, sstx)
 		}
 		{ // validate
@@ -56,7 +63,7 @@ func TestKeeper_SignerSetTx(t *testing.T) {
 
 		var signerSetNonce uint64
 		{ // setup
-			sstx := gk.CreateSignerSetTx(env.Context)
+			sstx := gk.CreateSignerSetTx(env.Context, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 			require.NotNil(t, sstx)
 			signerSetNonce = sstx.Nonce
 		}
@@ -142,8 +149,8 @@ func TestKeeper_SignerSetTxs(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			require.NotNil(t, gk.CreateSignerSetTx(env.Context))
-			require.NotNil(t, gk.CreateSignerSetTx(env.Context))
+			require.NotNil(t, gk.CreateSignerSetTx(env.Context, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED))
+			require.NotNil(t, gk.CreateSignerSetTx(env.Context, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED))
 		}
 		{ // validate
 			req := &types.SignerSetTxsRequest{}
@@ -155,6 +162,51 @@ func TestKeeper_SignerSetTxs(t *testing.T) {
 	})
 }
 
+func TestKeeper_SignerSetDiff(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	current := gk.CurrentSignerSet(ctx)
+	removed := common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7").Hex()
+
+	// the latest signer set tx lacks the first signer, gave the second one
+	// less power and still holds a signer that has since left
+	latest := types.EthereumSigners{
+		{Power: current[1].Power - 100, EthereumAddress: current[1].EthereumAddress},
+		{Power: 100, EthereumAddress: removed},
+	}
+	for _, signer := range current[2:] {
+		latest = append(latest, &types.EthereumSigner{Power: signer.Power, EthereumAddress: signer.EthereumAddress})
+	}
+	gk.SetOutgoingTx(ctx, &types.SignerSetTx{Nonce: gk.incrementLatestSignerSetTxNonce(ctx), Signers: latest})
+
+	res, err := gk.SignerSetDiff(sdk.WrapSDKContext(ctx), &types.SignerSetDiffRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LatestSignerSetNonce)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), res.PowerDiffThreshold)
+	require.True(t, res.PowerDiff.GT(res.PowerDiffThreshold))
+	require.InDelta(t, current.PowerDiff(latest), res.PowerDiff.MustFloat64(), 1e-12)
+
+	expected := []types.SignerPowerChange{
+		{EthereumAddress: current[0].EthereumAddress, CurrentPower: current[0].Power, PowerDelta: int64(current[0].Power), Added: true},
+		{EthereumAddress: current[1].EthereumAddress, LatestPower: current[1].Power - 100, CurrentPower: current[1].Power, PowerDelta: 100},
+		{EthereumAddress: removed, LatestPower: 100, PowerDelta: -100, Removed: true},
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		return types.EthereumAddrLessThan(expected[i].EthereumAddress, expected[j].EthereumAddress)
+	})
+	require.Equal(t, expected, res.Changes)
+
+	// a signer set tx of the current set leaves nothing to change
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE)
+	require.Equal(t, types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE, gk.GetLatestSignerSetTx(ctx).Reason)
+	res, err = gk.SignerSetDiff(sdk.WrapSDKContext(ctx), &types.SignerSetDiffRequest{})
+	require.NoError(t, err)
+	require.Equal(t, signerSetTx.Nonce, res.LatestSignerSetNonce)
+	require.True(t, res.PowerDiff.IsZero())
+	require.Empty(t, res.Changes)
+}
+
 func TestKeeper_BatchTxs(t *testing.T) {
 	t.Run("read after there's something in state", func(t *testing.T) {
 		env := CreateTestEnv(t)
@@ -185,6 +237,64 @@ func TestKeeper_BatchTxs(t *testing.T) {
 			require.Len(t, got.Batches, 2)
 		}
 	})
+
+	t.Run("filtered and paginated", func(t *testing.T) {
+		env := CreateTestEnv(t)
+		ctx := env.Context
+		gk := env.GravityKeeper
+
+		tokenA := "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+		tokenB := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			gk.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: nonce, Timeout: 1000, TokenContract: tokenA})
+			gk.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: nonce, Timeout: 1000, TokenContract: tokenB})
+		}
+
+		req := &types.BatchTxsRequest{
+			TokenContract: tokenA,
+			SinceNonce:    1,
+			UntilNonce:    4,
+			Pagination:    &query.PageRequest{Limit: 2},
+		}
+		got, err := gk.BatchTxs(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Len(t, got.Batches, 2)
+		require.EqualValues(t, 2, got.Batches[0].BatchNonce)
+		require.EqualValues(t, 3, got.Batches[1].BatchNonce)
+		require.NotNil(t, got.Pagination.NextKey)
+
+		req.Pagination = &query.PageRequest{Key: got.Pagination.NextKey, Limit: 2}
+		got, err = gk.BatchTxs(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Len(t, got.Batches, 1)
+		require.EqualValues(t, 4, got.Batches[0].BatchNonce)
+		require.Equal(t, tokenA, got.Batches[0].TokenContract)
+		require.Nil(t, got.Pagination.NextKey)
+
+		_, err = gk.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{TokenContract: "not-an-address"})
+		require.Error(t, err)
+	})
+}
+
+func TestKeeper_BatchTxConfirmations(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	tokenContract := "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+	for _, val := range ValAddrs[:3] {
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{TokenContract: tokenContract, BatchNonce: 1, Signature: []byte("signature")}, val)
+	}
+
+	req := &types.BatchTxConfirmationsRequest{TokenContract: tokenContract, BatchNonce: 1, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+	got, err := gk.BatchTxConfirmations(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Len(t, got.Signatures, 2)
+	require.EqualValues(t, 3, got.Pagination.Total)
+
+	req.Pagination = &query.PageRequest{Key: got.Pagination.NextKey}
+	got, err = gk.BatchTxConfirmations(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Len(t, got.Signatures, 1)
 }
 
 func TestKeeper_ContractCallTxs(t *testing.T) {
@@ -214,6 +324,324 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 	})
 }
 
+func TestKeeper_ValidatorBridgeStats(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	{ // setup
+		_, err := gk.recordEventVote(ctx, &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 10,
+		}, ValAddrs[0])
+		require.NoError(t, err)
+
+		gk.IncrementExpectedConfirmations(ctx, ValAddrs[0])
+		gk.IncrementExpectedConfirmations(ctx, ValAddrs[1])
+		gk.SetEthereumHeightVote(ctx, ValAddrs[0], 15)
+	}
+	{ // validate
+		got, err := gk.ValidatorBridgeStats(sdk.WrapSDKContext(ctx), &types.ValidatorBridgeStatsRequest{})
+		require.NoError(t, err)
+		require.Len(t, got.Stats, 2)
+
+		stats := gk.GetValidatorBridgeStats(ctx, ValAddrs[0])
+		require.Equal(t, uint64(1), stats.EventVotes)
+		require.Equal(t, uint64(1), stats.ExpectedConfirmations)
+		require.Equal(t, uint64(0), stats.SignedConfirmations)
+		for _, s := range got.Stats {
+			if s.ValidatorAddress == ValAddrs[0].String() {
+				require.Equal(t, uint64(15), s.LastHeightVote.EthereumHeight)
+			}
+		}
+
+		got, err = gk.ValidatorBridgeStats(sdk.WrapSDKContext(ctx), &types.ValidatorBridgeStatsRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, got.Stats, 1)
+		require.NotNil(t, got.Pagination.NextKey)
+	}
+}
+
+func TestKeeper_ValidatorBridgeStatsEpoch(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.incrementObservedEvents(ctx)
+	gk.incrementObservedEvents(ctx)
+	gk.IncrementExpectedConfirmations(ctx, ValAddrs[0])
+
+	// every validator has seen the events observed since the epoch started
+	require.Equal(t, uint64(2), gk.GetValidatorBridgeStats(ctx, ValAddrs[0]).ObservedEvents)
+	require.Equal(t, uint64(2), gk.GetValidatorBridgeStats(ctx, ValAddrs[1]).ObservedEvents)
+
+	// a new epoch resets the counters
+	ctx = ctx.WithBlockHeight(100)
+	gk.StartBridgeStatsEpoch(ctx)
+	gk.incrementObservedEvents(ctx)
+
+	stats := gk.GetValidatorBridgeStats(ctx, ValAddrs[0])
+	require.Equal(t, uint64(100), stats.EpochStartHeight)
+	require.Equal(t, uint64(0), stats.ExpectedConfirmations)
+	require.Equal(t, uint64(1), stats.ObservedEvents)
+
+	got, err := gk.ValidatorBridgeStats(sdk.WrapSDKContext(ctx), &types.ValidatorBridgeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.BridgeStatsEpoch{StartHeight: 100, ObservedEvents: 3, StartObservedEvents: 2}, got.Epoch)
+	require.Len(t, got.Stats, 1)
+	require.Equal(t, uint64(0), got.Stats[0].ExpectedConfirmations)
+	require.Equal(t, uint64(1), got.Stats[0].ObservedEvents)
+
+	// counting continues within the epoch
+	gk.IncrementExpectedConfirmations(ctx, ValAddrs[0])
+	gk.incrementObservedEvents(ctx)
+	stats = gk.GetValidatorBridgeStats(ctx, ValAddrs[0])
+	require.Equal(t, uint64(1), stats.ExpectedConfirmations)
+	require.Equal(t, uint64(2), stats.ObservedEvents)
+}
+
+func TestKeeper_UnsignedSignerSetTxs(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	var signerSets []*types.SignerSetTx
+	for i := 0; i < 3; i++ {
+		signerSets = append(signerSets, gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED))
+	}
+	gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSets[1].Nonce,
+		EthereumSigner: EthAddrs[0].Hex(),
+		Signature:      []byte("fake-signature"),
+	}, ValAddrs[0])
+
+	unsigned := func(req *types.UnsignedSignerSetTxsRequest) (nonces []uint64) {
+		res, err := gk.UnsignedSignerSetTxs(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		for _, signerSet := range res.SignerSets {
+			nonces = append(nonces, signerSet.Nonce)
+		}
+		return nonces
+	}
+
+	require.Equal(t, []uint64{1, 3}, unsigned(&types.UnsignedSignerSetTxsRequest{Address: AccAddrs[0].String()}))
+	require.Equal(t, []uint64{1, 2, 3}, unsigned(&types.UnsignedSignerSetTxsRequest{Address: AccAddrs[1].String()}))
+	require.Equal(t, []uint64{3}, unsigned(&types.UnsignedSignerSetTxsRequest{Address: AccAddrs[1].String(), SinceNonce: 2}))
+	require.Equal(t, []uint64{1}, unsigned(&types.UnsignedSignerSetTxsRequest{
+		Address:    AccAddrs[1].String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	}))
+
+	// the since nonce holds across key, offset and reverse pagination
+	res, err := gk.UnsignedSignerSetTxs(sdk.WrapSDKContext(ctx), &types.UnsignedSignerSetTxsRequest{
+		Address:    AccAddrs[1].String(),
+		SinceNonce: 1,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.SignerSets[0].Nonce)
+	require.Equal(t, []uint64{3}, unsigned(&types.UnsignedSignerSetTxsRequest{
+		Address:    AccAddrs[1].String(),
+		SinceNonce: 1,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	}))
+	require.Equal(t, []uint64{3}, unsigned(&types.UnsignedSignerSetTxsRequest{
+		Address:    AccAddrs[1].String(),
+		SinceNonce: 1,
+		Pagination: &query.PageRequest{Offset: 1},
+	}))
+	require.Equal(t, []uint64{3, 2}, unsigned(&types.UnsignedSignerSetTxsRequest{
+		Address:    AccAddrs[1].String(),
+		SinceNonce: 1,
+		Pagination: &query.PageRequest{Reverse: true},
+	}))
+
+	// deleted txs are no longer pending
+	gk.DeleteOutgoingTx(ctx, signerSets[0].GetStoreIndex())
+	require.Equal(t, []uint64{3}, unsigned(&types.UnsignedSignerSetTxsRequest{Address: AccAddrs[0].String()}))
+}
+
+func TestKeeper_UnsignedBatchTxs(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	for _, batch := range []*types.BatchTx{
+		{BatchNonce: 1, TokenContract: TokenContractAddrs[0]},
+		{BatchNonce: 2, TokenContract: TokenContractAddrs[1]},
+		{BatchNonce: 3, TokenContract: TokenContractAddrs[0]},
+		{BatchNonce: 4, TokenContract: TokenContractAddrs[0]},
+	} {
+		gk.SetOutgoingTx(ctx, batch)
+	}
+
+	unsigned := func(req *types.UnsignedBatchTxsRequest) (nonces []uint64) {
+		req.Address = AccAddrs[0].String()
+		res, err := gk.UnsignedBatchTxs(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		for _, batch := range res.Batches {
+			nonces = append(nonces, batch.BatchNonce)
+		}
+		return nonces
+	}
+
+	require.Equal(t, []uint64{2, 1, 3, 4}, unsigned(&types.UnsignedBatchTxsRequest{}))
+	require.Equal(t, []uint64{4}, unsigned(&types.UnsignedBatchTxsRequest{SinceNonce: 3}))
+	require.Equal(t, []uint64{1, 3, 4}, unsigned(&types.UnsignedBatchTxsRequest{TokenContract: TokenContractAddrs[0]}))
+	require.Equal(t, []uint64{4}, unsigned(&types.UnsignedBatchTxsRequest{TokenContract: TokenContractAddrs[0], SinceNonce: 1, Pagination: &query.PageRequest{Offset: 1}}))
+	require.Equal(t, []uint64{4, 3}, unsigned(&types.UnsignedBatchTxsRequest{TokenContract: TokenContractAddrs[0], SinceNonce: 1, Pagination: &query.PageRequest{Reverse: true}}))
+	require.Empty(t, unsigned(&types.UnsignedBatchTxsRequest{TokenContract: TokenContractAddrs[1], SinceNonce: 2}))
+}
+
+func TestKeeper_RelayableSignerSetTxs(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	var (
+		keys    []*ecdsa.PrivateKey
+		signers types.EthereumSigners
+	)
+	for _, power := range []uint64{1431655765, 1431655765, 1431655766} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		signers = append(signers, &types.EthereumSigner{Power: power, EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()})
+	}
+	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{Nonce: 1, Signers: signers})
+
+	sign := func(signerSet *types.SignerSetTx, key *ecdsa.PrivateKey) {
+		signature, err := types.NewEthereumSignature(signerSet.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		val := sdk.ValAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+		gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{SignerSetNonce: signerSet.Nonce, Signature: signature}, val)
+	}
+
+	// signed by the whole set, but already observed
+	observed := &types.SignerSetTx{Nonce: 1, Signers: signers}
+	gk.SetOutgoingTx(ctx, observed)
+	for _, key := range keys {
+		sign(observed, key)
+	}
+	// signed by two thirds of the power
+	relayable := &types.SignerSetTx{Nonce: 2, Signers: signers}
+	gk.SetOutgoingTx(ctx, relayable)
+	sign(relayable, keys[0])
+	sign(relayable, keys[2])
+	// signed by a third of the power
+	unsigned := &types.SignerSetTx{Nonce: 3, Signers: signers}
+	gk.SetOutgoingTx(ctx, unsigned)
+	sign(unsigned, keys[1])
+
+	res, err := gk.RelayableSignerSetTxs(sdk.WrapSDKContext(ctx), &types.RelayableSignerSetTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SignerSets, 1)
+	require.Equal(t, uint64(2), res.SignerSets[0].SignerSet.Nonce)
+
+	// the signatures line up with the sorted signers of the current set
+	signatures := res.SignerSets[0].Signatures
+	require.Len(t, signatures.V, 3)
+	for i, signer := range res.CurrentSignerSet.Signers {
+		if signer.EthereumAddress == crypto.PubkeyToAddress(keys[1].PublicKey).Hex() {
+			require.Zero(t, signatures.V[i])
+			require.Empty(t, signatures.R[i])
+			continue
+		}
+
+		require.Contains(t, []uint32{27, 28}, signatures.V[i])
+		signature := append(append(append([]byte{}, signatures.R[i]...), signatures.S[i]...), byte(signatures.V[i]))
+		require.NoError(t, types.ValidateEthereumSignature(relayable.GetCheckpoint(gravityID), signature, common.HexToAddress(signer.EthereumAddress)))
+	}
+}
+
+func TestKeeper_BatchTxRelayCalldata(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	var (
+		keys    []*ecdsa.PrivateKey
+		signers types.EthereumSigners
+	)
+	for _, power := range []uint64{1431655765, 1431655765, 1431655766} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		signers = append(signers, &types.EthereumSigner{Power: power, EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()})
+	}
+	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{Nonce: 1, Signers: signers})
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	batch := &types.BatchTx{BatchNonce: 1, Timeout: 100, TokenContract: tokenContract.Hex()}
+	gk.SetOutgoingTx(ctx, batch)
+	sign := func(key *ecdsa.PrivateKey) {
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		val := sdk.ValAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{TokenContract: batch.TokenContract, BatchNonce: batch.BatchNonce, Signature: signature}, val)
+	}
+	req := &types.BatchTxRelayCalldataRequest{TokenContract: tokenContract.Hex(), BatchNonce: 1}
+
+	// a third of the power is not enough to relay
+	sign(keys[1])
+	_, err := gk.BatchTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	sign(keys[2])
+	res, err := gk.BatchTxRelayCalldata(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, gk.GetParams(ctx).BridgeEthereumAddress, res.BridgeEthereumAddress)
+
+	relayABI, err := abi.JSON(strings.NewReader(types.GravityRelayABIJSON))
+	require.NoError(t, err)
+	require.Equal(t, relayABI.Methods["submitBatch"].ID, res.Calldata[:4])
+	args, err := relayABI.Methods["submitBatch"].Inputs.Unpack(res.Calldata[4:])
+	require.NoError(t, err)
+	require.Equal(t, tokenContract, args[6])
+
+	// an unknown batch
+	_, err = gk.BatchTxRelayCalldata(sdk.WrapSDKContext(ctx), &types.BatchTxRelayCalldataRequest{TokenContract: tokenContract.Hex(), BatchNonce: 2})
+	require.Error(t, err)
+}
+
+func TestKeeper_Checkpoint(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	batch := &types.BatchTx{BatchNonce: 1, Timeout: 100, TokenContract: tokenContract.Hex()}
+	gk.SetOutgoingTx(ctx, batch)
+
+	res, err := gk.Checkpoint(sdk.WrapSDKContext(ctx), &types.CheckpointRequest{TokenContract: tokenContract.Hex(), BatchNonce: 1})
+	require.NoError(t, err)
+	require.Equal(t, batch.GetCheckpoint(gravityID), res.Checkpoint)
+	require.Equal(t, string(gravityID), res.GravityId)
+	require.Equal(t, types.CheckpointFields(batch, gravityID), res.Fields)
+
+	// a signature over the checkpoint signs the digest
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := types.NewEthereumSignature(res.Checkpoint, key)
+	require.NoError(t, err)
+	pubkey, err := crypto.SigToPub(res.SignatureDigest, signature)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey, *pubkey)
+
+	// exactly one outgoing tx must be selected
+	_, err = gk.Checkpoint(sdk.WrapSDKContext(ctx), &types.CheckpointRequest{SignerSetNonce: 1, TokenContract: tokenContract.Hex(), BatchNonce: 1})
+	require.Error(t, err)
+	_, err = gk.Checkpoint(sdk.WrapSDKContext(ctx), &types.CheckpointRequest{})
+	require.Error(t, err)
+	_, err = gk.Checkpoint(sdk.WrapSDKContext(ctx), &types.CheckpointRequest{SignerSetNonce: 5})
+	require.Error(t, err)
+}
+
 // TODO(levi) ensure coverage for:
 // ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
 // ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
@@ -222,8 +650,6 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
 // ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
 
-// UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
-// UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
 // UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
 
 // BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
@@ -138,9 +138,25 @@ func (k Keeper) getEthereumSignature(ctx sdk.Context, storeIndex []byte, validat
 func (k Keeper) SetEthereumSignature(ctx sdk.Context, sig types.EthereumTxConfirmation, val sdk.ValAddress) []byte {
 	key := types.MakeEthereumSignatureKey(sig.GetStoreIndex(), val)
 	ctx.KVStore(k.storeKey).Set(key, sig.GetSignature())
+	ctx.KVStore(k.storeKey).Delete(types.MakePendingEthereumSignatureKey(val, sig.GetStoreIndex()))
 	return key
 }
 
+// deleteEthereumSignatures deletes all ethereum signatures for a given outgoing tx by store index
+func (k Keeper) deleteEthereumSignatures(ctx sdk.Context, storeIndex []byte) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
 // GetEthereumSignatures returns all etherum signatures for a given outgoing tx by store index
 func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[string][]byte {
 	var signatures = make(map[string][]byte)
@@ -165,6 +181,17 @@ func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb
 	}
 }
 
+// paginateEthereumSignatures pages through the signatures over an outgoing tx
+// in validator address order
+func (k Keeper) paginateEthereumSignatures(ctx sdk.Context, storeIndex []byte, pageReq *query.PageRequest, cb func(sdk.ValAddress, []byte)) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
+
+	return query.Paginate(prefixStore, pageReq, func(key []byte, value []byte) error {
+		cb(key, value)
+		return nil
+	})
+}
+
 /////////////////////////
 //  ORC -> VAL ADDRESS //
 /////////////////////////
@@ -190,12 +217,17 @@ func (k Keeper) GetOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.Ac
 // VAL -> ETH ADDRESS //
 ////////////////////////
 
-// setValidatorEthereumAddress sets the ethereum address for a given validator
+// setValidatorEthereumAddress sets the ethereum address for a given validator,
+// a validator registering its first address is expected to sign the stored outgoing txs
 func (k Keeper) setValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeValidatorEthereumAddressKey(valAddr)
 
+	registered := store.Has(key)
 	store.Set(key, ethAddr.Bytes())
+	if !registered {
+		k.setValidatorPendingEthereumSignatures(ctx, valAddr)
+	}
 }
 
 // GetValidatorEthereumAddress returns the eth address for a given gravity validator.
@@ -207,16 +239,56 @@ func (k Keeper) GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 }
 
 func (k Keeper) validatorForEthAddressExists(ctx sdk.Context, ethAddr common.Address) bool {
+	return k.GetValidatorForEthereumAddress(ctx, ethAddr) != nil
+}
+
+// GetValidatorForEthereumAddress returns the validator that registered the given
+// eth address, including a key it is rotating out, or nil if no validator did
+func (k Keeper) GetValidatorForEthereumAddress(ctx sdk.Context, ethAddr common.Address) sdk.ValAddress {
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		if common.BytesToAddress(iter.Value()) == ethAddr {
+			return iter.Key()
+		}
+	}
+
+	// keys being rotated out still belong to their validator
+	var val sdk.ValAddress
+	k.iterateEthereumKeyRotations(ctx, func(v sdk.ValAddress, rotation types.EthereumKeyRotation) bool {
+		if common.HexToAddress(rotation.OldEthereumAddress) == ethAddr {
+			val = v
 			return true
 		}
+		return false
+	})
+
+	return val
+}
+
+// GetEthereumAddressValidators maps every registered eth address, including
+// the keys being rotated out, to its validator, for lookups over many
+// addresses at once
+func (k Keeper) GetEthereumAddressValidators(ctx sdk.Context) map[common.Address]sdk.ValAddress {
+	out := make(map[common.Address]sdk.ValAddress)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		out[common.BytesToAddress(iter.Value())] = iter.Key()
 	}
 
-	return false
+	// keys being rotated out still belong to their validator
+	k.iterateEthereumKeyRotations(ctx, func(val sdk.ValAddress, rotation types.EthereumKeyRotation) bool {
+		if ethAddr := common.HexToAddress(rotation.OldEthereumAddress); out[ethAddr] == nil {
+			out[ethAddr] = val
+		}
+		return false
+	})
+
+	return out
 }
 
 ////////////////////////
@@ -254,30 +326,91 @@ func (k Keeper) ethAddressForOrchestratorExists(ctx sdk.Context, orch sdk.AccAdd
 
 // CreateSignerSetTx gets the current signer set from the staking keeper, increments the nonce,
 // creates the signer set tx object, emits an event and sets the signer set in state
-func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
+func (k Keeper) CreateSignerSetTx(ctx sdk.Context, reason types.SignerSetTxReason) *types.SignerSetTx {
 	nonce := k.incrementLatestSignerSetTxNonce(ctx)
 	currSignerSet := k.CurrentSignerSet(ctx)
 	newSignerSetTx := types.NewSignerSetTx(nonce, uint64(ctx.BlockHeight()), currSignerSet)
+	newSignerSetTx.Reason = reason
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMultisigUpdateRequest,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(nonce)),
-		),
-	)
+	k.emitMultisigUpdateRequest(ctx, newSignerSetTx)
 	k.SetOutgoingTx(ctx, newSignerSetTx)
 	k.Logger(ctx).Info(
 		"SignerSetTx created",
 		"nonce", newSignerSetTx.Nonce,
 		"height", newSignerSetTx.Height,
 		"signers", len(newSignerSetTx.Signers),
+		"reason", reason.String(),
 	)
 	return newSignerSetTx
 }
 
+// ProduceSignerSetTx creates a signer set tx for the reason, unless one was
+// already created in this block. That one is then refreshed in place with the
+// current signer set and the reason, so that at most one signer set tx is
+// created per block whatever triggers it. Signatures over the replaced tx are
+// deleted, while its checkpoint stays in the past checkpoints since it may
+// already have been signed off chain.
+func (k Keeper) ProduceSignerSetTx(ctx sdk.Context, reason types.SignerSetTxReason) *types.SignerSetTx {
+	latest := k.GetLatestSignerSetTx(ctx)
+	if latest == nil || latest.Height != uint64(ctx.BlockHeight()) {
+		return k.CreateSignerSetTx(ctx, reason)
+	}
+
+	refreshed := types.NewSignerSetTx(latest.Nonce, latest.Height, k.CurrentSignerSet(ctx))
+	refreshed.Reason = reason
+
+	k.deleteEthereumSignatures(ctx, latest.GetStoreIndex())
+	k.setPendingEthereumSignatures(ctx, latest.GetStoreIndex())
+	k.emitMultisigUpdateRequest(ctx, refreshed)
+	k.SetOutgoingTx(ctx, refreshed)
+	k.Logger(ctx).Info(
+		"SignerSetTx refreshed",
+		"nonce", refreshed.Nonce,
+		"height", refreshed.Height,
+		"signers", len(refreshed.Signers),
+		"reason", reason.String(),
+	)
+	return refreshed
+}
+
+// emitMultisigUpdateRequest emits the event orchestrators sign signer set txs on
+func (k Keeper) emitMultisigUpdateRequest(ctx sdk.Context, signerSetTx *types.SignerSetTx) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMultisigUpdateRequest,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(signerSetTx.Nonce)),
+			sdk.NewAttribute(types.AttributeKeySignerSetTxReason, signerSetTx.Reason.String()),
+		),
+	)
+}
+
+// GetSignerSetDiff compares the current signer set to the latest signer set tx
+func (k Keeper) GetSignerSetDiff(ctx sdk.Context) types.SignerSetDiffResponse {
+	var latest types.EthereumSigners
+	res := types.SignerSetDiffResponse{PowerDiffThreshold: k.GetParams(ctx).SignerSetTxPowerDiffThreshold}
+	if latestSignerSetTx := k.GetLatestSignerSetTx(ctx); latestSignerSetTx != nil {
+		res.LatestSignerSetNonce = latestSignerSetTx.Nonce
+		latest = latestSignerSetTx.Signers
+	}
+
+	// the same normalized diff as EthereumSigners.PowerDiff, without the float
+	var delta int64
+	res.Changes = k.CurrentSignerSet(ctx).PowerChanges(latest)
+	for _, change := range res.Changes {
+		if change.PowerDelta < 0 {
+			delta -= change.PowerDelta
+		} else {
+			delta += change.PowerDelta
+		}
+	}
+	res.PowerDiff = sdk.NewDec(delta).QuoInt64(math.MaxUint32)
+
+	return res
+}
+
 // CurrentSignerSet gets powers from the store and normalizes them
 // into an integer percentage with a resolution of uint32 Max meaning
 // a given validators 'gravity power' is computed as
@@ -289,11 +422,22 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 // total voting power. This is an acceptable rounding error since floating
 // point may cause consensus problems if different floating point unit
 // implementations are involved.
+//
+// When the MaxSigners param is set only that many validators with the most
+// power are included, the powers are normalized over the included validators
+// so that they can reach the contract's threshold on their own. When the
+// MaxSignerPowerFraction param is set the normalized powers are capped by
+// capSignerPowers.
 func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	params := k.GetParams(ctx)
+	maxSigners := params.MaxSigners
 	ethereumSigners := make([]*types.EthereumSigner, 0)
 	var totalPower uint64
 	for _, validator := range validators {
+		if maxSigners != 0 && uint64(len(ethereumSigners)) >= maxSigners {
+			break
+		}
 		val := validator.GetOperator()
 
 		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
@@ -304,6 +448,10 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 			totalPower += p
 		}
 	}
+	if params.MaxSignerPowerFraction.IsPositive() {
+		capSignerPowers(ethereumSigners, totalPower, params.MaxSignerPowerFraction)
+		return ethereumSigners
+	}
 	// normalize power values
 	for i := range ethereumSigners {
 		ethereumSigners[i].Power = sdk.NewUint(ethereumSigners[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
@@ -312,6 +460,51 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 	return ethereumSigners
 }
 
+// capSignerPowers normalizes the powers of the signers like CurrentSignerSet
+// but caps each one at maxFraction of uint32 Max. The power above the cap is
+// redistributed over the uncapped signers in proportion to their power, which
+// is repeated until no signer is above the cap. The cap is raised to an equal
+// share of the power when it is too low for the signers to hold all of it, so
+// that the capped signer set can still reach the contract's threshold.
+func capSignerPowers(signers []*types.EthereumSigner, totalPower uint64, maxFraction sdk.Dec) {
+	if len(signers) == 0 {
+		return
+	}
+	maxPower := maxFraction.MulInt64(math.MaxUint32).TruncateInt().Uint64()
+	if equalShare := uint64(math.MaxUint32) / uint64(len(signers)); maxPower < equalShare {
+		maxPower = equalShare
+	}
+
+	// capping a signer only raises the share of the others, so a signer is
+	// never uncapped again
+	capped := make([]bool, len(signers))
+	uncappedNormalizedPower := uint64(math.MaxUint32)
+	for changed := true; changed; {
+		changed = false
+		for i, signer := range signers {
+			// signers without power stay at zero, which also avoids dividing
+			// by zero once every other signer is capped
+			if capped[i] || signer.Power == 0 {
+				continue
+			}
+			if sdk.NewUint(signer.Power).MulUint64(uncappedNormalizedPower).QuoUint64(totalPower).Uint64() > maxPower {
+				capped[i] = true
+				uncappedNormalizedPower -= maxPower
+				totalPower -= signer.Power
+				changed = true
+			}
+		}
+	}
+
+	for i, signer := range signers {
+		if capped[i] {
+			signer.Power = maxPower
+		} else if signer.Power != 0 {
+			signer.Power = sdk.NewUint(signer.Power).MulUint64(uncappedNormalizedPower).QuoUint64(totalPower).Uint64()
+		}
+	}
+}
+
 // GetSignerSetTxs returns all the signer set txs from the store
 func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -442,41 +635,113 @@ func (k Keeper) GetOutgoingTx(ctx sdk.Context, storeIndex []byte) (out types.Out
 	return out
 }
 
+// timeoutOutgoingTx is implemented by the outgoing txs that expire on Ethereum
+type timeoutOutgoingTx interface {
+	types.OutgoingTx
+	GetTimeout() uint64
+}
+
 func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 	any, err := types.PackOutgoingTx(outgoing)
 	if err != nil {
 		panic(err)
 	}
+	if existing := k.GetOutgoingTx(ctx, outgoing.GetStoreIndex()); existing != nil {
+		k.deleteOutgoingTxTimeout(ctx, existing)
+		k.deleteBatchedSendToEthereumIDs(ctx, existing)
+	} else {
+		k.setPendingEthereumSignatures(ctx, outgoing.GetStoreIndex())
+	}
 	ctx.KVStore(k.storeKey).Set(
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
+	if otx, ok := outgoing.(timeoutOutgoingTx); ok {
+		ctx.KVStore(k.storeKey).Set(types.MakeOutgoingTxTimeoutKey(otx.GetTimeout(), otx.GetStoreIndex()), []byte{0x1})
+	}
+	if btx, ok := outgoing.(*types.BatchTx); ok {
+		for _, ste := range btx.Transactions {
+			ctx.KVStore(k.storeKey).Set(types.MakeBatchedSendToEthereumIDKey(ste.Id), btx.GetStoreIndex())
+		}
+	}
+	k.setPastEthereumSignatureCheckpoint(ctx, outgoing, uint64(ctx.BlockHeight()))
 }
 
-// DeleteOutgoingTx deletes a given outgoingtx
+// DeleteOutgoingTx deletes a given outgoingtx along with its signatures,
+// outgoingTxSlashing only considers the stored txs
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
+	otx := k.GetOutgoingTx(ctx, storeIndex)
+	if otx == nil {
+		return
+	}
+
+	k.deleteOutgoingTxTimeout(ctx, otx)
+	k.deleteBatchedSendToEthereumIDs(ctx, otx)
+	k.deletePendingEthereumSignatures(ctx, storeIndex)
+	k.deleteEthereumSignatures(ctx, storeIndex)
 	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
 }
 
-func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+// deleteOutgoingTxTimeout removes the outgoing tx from the timeout index
+func (k Keeper) deleteOutgoingTxTimeout(ctx sdk.Context, outgoing types.OutgoingTx) {
+	if otx, ok := outgoing.(timeoutOutgoingTx); ok {
+		ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxTimeoutKey(otx.GetTimeout(), otx.GetStoreIndex()))
+	}
+}
 
-	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		if !accumulate {
-			return false, nil
+// deleteBatchedSendToEthereumIDs removes the sends to ethereum of a batch tx
+// from the batched send id index
+func (k Keeper) deleteBatchedSendToEthereumIDs(ctx sdk.Context, outgoing types.OutgoingTx) {
+	if btx, ok := outgoing.(*types.BatchTx); ok {
+		for _, ste := range btx.Transactions {
+			ctx.KVStore(k.storeKey).Delete(types.MakeBatchedSendToEthereumIDKey(ste.Id))
 		}
+	}
+}
+
+// GetTimedOutOutgoingTxs returns the outgoing txs of the type denoted by the
+// chosen prefix byte whose timeout is below the given Ethereum height, in
+// timeout order
+func (k Keeper) GetTimedOutOutgoingTxs(ctx sdk.Context, prefixByte byte, ethereumHeight uint64) (out []types.OutgoingTx) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxTimeoutKey})
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(ethereumHeight))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the store index following the timeout starts with the type prefix
+		if storeIndex := iter.Key()[8:]; storeIndex[0] == prefixByte {
+			out = append(out, k.GetOutgoingTx(ctx, storeIndex))
+		}
+	}
+	return out
+}
+
+// PaginateOutgoingTxsByType pages through the outgoing transactions of the type
+// denoted by the chosen prefix byte that pass the filter, in store index order
+func (k Keeper) PaginateOutgoingTxsByType(
+	ctx sdk.Context,
+	pageReq *query.PageRequest,
+	prefixByte byte,
+	filter func(outgoing types.OutgoingTx) bool,
+	cb func(key []byte, outgoing types.OutgoingTx),
+) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
 
+	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var any cdctypes.Any
 		k.cdc.MustUnmarshal(value, &any)
 		var otx types.OutgoingTx
 		if err := k.cdc.UnpackAny(&any, &otx); err != nil {
 			panic(err)
 		}
-		if accumulate {
-			return cb(key, otx), nil
+		if !filter(otx) {
+			return false, nil
 		}
 
-		return false, nil
+		if accumulate {
+			cb(key, otx)
+		}
+		return true, nil
 	})
 }
 
@@ -702,6 +967,27 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 		prefixStoreEthereumEvent.Delete(iterEvent.Key())
 	}
 
+	prefixStorePendingSig := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingEthereumSignatureKey})
+	iterPendingSig := prefixStorePendingSig.Iterator(nil, nil)
+	defer iterPendingSig.Close()
+	for ; iterPendingSig.Valid(); iterPendingSig.Next() {
+		prefixStorePendingSig.Delete(iterPendingSig.Key())
+	}
+
+	prefixStoreOtxTimeout := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxTimeoutKey})
+	iterOtxTimeout := prefixStoreOtxTimeout.Iterator(nil, nil)
+	defer iterOtxTimeout.Close()
+	for ; iterOtxTimeout.Valid(); iterOtxTimeout.Next() {
+		prefixStoreOtxTimeout.Delete(iterOtxTimeout.Key())
+	}
+
+	prefixStorePendingEvent := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingEthereumEventVoteRecordKey})
+	iterPendingEvent := prefixStorePendingEvent.Iterator(nil, nil)
+	defer iterPendingEvent.Close()
+	for ; iterPendingEvent.Valid(); iterPendingEvent.Next() {
+		prefixStorePendingEvent.Delete(iterPendingEvent.Key())
+	}
+
 	// Set the Last oberved Ethereum Blockheight to zero
 	height := types.LatestEthereumBlockHeight{
 		EthereumHeight: (bridgeDeploymentHeight - 1),
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"math"
 	"testing"
 	"time"
 
@@ -43,12 +44,107 @@ func TestCurrentValsetNormalization(t *testing.T) {
 				input.GravityKeeper.setValidatorEthereumAddress(ctx, cAddr, common.HexToAddress("0xf71402f886b45c134743F4c00750823Bbf5Fd045"))
 			}
 			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
-			r := input.GravityKeeper.CreateSignerSetTx(ctx)
+			r := input.GravityKeeper.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 			assert.Equal(t, spec.expPowers, r.Signers.GetPowers())
 		})
 	}
 }
 
+func TestCurrentSignerSetMaxSigners(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	srcPowers := []int64{40, 30, 20, 5, 5}
+	operators := make([]MockStakingValidatorData, len(srcPowers))
+	for i, power := range srcPowers {
+		operators[i] = MockStakingValidatorData{Operator: bytes.Repeat([]byte{byte(i + 1)}, 20), Power: power}
+		gk.setValidatorEthereumAddress(ctx, operators[i].Operator, EthAddrs[i])
+	}
+	gk.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+
+	// without a cap every validator is included
+	require.Len(t, gk.CurrentSignerSet(ctx), 5)
+
+	params := gk.GetParams(ctx)
+	params.MaxSigners = 3
+	gk.setParams(ctx, params)
+
+	// only the three validators with the most power are included and their
+	// powers are normalized over the included power
+	signers := gk.CurrentSignerSet(ctx)
+	require.Len(t, signers, 3)
+	for i, signer := range signers {
+		require.Equal(t, EthAddrs[i].Hex(), signer.EthereumAddress)
+	}
+	require.Equal(t, []uint64{1908874353, 1431655765, 954437176}, signers.GetPowers())
+	require.Greater(t, signers.TotalPower(), uint64(types.EthereumSignaturePowerThreshold))
+
+	// the two validators with the most power can reach the threshold on their own
+	require.Greater(t, signers[0].Power+signers[1].Power, uint64(types.EthereumSignaturePowerThreshold))
+}
+
+func TestCurrentSignerSetMaxSignerPowerFraction(t *testing.T) {
+	specs := map[string]struct {
+		srcPowers   []int64
+		maxFraction sdk.Dec
+		expPowers   []uint64
+	}{
+		"excess redistributed until no signer is above the cap": {
+			srcPowers:   []int64{60, 20, 10, 10},
+			maxFraction: sdk.NewDecWithPrec(33, 2),
+			expPowers:   []uint64{1417339207, 1417339207, 730144440, 730144440},
+		},
+		"no signer above the cap": {
+			srcPowers:   []int64{25, 25, 25, 25},
+			maxFraction: sdk.NewDecWithPrec(5, 1),
+			expPowers:   []uint64{1073741823, 1073741823, 1073741823, 1073741823},
+		},
+		"cap raised to an equal share": {
+			srcPowers:   []int64{80, 15, 5},
+			maxFraction: sdk.NewDecWithPrec(2, 1),
+			expPowers:   []uint64{1431655765, 1431655765, 1431655765},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+			gk := input.GravityKeeper
+
+			operators := make([]MockStakingValidatorData, len(spec.srcPowers))
+			for i, power := range spec.srcPowers {
+				operators[i] = MockStakingValidatorData{Operator: bytes.Repeat([]byte{byte(i + 1)}, 20), Power: power}
+				gk.setValidatorEthereumAddress(ctx, operators[i].Operator, EthAddrs[i])
+			}
+			gk.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+			uncapped := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+
+			params := gk.GetParams(ctx)
+			params.MaxSignerPowerFraction = spec.maxFraction
+			gk.setParams(ctx, params)
+
+			// the capped powers are picked up as a power change
+			diff := gk.GetSignerSetDiff(ctx)
+			require.Equal(t, uncapped.Signers.PowerDiff(gk.CurrentSignerSet(ctx)) > 0, diff.PowerDiff.IsPositive())
+
+			sstx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+			require.Equal(t, spec.expPowers, sstx.Signers.GetPowers())
+			require.True(t, gk.GetSignerSetDiff(ctx).PowerDiff.IsZero())
+			require.NotEmpty(t, sstx.GetCheckpoint([]byte(gk.getGravityID(ctx))))
+
+			// the signers can still reach the contract's threshold, and with
+			// none of them above a third none can block it on its own
+			require.LessOrEqual(t, sstx.Signers.TotalPower(), uint64(math.MaxUint32))
+			require.Greater(t, sstx.Signers.TotalPower(), uint64(types.EthereumSignaturePowerThreshold))
+			for _, signer := range sstx.Signers {
+				require.Greater(t, sstx.Signers.TotalPower()-signer.Power, uint64(types.EthereumSignaturePowerThreshold))
+			}
+		})
+	}
+}
+
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -226,7 +322,7 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	i := 1
 	for ; i < 10; i++ {
 		ctx = ctx.WithBlockHeight(int64(i))
-		_ = k.CreateSignerSetTx(ctx)
+		_ = k.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 	}
 
 	latestValsetNonce := k.GetLatestSignerSetTxNonce(ctx)
@@ -512,6 +608,76 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 	})
 }
 
+func TestKeeper_DeleteOutgoingTxSignatures(t *testing.T) {
+	env := CreateTestEnv(t)
+	gk := env.GravityKeeper
+
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+	require.NoError(t, err)
+
+	ctx := env.Context.WithBlockHeight(10)
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		EthereumSigner: "0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09",
+		Signature:      []byte("fake-signature"),
+	}, valAddr)
+
+	require.Len(t, gk.GetEthereumSignatures(ctx, signerSetTx.GetStoreIndex()), 1)
+
+	// the signatures are deleted along with the tx
+	gk.DeleteOutgoingTx(ctx, signerSetTx.GetStoreIndex())
+	require.Nil(t, gk.GetOutgoingTx(ctx, signerSetTx.GetStoreIndex()))
+	require.Empty(t, gk.GetEthereumSignatures(ctx, signerSetTx.GetStoreIndex()))
+}
+
+func TestKeeper_ProduceSignerSetTxRefresh(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	reasons := func() (out []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeMultisigUpdateRequest {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeySignerSetTxReason {
+					out = append(out, string(attr.Value))
+				}
+			}
+		}
+		return out
+	}
+
+	created := gk.ProduceSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE)
+	createdCheckpoint := created.GetCheckpoint(gravityID)
+	require.True(t, gk.getPastEthereumSignatureCheckpoint(ctx, created))
+	gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: created.Nonce,
+		EthereumSigner: EthAddrs[1].Hex(),
+		Signature:      []byte("fake-signature"),
+	}, ValAddrs[1])
+
+	// a change within the same block refreshes the tx in place
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09"))
+	refreshed := gk.ProduceSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION)
+	require.Equal(t, created.Nonce, refreshed.Nonce)
+	require.NotEqual(t, createdCheckpoint, refreshed.GetCheckpoint(gravityID))
+
+	require.Equal(t, []string{
+		types.SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE.String(),
+		types.SignerSetTxReason_SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION.String(),
+	}, reasons())
+	// signatures over the replaced tx are dropped, its checkpoint is kept
+	require.Empty(t, gk.GetEthereumSignatures(ctx, refreshed.GetStoreIndex()))
+	res, err := gk.UnsignedSignerSetTxs(sdk.WrapSDKContext(ctx), &types.UnsignedSignerSetTxsRequest{Address: AccAddrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.SignerSets, 1)
+	require.True(t, gk.getPastEthereumSignatureCheckpoint(ctx, created))
+	require.True(t, gk.getPastEthereumSignatureCheckpoint(ctx, refreshed))
+}
+
 func TestKeeper_Migration(t *testing.T) {
 
 	input := CreateTestEnv(t)
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetEthereumKeyRotation returns the pending delegate key rotation of a validator, if any
+func (k Keeper) GetEthereumKeyRotation(ctx sdk.Context, val sdk.ValAddress) *types.EthereumKeyRotation {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumKeyRotationKey(val))
+	if bz == nil {
+		return nil
+	}
+
+	var rotation types.EthereumKeyRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return &rotation
+}
+
+func (k Keeper) setEthereumKeyRotation(ctx sdk.Context, val sdk.ValAddress, rotation types.EthereumKeyRotation) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumKeyRotationKey(val), k.cdc.MustMarshal(&rotation))
+}
+
+// iterateEthereumKeyRotations iterates over all pending delegate key rotations
+func (k Keeper) iterateEthereumKeyRotations(ctx sdk.Context, cb func(val sdk.ValAddress, rotation types.EthereumKeyRotation) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumKeyRotationKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.EthereumKeyRotation
+		k.cdc.MustUnmarshal(iter.Value(), &rotation)
+		if cb(iter.Key(), rotation) {
+			break
+		}
+	}
+}
+
+// completeEthereumKeyRotations removes the keys replaced by every rotation whose
+// signer set tx nonce is at or below the given observed signer set tx nonce
+func (k Keeper) completeEthereumKeyRotations(ctx sdk.Context, observedSignerSetNonce uint64) {
+	var completed []sdk.ValAddress
+	k.iterateEthereumKeyRotations(ctx, func(val sdk.ValAddress, rotation types.EthereumKeyRotation) bool {
+		if rotation.SignerSetNonce <= observedSignerSetNonce {
+			completed = append(completed, val)
+		}
+		return false
+	})
+
+	for _, val := range completed {
+		k.deleteEthereumKeyRotation(ctx, val)
+	}
+}
+
+// deleteEthereumKeyRotation removes a pending rotation along with the mappings
+// of the keys it replaced
+func (k Keeper) deleteEthereumKeyRotation(ctx sdk.Context, val sdk.ValAddress) {
+	rotation := k.GetEthereumKeyRotation(ctx, val)
+	if rotation == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeEthereumOrchestratorAddressKey(common.HexToAddress(rotation.OldEthereumAddress)))
+
+	// the orchestrator may have been kept through the rotation
+	if oldOrch, err := sdk.AccAddressFromBech32(rotation.OldOrchestratorAddress); err == nil {
+		currentOrch := k.GetEthereumOrchestratorAddress(ctx, k.GetValidatorEthereumAddress(ctx, val))
+		if !oldOrch.Equals(currentOrch) {
+			store.Delete(types.MakeOrchestratorValidatorAddressKey(oldOrch))
+		}
+	}
+
+	store.Delete(types.MakeEthereumKeyRotationKey(val))
+}
+
+// confirmationEthereumSigner returns the Ethereum signer of the confirmation
+// if it is the current Ethereum key of the validator, or the key being rotated
+// out, which remains valid until the rotation completes
+func (k Keeper) confirmationEthereumSigner(ctx sdk.Context, val sdk.ValAddress, confirmation types.EthereumTxConfirmation) (common.Address, bool) {
+	signer := confirmation.GetSigner()
+	if k.GetValidatorEthereumAddress(ctx, val) == signer {
+		return signer, true
+	}
+
+	rotation := k.GetEthereumKeyRotation(ctx, val)
+	if rotation == nil || common.HexToAddress(rotation.OldEthereumAddress) != signer {
+		return common.Address{}, false
+	}
+
+	return signer, true
+}
+
+// removedValidatorDelegateKeysScanSize is the number of validator delegate
+// keys checked against the staking store per block
+const removedValidatorDelegateKeysScanSize = 100
+
+// PruneRemovedValidatorDelegateKeys deletes the delegate keys of the validators
+// that have been removed from the staking store. The gravity staking hooks are
+// not registered so this can't be done in AfterValidatorRemoved, instead each
+// block checks the next removedValidatorDelegateKeysScanSize validators and
+// the scan wraps around once it reaches the last one.
+func (k Keeper) PruneRemovedValidatorDelegateKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	cursorKey := []byte{types.RemovedValidatorDelegateKeysCursorKey}
+
+	var (
+		removed []sdk.ValAddress
+		next    []byte
+		checked int
+	)
+	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(store.Get(cursorKey), nil)
+	for ; iter.Valid(); iter.Next() {
+		if checked == removedValidatorDelegateKeysScanSize {
+			next = iter.Key()
+			break
+		}
+		checked++
+
+		if _, found := k.StakingKeeper.GetValidator(ctx, iter.Key()); !found {
+			removed = append(removed, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, val := range removed {
+		k.deleteDelegateKeys(ctx, val)
+	}
+
+	if next == nil {
+		store.Delete(cursorKey)
+	} else {
+		store.Set(cursorKey, next)
+	}
+}
+
+// deleteDelegateKeys removes every delegate key mapping of a validator,
+// including the keys of a pending rotation
+func (k Keeper) deleteDelegateKeys(ctx sdk.Context, val sdk.ValAddress) {
+	k.deleteEthereumKeyRotation(ctx, val)
+	k.deleteValidatorPendingEthereumSignatures(ctx, val)
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.MakeValidatorEthereumAddressKey(val)) {
+		return
+	}
+
+	ethAddr := k.GetValidatorEthereumAddress(ctx, val)
+	if orch := k.GetEthereumOrchestratorAddress(ctx, ethAddr); orch != nil {
+		store.Delete(types.MakeOrchestratorValidatorAddressKey(orch))
+	}
+	store.Delete(types.MakeEthereumOrchestratorAddressKey(ethAddr))
+	store.Delete(types.MakeValidatorEthereumAddressKey(val))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v1"
+	v2 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v2"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,9 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v2.MigrateParams(ctx, m.keeper.paramSpace)
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.getGravityID(ctx))
+}
@@ -57,26 +57,11 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
 	}
 
-	valAccAddr := sdk.AccAddress(valAddr)
-	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
+	hash, nonce, err := k.delegateKeysSignHash(ctx, valAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
-	}
-
-	var nonce uint64
-	if valAccSeq > 0 {
-		nonce = valAccSeq - 1
+		return nil, err
 	}
 
-	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
-		ValidatorAddress: valAddr.String(),
-		// We decrement since we process the message after the ante-handler which
-		// increments the nonce.
-		Nonce: nonce,
-	})
-
-	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
-
 	if err = types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr); err != nil {
 		return nil, sdkerrors.Wrapf(
 			types.ErrDelegateKeys,
@@ -88,6 +73,7 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
 	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+	k.seedLastEventNonceByValidator(ctx, valAddr)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -103,6 +89,154 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 
 }
 
+// RotateEthereumKey handles MsgRotateEthereumKey
+func (k msgServer) RotateEthereumKey(c context.Context, msg *types.MsgRotateEthereumKey) (*types.MsgRotateEthereumKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	newEthAddr := common.HexToAddress(msg.EthereumAddress)
+
+	// ensure that the validator exists
+	if k.Keeper.StakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+	}
+
+	if !ctx.KVStore(k.storeKey).Has(types.MakeValidatorEthereumAddressKey(valAddr)) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "no delegate keys set for validator %s", valAddr)
+	}
+
+	if k.GetEthereumKeyRotation(ctx, valAddr) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "key rotation already pending for validator %s", valAddr)
+	}
+
+	oldEthAddr := k.GetValidatorEthereumAddress(ctx, valAddr)
+	oldOrchAddr := k.GetEthereumOrchestratorAddress(ctx, oldEthAddr)
+
+	// check if the new Ethereum address is currently not used
+	if k.validatorForEthAddressExists(ctx, newEthAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", newEthAddr)
+	}
+
+	// check if the orchestrator address is currently not used by another validator
+	if !orchAddr.Equals(oldOrchAddr) && k.ethAddressForOrchestratorExists(ctx, orchAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	hash, nonce, err := k.rotateEthereumKeySignHash(ctx, valAddr, oldEthAddr, newEthAddr, orchAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = types.ValidateEthereumSignature(hash, msg.OldEthSignature, oldEthAddr); err != nil {
+		return nil, sdkerrors.Wrapf(
+			types.ErrDelegateKeys,
+			"failed to validate key rotation signature for old Ethereum address %X; %s ;%d",
+			oldEthAddr, err, nonce,
+		)
+	}
+
+	if err = types.ValidateEthereumSignature(hash, msg.NewEthSignature, newEthAddr); err != nil {
+		return nil, sdkerrors.Wrapf(
+			types.ErrDelegateKeys,
+			"failed to validate key rotation signature for new Ethereum address %X; %s ;%d",
+			newEthAddr, err, nonce,
+		)
+	}
+
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	k.setValidatorEthereumAddress(ctx, valAddr, newEthAddr)
+	k.setEthereumOrchestratorAddress(ctx, newEthAddr, orchAddr)
+
+	// the old keys stay valid until the new signer set is observed on Ethereum
+	signerSetTx := k.ProduceSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION)
+	k.setEthereumKeyRotation(ctx, valAddr, types.EthereumKeyRotation{
+		ValidatorAddress:       valAddr.String(),
+		OldEthereumAddress:     oldEthAddr.Hex(),
+		OldOrchestratorAddress: oldOrchAddr.String(),
+		SignerSetNonce:         signerSetTx.Nonce,
+	})
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeEthereumKeyRotated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldEthereumAddr, oldEthAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(signerSetTx.Nonce)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, newEthAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	})
+
+	return &types.MsgRotateEthereumKeyResponse{}, nil
+}
+
+// delegateKeysSignHash returns the hash of the DelegateKeysSignMsg the Ethereum
+// keys of a validator are expected to sign along with the nonce used
+func (k Keeper) delegateKeysSignHash(ctx sdk.Context, valAddr sdk.ValAddress) ([]byte, uint64, error) {
+	nonce, err := k.delegateKeysNonce(ctx, valAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr.String(),
+		Nonce:            nonce,
+	})
+
+	return crypto.Keccak256Hash(signMsgBz).Bytes(), nonce, nil
+}
+
+// rotateEthereumKeySignHash returns the hash of the RotateEthereumKeySignMsg
+// that both the current and the new Ethereum keys of a validator sign to
+// rotate to the new key and orchestrator
+func (k Keeper) rotateEthereumKeySignHash(ctx sdk.Context, valAddr sdk.ValAddress, oldEthAddr, newEthAddr common.Address, orchAddr sdk.AccAddress) ([]byte, uint64, error) {
+	nonce, err := k.delegateKeysNonce(ctx, valAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	signMsgBz := k.cdc.MustMarshal(&types.RotateEthereumKeySignMsg{
+		ValidatorAddress:    valAddr.String(),
+		OldEthereumAddress:  oldEthAddr.Hex(),
+		NewEthereumAddress:  newEthAddr.Hex(),
+		OrchestratorAddress: orchAddr.String(),
+		Nonce:               nonce,
+	})
+
+	return crypto.Keccak256Hash(signMsgBz).Bytes(), nonce, nil
+}
+
+func (k Keeper) delegateKeysNonce(ctx sdk.Context, valAddr sdk.ValAddress) (uint64, error) {
+	valAccAddr := sdk.AccAddress(valAddr)
+	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
+	}
+
+	// We decrement since we process the message after the ante-handler which
+	// increments the nonce.
+	if valAccSeq > 0 {
+		return valAccSeq - 1, nil
+	}
+
+	return 0, nil
+}
+
 // SubmitEthereumTxConfirmation handles MsgSubmitEthereumTxConfirmation
 func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.MsgSubmitEthereumTxConfirmation) (*types.MsgSubmitEthereumTxConfirmationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -129,8 +263,8 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 	gravityID := k.getGravityID(ctx)
 	checkpoint := otx.GetCheckpoint([]byte(gravityID))
 
-	ethAddress := k.GetValidatorEthereumAddress(ctx, val)
-	if ethAddress != confirmation.GetSigner() {
+	ethAddress, ok := k.confirmationEthereumSigner(ctx, val, confirmation)
+	if !ok {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
 	}
 
@@ -158,6 +292,10 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 	}
 
 	key := k.SetEthereumSignature(ctx, confirmation, val)
+	k.updateValidatorBridgeStats(ctx, val, func(stats *types.ValidatorBridgeStats) {
+		stats.SignedConfirmations++
+	})
+	k.incrementRewardPoolParticipation(ctx, val)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -277,6 +415,37 @@ func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEth
 	return &types.MsgEthereumHeightVoteResponse{}, nil
 }
 
+// SubmitBadSignatureEvidence handles MsgSubmitBadSignatureEvidence
+func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	subject, err := types.UnpackOutgoingTx(msg.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	val, checkpoint, err := k.checkBadSignatureEvidence(ctx, subject, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeBadSignatureEvidence,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			sdk.NewAttribute(types.AttributeKeyCheckpoint, hex.EncodeToString(checkpoint)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -47,7 +48,7 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
 
 	// setup for GetOutgoingTx
-	signerSetTx := gk.CreateSignerSetTx(ctx)
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
 
 	// setup for ValidateEthereumSignature
 	gravityId := gk.getGravityID(ctx)
@@ -75,6 +76,87 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitBadSignatureEvidence(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], ethAddr)
+
+	msgServer := NewMsgServerImpl(gk)
+	gravityID := []byte(gk.getGravityID(ctx))
+
+	// a signature over a checkpoint produced by the chain isn't evidence
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint(gravityID), ethPrivKey)
+	require.NoError(t, err)
+	msg, err := types.NewMsgSubmitBadSignatureEvidence(signerSetTx, signature, AccAddrs[1])
+	require.NoError(t, err)
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// nor is it once the signer set tx has been pruned
+	gk.DeleteOutgoingTx(ctx, signerSetTx.GetStoreIndex())
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// a signature over a batch that never existed is
+	fakeBatch := &types.BatchTx{
+		BatchNonce:    100,
+		Timeout:       1000,
+		TokenContract: TokenContractAddrs[0],
+		Height:        uint64(ctx.BlockHeight()),
+	}
+	signature, err = types.NewEthereumSignature(fakeBatch.GetCheckpoint(gravityID), ethPrivKey)
+	require.NoError(t, err)
+	msg, err = types.NewMsgSubmitBadSignatureEvidence(fakeBatch, signature, AccAddrs[1])
+	require.NoError(t, err)
+
+	// unless its nonce may have been used before checkpoints were indexed
+	gk.setPastEthereumSignatureCheckpointFloors(ctx, types.PastEthereumSignatureCheckpointFloors{BatchNonce: fakeBatch.BatchNonce})
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	gk.setPastEthereumSignatureCheckpointFloors(ctx, types.PastEthereumSignatureCheckpointFloors{BatchNonce: fakeBatch.BatchNonce - 1})
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	require.True(t, val.IsJailed())
+	require.True(t, val.GetTokens().LT(tokensBefore))
+
+	// the same evidence can only be used once
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+}
+
+func TestMsgServer_SubmitBadSignatureEvidence_UnbondedValidator(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], crypto.PubkeyToAddress(ethPrivKey.PublicKey))
+
+	validator, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	validator = validator.UpdateStatus(stakingtypes.Unbonded)
+	input.StakingKeeper.SetValidator(ctx, validator)
+
+	fakeBatch := &types.BatchTx{BatchNonce: 100, Timeout: 1000, TokenContract: TokenContractAddrs[0]}
+	signature, err := types.NewEthereumSignature(fakeBatch.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	msg, err := types.NewMsgSubmitBadSignatureEvidence(fakeBatch, signature, AccAddrs[1])
+	require.NoError(t, err)
+
+	_, err = NewMsgServerImpl(gk).SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrBadSignatureEvidence)
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
+
 func TestMsgServer_SendToEthereum(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -313,6 +395,157 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_RotateEthereumKey(t *testing.T) {
+	oldEthPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	newEthPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		oldEthAddr  = crypto.PubkeyToAddress(oldEthPrivKey.PublicKey)
+		newEthAddr  = crypto.PubkeyToAddress(newEthPrivKey.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, oldEthAddr)
+	gk.setEthereumOrchestratorAddress(ctx, oldEthAddr, orcAddr1)
+
+	// Set the sequence to 1 because the antehandler will do this in the full
+	// chain.
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	// a delegate keys signature of the old key doesn't authorize a rotation
+	delegateSignMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr1.String(),
+		Nonce:            0,
+	})
+	delegateSig, err := types.NewEthereumSignature(crypto.Keccak256Hash(delegateSignMsgBz).Bytes(), oldEthPrivKey)
+	require.NoError(t, err)
+
+	signMsgBz := env.Marshaler.MustMarshal(&types.RotateEthereumKeySignMsg{
+		ValidatorAddress:    valAddr1.String(),
+		OldEthereumAddress:  oldEthAddr.Hex(),
+		NewEthereumAddress:  newEthAddr.Hex(),
+		OrchestratorAddress: orcAddr2.String(),
+		Nonce:               0,
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	oldSig, err := types.NewEthereumSignature(hash, oldEthPrivKey)
+	require.NoError(t, err)
+	newSig, err := types.NewEthereumSignature(hash, newEthPrivKey)
+	require.NoError(t, err)
+
+	msg := types.NewMsgRotateEthereumKey(valAddr1, orcAddr2, newEthAddr.Hex(), delegateSig, newSig)
+	_, err = msgServer.RotateEthereumKey(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// the signatures cover the orchestrator address
+	msg = types.NewMsgRotateEthereumKey(valAddr1, orcAddr1, newEthAddr.Hex(), oldSig, newSig)
+	_, err = msgServer.RotateEthereumKey(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// both keys must sign
+	msg = types.NewMsgRotateEthereumKey(valAddr1, orcAddr2, newEthAddr.Hex(), newSig, newSig)
+	_, err = msgServer.RotateEthereumKey(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	msg = types.NewMsgRotateEthereumKey(valAddr1, orcAddr2, newEthAddr.Hex(), oldSig, newSig)
+	_, err = msgServer.RotateEthereumKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	require.Equal(t, newEthAddr, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+	require.True(t, gk.validatorForEthAddressExists(ctx, oldEthAddr))
+
+	rotation := gk.GetEthereumKeyRotation(ctx, valAddr1)
+	require.NotNil(t, rotation)
+	signerSetTx := gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, signerSetTx.Nonce, rotation.SignerSetNonce)
+
+	// the old key may still confirm the signer set tx replacing it
+	signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), oldEthPrivKey)
+	require.NoError(t, err)
+	confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		EthereumSigner: oldEthAddr.Hex(),
+		Signature:      signature,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+		Confirmation: confirmation,
+		Signer:       orcAddr1.String(),
+	})
+	require.NoError(t, err)
+
+	// once the new signer set is observed the old keys are removed
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: signerSetTx.Nonce,
+		EthereumHeight:   10,
+		Members:          signerSetTx.Signers,
+	}))
+	require.Nil(t, gk.GetEthereumKeyRotation(ctx, valAddr1))
+	require.Nil(t, gk.GetEthereumOrchestratorAddress(ctx, oldEthAddr))
+	require.Nil(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	require.False(t, gk.validatorForEthAddressExists(ctx, oldEthAddr))
+
+	// and removing the validator cleans up the remaining ones
+	gk.PruneRemovedValidatorDelegateKeys(ctx)
+	require.Equal(t, newEthAddr, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	gk.StakingKeeper = NewStakingKeeperMock()
+	gk.PruneRemovedValidatorDelegateKeys(ctx)
+	require.Nil(t, gk.GetEthereumOrchestratorAddress(ctx, newEthAddr))
+	require.Nil(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+	require.False(t, gk.validatorForEthAddressExists(ctx, newEthAddr))
+}
+
+func TestKeeper_PruneRemovedValidatorDelegateKeys(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	bonded := sdk.ValAddress(bytes.Repeat([]byte{0}, 20))
+	gk.StakingKeeper = NewStakingKeeperMock(bonded)
+
+	vals := []sdk.ValAddress{bonded}
+	for i := 1; i <= removedValidatorDelegateKeysScanSize+10; i++ {
+		vals = append(vals, sdk.ValAddress(bytes.Repeat([]byte{byte(i)}, 20)))
+	}
+	for i, val := range vals {
+		ethAddr := common.BytesToAddress(bytes.Repeat([]byte{byte(i + 1)}, 20))
+		orch := sdk.AccAddress(bytes.Repeat([]byte{byte(i + 1)}, 20))
+		gk.SetOrchestratorValidatorAddress(ctx, val, orch)
+		gk.setValidatorEthereumAddress(ctx, val, ethAddr)
+		gk.setEthereumOrchestratorAddress(ctx, ethAddr, orch)
+	}
+
+	// the first block only checks the first scan size validators
+	gk.PruneRemovedValidatorDelegateKeys(ctx)
+	require.True(t, gk.validatorForEthAddressExists(ctx, gk.GetValidatorEthereumAddress(ctx, bonded)))
+	require.False(t, ctx.KVStore(gk.storeKey).Has(types.MakeValidatorEthereumAddressKey(vals[removedValidatorDelegateKeysScanSize-1])))
+	require.True(t, ctx.KVStore(gk.storeKey).Has(types.MakeValidatorEthereumAddressKey(vals[removedValidatorDelegateKeysScanSize])))
+
+	// the next block resumes from there and wraps around
+	gk.PruneRemovedValidatorDelegateKeys(ctx)
+	for _, val := range vals[1:] {
+		require.False(t, ctx.KVStore(gk.storeKey).Has(types.MakeValidatorEthereumAddressKey(val)))
+	}
+	require.False(t, ctx.KVStore(gk.storeKey).Has([]byte{types.RemovedValidatorDelegateKeysCursorKey}))
+	require.True(t, ctx.KVStore(gk.storeKey).Has(types.MakeValidatorEthereumAddressKey(bonded)))
+}
+
 func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	var (
 		env = CreateTestEnv(t)
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// The pending signature index holds, for every validator that registered an
+// Ethereum address, the outgoing txs that the validator has yet to sign. It
+// is what the Unsigned*Txs queries read, so that an orchestrator polling them
+// only touches its own entries.
+
+// iterateSignerValidators iterates over the validators that registered an Ethereum address
+func (k Keeper) iterateSignerValidators(ctx sdk.Context, cb func(val sdk.ValAddress) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+// setPendingEthereumSignatures marks a new outgoing tx as pending for every
+// validator that has not signed it yet
+func (k Keeper) setPendingEthereumSignatures(ctx sdk.Context, storeIndex []byte) {
+	store := ctx.KVStore(k.storeKey)
+	k.iterateSignerValidators(ctx, func(val sdk.ValAddress) bool {
+		if !store.Has(types.MakeEthereumSignatureKey(storeIndex, val)) {
+			store.Set(types.MakePendingEthereumSignatureKey(val, storeIndex), []byte{0x1})
+		}
+		return false
+	})
+}
+
+// deletePendingEthereumSignatures removes an outgoing tx from the pending
+// index of every validator
+func (k Keeper) deletePendingEthereumSignatures(ctx sdk.Context, storeIndex []byte) {
+	store := ctx.KVStore(k.storeKey)
+	k.iterateSignerValidators(ctx, func(val sdk.ValAddress) bool {
+		store.Delete(types.MakePendingEthereumSignatureKey(val, storeIndex))
+		return false
+	})
+}
+
+// setValidatorPendingEthereumSignatures marks every stored outgoing tx that
+// the validator has not signed as pending, used when a validator first
+// registers an Ethereum address
+func (k Keeper) setValidatorPendingEthereumSignatures(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		if !store.Has(types.MakeEthereumSignatureKey(otx.GetStoreIndex(), val)) {
+			store.Set(types.MakePendingEthereumSignatureKey(val, otx.GetStoreIndex()), []byte{0x1})
+		}
+		return false
+	})
+}
+
+// deleteValidatorPendingEthereumSignatures clears the pending index of a validator
+func (k Keeper) deleteValidatorPendingEthereumSignatures(ctx sdk.Context, val sdk.ValAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakePendingEthereumSignatureKey(val, nil))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// paginatePendingOutgoingTxs pages through the outgoing txs under the store
+// index prefix that the validator has yet to sign, in store index order.
+// Iteration starts at the first key after since, relative to the index
+// prefix, so txs at or below a nonce are skipped without being read. A nil
+// since starts at the beginning and a nil filter accepts every tx.
+func (k Keeper) paginatePendingOutgoingTxs(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	indexPrefix []byte,
+	since []byte,
+	pageReq *query.PageRequest,
+	filter func(otx types.OutgoingTx) bool,
+) ([]types.OutgoingTx, *query.PageResponse, error) {
+	var pendingStore storetypes.KVStore = prefix.NewStore(ctx.KVStore(k.storeKey), types.MakePendingEthereumSignatureKey(val, indexPrefix))
+	if since != nil {
+		pendingStore = lowerBoundStore{KVStore: pendingStore, lower: append(since, 0x00)}
+	}
+
+	var out []types.OutgoingTx
+	pageRes, err := query.FilteredPaginate(pendingStore, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		otx := k.GetOutgoingTx(ctx, append(append([]byte{}, indexPrefix...), key...))
+		if otx == nil || (filter != nil && !filter(otx)) {
+			return false, nil
+		}
+
+		if accumulate {
+			out = append(out, otx)
+		}
+		return true, nil
+	})
+
+	return out, pageRes, err
+}
+
+// lowerBoundStore clamps the start of every iterator to lower, so that
+// pagination with a key, an offset or in reverse never sees the keys below it
+type lowerBoundStore struct {
+	storetypes.KVStore
+	lower []byte
+}
+
+func (s lowerBoundStore) clamp(start []byte) []byte {
+	if start == nil || bytes.Compare(start, s.lower) < 0 {
+		return s.lower
+	}
+	return start
+}
+
+func (s lowerBoundStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.Iterator(s.clamp(start), end)
+}
+
+func (s lowerBoundStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.ReverseIterator(s.clamp(start), end)
+}
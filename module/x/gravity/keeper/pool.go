@@ -15,12 +15,18 @@ import (
 
 // createSendToEthereum
 // - checks a counterpart denominator exists for the given voucher type
+// - splits the reward pool's fraction off the fee
 // - burns the voucher for transfer amount and fees
+// - holds the reward pool's fraction of the fee until the send is executed
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
 func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	poolFee := k.bridgeFeeRewardPoolCut(ctx, fee)
+	fee = fee.Sub(poolFee)
+
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
+	heldInVouchers := totalInVouchers.Add(poolFee)
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
@@ -31,11 +37,11 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 	}
 
 	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, totalInVouchers); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, heldInVouchers); err != nil {
 			return 0, err
 		}
 	} else {
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, heldInVouchers); err != nil {
 			return 0, err
 		}
 	}
@@ -55,33 +61,36 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 	// rather than the denom that is the input to this function.
 
 	// set the unbatched transaction in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
+	ste := &types.SendToEthereum{
 		Id:                nextID,
 		Sender:            sender.String(),
 		EthereumRecipient: counterpartReceiver,
 		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
 		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
-	})
+	}
+	if poolFee.IsPositive() {
+		poolToken := types.NewSDKIntERC20Token(poolFee.Amount, tokenContract)
+		ste.Erc20RewardPoolFee = &poolToken
+	}
+	k.setUnbatchedSendToEthereum(ctx, ste)
 
 	return nextID, nil
 }
 
 // cancelSendToEthereum
-// - checks that the provided tx actually exists
-// - deletes the unbatched tx from the pool
-// - issues the tokens back to the sender
+//   - checks that the provided tx actually exists
+//   - deletes the unbatched tx from the pool
+//   - issues the tokens back to the sender, including the reward pool's
+//     fraction of the fee
 func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
-	var send *types.SendToEthereum
-	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
-		if ste.Id == id {
-			send = ste
-		}
-	}
+	send, batchNonce := k.getSendToEthereum(ctx, id)
 	if send == nil {
-		// NOTE: this case will also be hit if the transaction is in a batch
-		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
+		return sdkerrors.Wrapf(types.ErrSendToEthereumNotFound, "id %d", id)
+	}
+	if batchNonce != 0 {
+		return sdkerrors.Wrapf(types.ErrSendToEthereumBatched, "id %d in batch %d", id, batchNonce)
 	}
 
 	if sender.String() != send.Sender {
@@ -99,6 +108,10 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		}
 	}
 
+	if poolFee := rewardPoolFee(send); poolFee.IsPositive() {
+		coinsToRefund = coinsToRefund.Add(sdk.NewCoin(denom, poolFee))
+	}
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
 		return sdkerrors.Wrap(err, "sending coins from module account")
 	}
@@ -108,11 +121,54 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 }
 
 func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	store.Set(types.MakeSendToEthereumIDKey(ste.Id), k.cdc.MustMarshal(&ste.Erc20Fee))
 }
 
 func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToEthereumKey(id, fee))
+	store.Delete(types.MakeSendToEthereumIDKey(id))
+}
+
+// getUnbatchedSendToEthereum returns the send to ethereum with the given id
+// from the pool, or nil if it is not in the pool
+func (k Keeper) getUnbatchedSendToEthereum(ctx sdk.Context, id uint64) *types.SendToEthereum {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MakeSendToEthereumIDKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var fee types.ERC20Token
+	k.cdc.MustUnmarshal(bz, &fee)
+
+	var ste types.SendToEthereum
+	k.cdc.MustUnmarshal(store.Get(types.MakeSendToEthereumKey(id, fee)), &ste)
+	return &ste
+}
+
+// getSendToEthereum returns the send to ethereum with the given id along with
+// the nonce of the batch holding it, which is zero while it is unbatched
+func (k Keeper) getSendToEthereum(ctx sdk.Context, id uint64) (send *types.SendToEthereum, batchNonce uint64) {
+	if send = k.getUnbatchedSendToEthereum(ctx, id); send != nil {
+		return send, 0
+	}
+
+	storeIndex := ctx.KVStore(k.storeKey).Get(types.MakeBatchedSendToEthereumIDKey(id))
+	if storeIndex == nil {
+		return nil, 0
+	}
+
+	batchTx := k.GetOutgoingTx(ctx, storeIndex).(*types.BatchTx)
+	for _, ste := range batchTx.Transactions {
+		if ste.Id == id {
+			return ste, batchTx.BatchNonce
+		}
+	}
+
+	return nil, 0
 }
 
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
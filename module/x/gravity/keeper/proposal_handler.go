@@ -32,3 +32,10 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 
 	return nil
 }
+
+func (k Keeper) HandleForceSignerSetTxProposal(ctx sdk.Context, p *types.ForceSignerSetTxProposal) error {
+	signerSetTx := k.ProduceSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_GOVERNANCE)
+	k.Logger(ctx).Info("signer set tx created by governance", "nonce", signerSetTx.Nonce, "title", p.Title)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// currentRelaySignerSet returns the last observed signer set with its signers
+// in the order Gravity.sol holds them, or nil if none has been observed
+func (k Keeper) currentRelaySignerSet(ctx sdk.Context) *types.SignerSetTx {
+	signerSet := k.GetLastObservedSignerSetTx(ctx)
+	if signerSet == nil || len(signerSet.Signers) == 0 {
+		return nil
+	}
+
+	types.EthereumSigners(signerSet.Signers).Sort()
+	return signerSet
+}
+
+// relaySignatures aligns the signatures over an outgoing tx to the signers of
+// the current signer set and reports whether the signers carry more than the
+// Gravity.sol power threshold. Signatures are attributed by recovering their
+// Ethereum address, so keys in a rotation grace period count as well.
+func (k Keeper) relaySignatures(ctx sdk.Context, current *types.SignerSetTx, otx types.OutgoingTx) (types.RelaySignatures, bool) {
+	checkpoint := otx.GetCheckpoint([]byte(k.getGravityID(ctx)))
+
+	signatures := make(map[common.Address][]byte)
+	k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(_ sdk.ValAddress, signature []byte) bool {
+		if addr, err := types.EthereumAddressFromSignature(checkpoint, signature); err == nil {
+			signatures[addr] = signature
+		}
+		return false
+	})
+
+	out := types.RelaySignatures{
+		V: make([]uint32, len(current.Signers)),
+		R: make([][]byte, len(current.Signers)),
+		S: make([][]byte, len(current.Signers)),
+	}
+	var power uint64
+	for i, signer := range current.Signers {
+		signature, ok := signatures[common.HexToAddress(signer.EthereumAddress)]
+		if !ok {
+			continue
+		}
+
+		v, r, s, err := types.SplitEthereumSignature(signature)
+		if err != nil {
+			continue
+		}
+		out.V[i], out.R[i], out.S[i] = v, r, s
+		power += signer.Power
+	}
+
+	return out, power > types.EthereumSignaturePowerThreshold
+}
+
+// paginateRelayableOutgoingTxs pages through the outgoing txs of one type that
+// are signed by enough of the current signer set to be relayed
+func (k Keeper) paginateRelayableOutgoingTxs(
+	ctx sdk.Context,
+	current *types.SignerSetTx,
+	prefixByte byte,
+	pageReq *query.PageRequest,
+	filter func(otx types.OutgoingTx) bool,
+	cb func(otx types.OutgoingTx, signatures types.RelaySignatures),
+) (*query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+
+	return query.FilteredPaginate(prefixStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var otx types.OutgoingTx
+		if err := k.cdc.UnmarshalInterface(value, &otx); err != nil {
+			return false, err
+		}
+		if !filter(otx) {
+			return false, nil
+		}
+
+		signatures, ok := k.relaySignatures(ctx, current, otx)
+		if !ok {
+			return false, nil
+		}
+
+		if accumulate {
+			cb(otx, signatures)
+		}
+		return true, nil
+	})
+}
+
+// relayCalldata encodes the Gravity.sol calldata relaying the outgoing tx at
+// the store index, provided it is signed by enough of the current signer set
+func (k Keeper) relayCalldata(
+	ctx sdk.Context,
+	storeIndex []byte,
+	encode func(otx types.OutgoingTx, current *types.SignerSetTx, signatures types.RelaySignatures) ([]byte, error),
+) ([]byte, error) {
+	otx := k.GetOutgoingTx(ctx, storeIndex)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for %X", storeIndex)
+	}
+
+	current := k.currentRelaySignerSet(ctx)
+	if current == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no signer set has been observed on Ethereum")
+	}
+
+	signatures, ok := k.relaySignatures(ctx, current, otx)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "outgoing tx %X is not signed by enough of signer set %d", storeIndex, current.Nonce)
+	}
+
+	return encode(otx, current, signatures)
+}
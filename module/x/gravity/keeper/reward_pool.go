@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetRewardPoolBalance returns the coins held by the reward pool module account
+func (k Keeper) GetRewardPoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.RewardPoolName))
+}
+
+// GetValidatorRewards returns the reward pool participation and payouts of a validator
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, val sdk.ValAddress) types.ValidatorRewards {
+	rewards := types.ValidatorRewards{ValidatorAddress: val.String()}
+	if bz := ctx.KVStore(k.storeKey).Get(types.MakeValidatorRewardsKey(val)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &rewards)
+	}
+
+	return rewards
+}
+
+func (k Keeper) setValidatorRewards(ctx sdk.Context, val sdk.ValAddress, rewards types.ValidatorRewards) {
+	ctx.KVStore(k.storeKey).Set(types.MakeValidatorRewardsKey(val), k.cdc.MustMarshal(&rewards))
+}
+
+func (k Keeper) iterateValidatorRewards(ctx sdk.Context, cb func(sdk.ValAddress, types.ValidatorRewards) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorRewardsKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		if cb(iter.Key(), rewards) {
+			break
+		}
+	}
+}
+
+// incrementRewardPoolParticipation credits a validator with one unit of
+// participation in the current reward pool epoch
+func (k Keeper) incrementRewardPoolParticipation(ctx sdk.Context, val sdk.ValAddress) {
+	rewards := k.GetValidatorRewards(ctx, val)
+	rewards.Participation++
+	k.setValidatorRewards(ctx, val, rewards)
+}
+
+// GetRewardPoolTotalParticipation returns the participation of all validators in the current epoch
+func (k Keeper) GetRewardPoolTotalParticipation(ctx sdk.Context) (total uint64) {
+	k.iterateValidatorRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorRewards) bool {
+		total += rewards.Participation
+		return false
+	})
+	return total
+}
+
+// bridgeFeeRewardPoolCut returns the governance set fraction of a bridge fee
+// owed to the reward pool
+func (k Keeper) bridgeFeeRewardPoolCut(ctx sdk.Context, fee sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(fee.Denom, k.GetParams(ctx).BridgeFeeRewardPoolFraction.MulInt(fee.Amount).TruncateInt())
+}
+
+// rewardPoolFee returns the reward pool's fraction of the bridge fee held for
+// a send to Ethereum
+func rewardPoolFee(ste *types.SendToEthereum) sdk.Int {
+	if ste.Erc20RewardPoolFee == nil {
+		return sdk.ZeroInt()
+	}
+	return ste.Erc20RewardPoolFee.Amount
+}
+
+// fundRewardPoolFromBatchTx moves the reward pool's fraction of the bridge
+// fees of an executed batch, held by the gravity module since the sends were
+// created, into the reward pool
+func (k Keeper) fundRewardPoolFromBatchTx(ctx sdk.Context, batchTx *types.BatchTx) {
+	total := sdk.ZeroInt()
+	for _, ste := range batchTx.Transactions {
+		total = total.Add(rewardPoolFee(ste))
+	}
+	if !total.IsPositive() {
+		return
+	}
+
+	_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(batchTx.TokenContract))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RewardPoolName, sdk.NewCoins(sdk.NewCoin(denom, total))); err != nil {
+		panic(err)
+	}
+}
+
+// rewardPoolShare returns the part of the balance owed for the participation, rounded down
+func rewardPoolShare(balance sdk.Coins, participation, total uint64) sdk.Coins {
+	if total == 0 {
+		return sdk.NewCoins()
+	}
+
+	share, _ := sdk.NewDecCoinsFromCoins(balance...).
+		MulDec(sdk.NewDec(int64(participation))).
+		QuoDec(sdk.NewDec(int64(total))).
+		TruncateDecimal()
+	return share
+}
+
+// DistributeRewardPool pays out the reward pool to validators in proportion to
+// their participation in the epoch that just ended and starts a new epoch.
+// Depending on the params, rewards are allocated to validators through the
+// distribution module, and so shared with their delegators, or sent directly
+// to the validator operator. Rounding dust, the shares of validators that no
+// longer exist and the shares that failed to be paid out remain in the pool for
+// the next epoch.
+func (k Keeper) DistributeRewardPool(ctx sdk.Context) {
+	balance := k.GetRewardPoolBalance(ctx)
+	total := k.GetRewardPoolTotalParticipation(ctx)
+	toDistribution := k.GetParams(ctx).RewardPoolToDistribution
+
+	var participants []sdk.ValAddress
+	k.iterateValidatorRewards(ctx, func(val sdk.ValAddress, rewards types.ValidatorRewards) bool {
+		if rewards.Participation > 0 {
+			participants = append(participants, val)
+		}
+		return false
+	})
+
+	for _, val := range participants {
+		rewards := k.GetValidatorRewards(ctx, val)
+		participation := rewards.Participation
+		share := rewardPoolShare(balance, participation, total)
+		rewards.Participation = 0
+
+		validator := k.StakingKeeper.Validator(ctx, val)
+		if validator == nil || share.IsZero() {
+			k.setValidatorRewards(ctx, val, rewards)
+			continue
+		}
+
+		// pay out with a transient storage so that a failed payout leaves the
+		// share in the pool for the next epoch
+		xCtx, commit := ctx.CacheContext()
+		var err error
+		if toDistribution {
+			if err = k.bankKeeper.SendCoinsFromModuleToModule(xCtx, types.RewardPoolName, distributiontypes.ModuleName, share); err == nil {
+				k.DistributionKeeper.AllocateTokensToValidator(xCtx, validator, sdk.NewDecCoinsFromCoins(share...))
+			}
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(xCtx, types.RewardPoolName, sdk.AccAddress(val), share)
+		}
+		if err != nil {
+			k.Logger(ctx).Error(
+				"reward pool distribution failed",
+				"cause", err.Error(),
+				"validator", val.String(),
+				"amount", share.String(),
+			)
+			k.setValidatorRewards(ctx, val, rewards)
+			continue
+		}
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		commit()
+
+		rewards.Distributed = rewards.Distributed.Add(share...)
+		k.setValidatorRewards(ctx, val, rewards)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRewardPoolDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+			sdk.NewAttribute(types.AttributeKeyParticipation, fmt.Sprint(participation)),
+		))
+	}
+}
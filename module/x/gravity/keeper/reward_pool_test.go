@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRewardPool(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		voucher             = types.NewERC20Token(1000, myTokenContractAddr).GravityCoin()
+	)
+
+	params := gk.GetParams(ctx)
+	params.BridgeFeeRewardPoolFraction = sdk.NewDecWithPrec(5, 1)
+	params.RewardPoolToDistribution = false
+	gk.setParams(ctx, params)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{voucher}))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.Coins{voucher}))
+
+	// half of the bridge fee is held for the reward pool
+	amount := sdk.NewCoin(voucher.Denom, sdk.NewInt(100))
+	fee := sdk.NewCoin(voucher.Denom, sdk.NewInt(81))
+	_, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.NoError(t, err)
+	require.True(t, gk.GetRewardPoolBalance(ctx).IsZero())
+	require.Equal(t, sdk.NewInt(819), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount)
+
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		require.Equal(t, sdk.NewInt(41), ste.Erc20Fee.Amount)
+		require.Equal(t, sdk.NewInt(40), ste.Erc20RewardPoolFee.Amount)
+		return false
+	})
+
+	// a canceled send is refunded in full
+	canceledID, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, sdk.NewCoin(voucher.Denom, sdk.NewInt(20)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(699), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount)
+	require.NoError(t, gk.cancelSendToEthereum(ctx, canceledID, mySender.String()))
+	require.Equal(t, sdk.NewInt(819), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount)
+	require.True(t, gk.GetRewardPoolBalance(ctx).IsZero())
+
+	// and the reward pool is paid once the batch is executed
+	batch := gk.CreateBatchTx(ctx, myTokenContractAddr, 10)
+	require.NotNil(t, batch)
+	gk.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce, types.OutgoingTxExecution{})
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(40))), gk.GetRewardPoolBalance(ctx))
+
+	// rewards are shared in proportion to participation
+	for i := 0; i < 3; i++ {
+		gk.incrementRewardPoolParticipation(ctx, ValAddrs[0])
+	}
+	gk.incrementRewardPoolParticipation(ctx, ValAddrs[1])
+	require.EqualValues(t, 4, gk.GetRewardPoolTotalParticipation(ctx))
+
+	res, err := gk.ValidatorRewards(sdk.WrapSDKContext(ctx), &types.ValidatorRewardsRequest{ValidatorAddress: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(30))), res.Accrued)
+
+	gk.DistributeRewardPool(ctx)
+
+	require.Equal(t, sdk.NewInt(30), input.BankKeeper.GetBalance(ctx, sdk.AccAddress(ValAddrs[0]), voucher.Denom).Amount)
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, sdk.AccAddress(ValAddrs[1]), voucher.Denom).Amount)
+	require.True(t, gk.GetRewardPoolBalance(ctx).IsZero())
+	require.Zero(t, gk.GetRewardPoolTotalParticipation(ctx))
+
+	rewards := gk.GetValidatorRewards(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(30))), rewards.Distributed)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// setObservedSignerSet appends an observed signer set to the history
+func (k Keeper) setObservedSignerSet(ctx sdk.Context, observed types.ObservedSignerSet) {
+	key := types.MakeObservedSignerSetKey(observed.EthereumHeight, observed.SignerSetNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&observed))
+}
+
+// GetObservedSignerSetAtEthereumHeight returns the last signer set observed at
+// or below the Ethereum height, which is the one that controlled the bridge at
+// that height, or nil if the history does not reach back that far
+func (k Keeper) GetObservedSignerSetAtEthereumHeight(ctx sdk.Context, ethereumHeight uint64) *types.ObservedSignerSet {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetKey})
+	iter := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(ethereumHeight+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+
+	var out types.ObservedSignerSet
+	k.cdc.MustUnmarshal(iter.Value(), &out)
+	return &out
+}
+
+// IterateObservedSignerSets iterates through the observed signer set history
+// in Ethereum height order
+func (k Keeper) IterateObservedSignerSets(ctx sdk.Context, cb func(types.ObservedSignerSet) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var observed types.ObservedSignerSet
+		k.cdc.MustUnmarshal(iter.Value(), &observed)
+		// cb returns true to stop early
+		if cb(observed) {
+			return
+		}
+	}
+}
+
+// PruneObservedSignerSetHistory deletes the observed signer sets that were
+// replaced more than the retention window ago. The latest observed signer set
+// is never pruned, it still controls the bridge.
+func (k Keeper) PruneObservedSignerSetHistory(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ObservedSignerSetHistoryRetentionBlocks
+	if retention == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var (
+		expired  [][]byte
+		previous []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		var observed types.ObservedSignerSet
+		k.cdc.MustUnmarshal(iter.Value(), &observed)
+		// the history is in observation order, so once a replacement is within
+		// the window every later one is too
+		if observed.CosmosHeight+retention > uint64(ctx.BlockHeight()) {
+			break
+		}
+		if previous != nil {
+			expired = append(expired, previous)
+		}
+		previous = iter.Key()
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestKeeper_ObservedSignerSetHistory(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	observe := func(cosmosHeight int64, nonce, ethereumHeight uint64) {
+		ctx = ctx.WithBlockHeight(cosmosHeight)
+		require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+			SignerSetTxNonce: nonce,
+			EthereumHeight:   ethereumHeight,
+			Members:          types.EthereumSigners{{Power: nonce, EthereumAddress: EthAddrs[nonce].Hex()}},
+		}))
+	}
+	observe(10, 1, 100)
+	observe(20, 2, 200)
+	observe(30, 3, 300)
+
+	require.Nil(t, gk.GetObservedSignerSetAtEthereumHeight(ctx, 99))
+	for ethereumHeight, nonce := range map[uint64]uint64{100: 1, 199: 1, 200: 2, 299: 2, 300: 3, 1000: 3} {
+		observed := gk.GetObservedSignerSetAtEthereumHeight(ctx, ethereumHeight)
+		require.NotNil(t, observed)
+		require.Equal(t, nonce, observed.SignerSetNonce)
+	}
+	observed := gk.GetObservedSignerSetAtEthereumHeight(ctx, 250)
+	require.Equal(t, types.ObservedSignerSet{
+		SignerSetNonce: 2,
+		Signers:        types.EthereumSigners{{Power: 2, EthereumAddress: EthAddrs[2].Hex()}},
+		EthereumHeight: 200,
+		CosmosHeight:   20,
+	}, *observed)
+
+	history := func() (nonces []uint64) {
+		gk.IterateObservedSignerSets(ctx, func(observed types.ObservedSignerSet) bool {
+			nonces = append(nonces, observed.SignerSetNonce)
+			return false
+		})
+		return nonces
+	}
+
+	// a zero retention keeps the whole history
+	ctx = ctx.WithBlockHeight(1000)
+	gk.PruneObservedSignerSetHistory(ctx)
+	require.Equal(t, []uint64{1, 2, 3}, history())
+
+	params := gk.GetParams(ctx)
+	params.ObservedSignerSetHistoryRetentionBlocks = 15
+	gk.setParams(ctx, params)
+
+	// the first set was replaced at height 20, the second at height 30
+	ctx = ctx.WithBlockHeight(35)
+	gk.PruneObservedSignerSetHistory(ctx)
+	require.Equal(t, []uint64{2, 3}, history())
+	require.Nil(t, gk.GetObservedSignerSetAtEthereumHeight(ctx, 150))
+
+	// the latest set still controls the bridge and is never pruned
+	ctx = ctx.WithBlockHeight(1000)
+	gk.PruneObservedSignerSetHistory(ctx)
+	require.Equal(t, []uint64{3}, history())
+}
@@ -181,21 +181,35 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                                 "testgravityid",
-		ContractSourceHash:                        "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:                     "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                             11,
-		SignedBatchesWindow:                       10,
-		SignedSignerSetTxsWindow:                  10,
-		UnbondSlashingSignerSetTxsWindow:          15,
-		EthereumSignaturesWindow:                  10,
-		TargetEthTxTimeout:                        60001,
-		AverageBlockTime:                          5000,
-		AverageEthereumBlockTime:                  15000,
-		SlashFractionSignerSetTx:                  sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:                        sdk.NewDecWithPrec(1, 2),
-		SlashFractionEthereumSignature:            sdk.NewDecWithPrec(1, 2),
-		SlashFractionConflictingEthereumSignature: sdk.NewDecWithPrec(1, 2),
+		GravityId:                                      "testgravityid",
+		ContractSourceHash:                             "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:                          "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                                  11,
+		SignedBatchesWindow:                            10,
+		SignedSignerSetTxsWindow:                       10,
+		UnbondSlashingSignerSetTxsWindow:               15,
+		EthereumSignaturesWindow:                       10,
+		TargetEthTxTimeout:                             60001,
+		AverageBlockTime:                               5000,
+		AverageEthereumBlockTime:                       15000,
+		SlashFractionSignerSetTx:                       sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:                             sdk.NewDecWithPrec(1, 2),
+		SlashFractionEthereumSignature:                 sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingEthereumSignature:      sdk.NewDecWithPrec(1, 2),
+		BridgeFeeRewardPoolFraction:                    sdk.ZeroDec(),
+		RewardPoolEpochBlocks:                          100,
+		RewardPoolToDistribution:                       true,
+		EventVoteRecordRetentionBlocks:                 100,
+		ObservedSignerSetHistoryRetentionBlocks:        0,
+		ExecutedOutgoingTxRetentionBlocks:              0,
+		BridgeHealthThresholds:                         types.DefaultBridgeHealthThresholds(),
+		SignerSetTxPowerDiffThreshold:                  sdk.NewDecWithPrec(5, 2),
+		SignerSetTxMinBlocks:                           0,
+		SignerSetTxMaxAgeBlocks:                        0,
+		MaxSigners:                                     0,
+		MaxSignerPowerFraction:                         sdk.ZeroDec(),
+		ValidatorBridgeStatsEpochBlocks:                0,
+		PastEthereumSignatureCheckpointRetentionBlocks: 0,
 	}
 )
 
@@ -332,6 +346,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		types.RewardPoolName:           nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -633,7 +648,12 @@ func (s *StakingKeeperMock) GetParams(ctx sdk.Context) stakingtypes.Params {
 }
 
 func (s *StakingKeeperMock) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool) {
-	panic("unexpected call")
+	for _, v := range s.BondedValidators {
+		if v.GetOperator().Equals(addr) {
+			return v, true
+		}
+	}
+	return stakingtypes.Validator{}, false
 }
 
 func (s *StakingKeeperMock) ValidatorQueueIterator(ctx sdk.Context, endTime time.Time, endHeight int64) sdk.Iterator {
@@ -0,0 +1,29 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateParams sets the params introduced in consensus version 3 to their defaults
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	ctx.Logger().Info("Gravity v2 to v3: Setting new params")
+
+	defaults := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamsStoreKeyBridgeFeeRewardPoolFraction, defaults.BridgeFeeRewardPoolFraction)
+	paramSpace.Set(ctx, types.ParamsStoreKeyRewardPoolEpochBlocks, defaults.RewardPoolEpochBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyRewardPoolToDistribution, defaults.RewardPoolToDistribution)
+	paramSpace.Set(ctx, types.ParamsStoreKeyEventVoteRecordRetentionBlocks, defaults.EventVoteRecordRetentionBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyObservedSignerSetHistoryRetentionBlocks, defaults.ObservedSignerSetHistoryRetentionBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyExecutedOutgoingTxRetentionBlocks, defaults.ExecutedOutgoingTxRetentionBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyBridgeHealthThresholds, defaults.BridgeHealthThresholds)
+	paramSpace.Set(ctx, types.ParamsStoreKeySignerSetTxPowerDiffThreshold, defaults.SignerSetTxPowerDiffThreshold)
+	paramSpace.Set(ctx, types.ParamsStoreKeySignerSetTxMinBlocks, defaults.SignerSetTxMinBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeySignerSetTxMaxAgeBlocks, defaults.SignerSetTxMaxAgeBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyMaxSigners, defaults.MaxSigners)
+	paramSpace.Set(ctx, types.ParamsStoreKeyMaxSignerPowerFraction, defaults.MaxSignerPowerFraction)
+	paramSpace.Set(ctx, types.ParamsStoreKeyValidatorBridgeStatsEpochBlocks, defaults.ValidatorBridgeStatsEpochBlocks)
+	paramSpace.Set(ctx, types.ParamsStoreKeyPastEthereumSignatureCheckpointRetentionBlocks, defaults.PastEthereumSignatureCheckpointRetentionBlocks)
+}
@@ -0,0 +1,293 @@
+package v2
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, gravityID string) error {
+	ctx.Logger().Info("Gravity v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	// before the event vote records of executed contract calls are pruned
+	migratePastEthereumSignatureCheckpoints(store, cdc, gravityID, uint64(ctx.BlockHeight()))
+	migratePendingEthereumEventVoteRecords(store, cdc)
+	pruneEthereumEventVoteRecords(store, cdc, uint64(ctx.BlockHeight()))
+	migrateLastEventNonceByValidator(store, cdc)
+	migrateOutgoingTxTimeouts(store, cdc)
+	migrateSendToEthereumIDs(store, cdc)
+	deleteOrphanedEthereumSignatures(store)
+	migratePendingEthereumSignatures(store)
+	migrateLastObservedEventHeight(store, uint64(ctx.BlockHeight()))
+
+	ctx.Logger().Info("Gravity v2 to v3: Store migration complete")
+
+	return nil
+}
+
+// migratePastEthereumSignatureCheckpoints indexes the checkpoints of the stored
+// outgoing txs. The checkpoints of the txs already pruned can't be rebuilt, so
+// the nonces they may have used are recorded as floors at or below which bad
+// signature evidence is rejected: the latest signer set and batch nonces, and
+// for contract calls, whose nonces are chosen by their creator, the highest
+// invalidation nonce of the stored and executed ones.
+func migratePastEthereumSignatureCheckpoints(store storetypes.KVStore, cdc codec.BinaryCodec, gravityID string, height uint64) {
+	var floors types.PastEthereumSignatureCheckpointFloors
+	if bz := store.Get([]byte{types.LatestSignerSetTxNonceKey}); len(bz) != 0 {
+		floors.SignerSetNonce = binary.BigEndian.Uint64(bz)
+	}
+	if bz := store.Get([]byte{types.LastOutgoingBatchNonceKey}); len(bz) != 0 {
+		floors.BatchNonce = binary.BigEndian.Uint64(bz)
+	}
+
+	otxIter := prefix.NewStore(store, []byte{types.OutgoingTxKey}).Iterator(nil, nil)
+	for ; otxIter.Valid(); otxIter.Next() {
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(otxIter.Value(), &otx); err != nil {
+			panic(err)
+		}
+		txType, nonce := types.OutgoingTxTypeAndNonce(otx)
+		store.Set(types.MakePastEthereumSignatureCheckpointKey(txType, nonce, otx.GetCheckpoint([]byte(gravityID))), sdk.Uint64ToBigEndian(height))
+		if cctx, ok := otx.(*types.ContractCallTx); ok && cctx.InvalidationNonce > floors.ContractCallInvalidationNonce {
+			floors.ContractCallInvalidationNonce = cctx.InvalidationNonce
+		}
+	}
+	otxIter.Close()
+
+	evrIter := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey}).Iterator(nil, nil)
+	for ; evrIter.Valid(); evrIter.Next() {
+		var record types.EthereumEventVoteRecord
+		cdc.MustUnmarshal(evrIter.Value(), &record)
+		event, err := types.UnpackEvent(record.Event)
+		if err != nil {
+			panic(err)
+		}
+		if executed, ok := event.(*types.ContractCallExecutedEvent); ok && executed.InvalidationNonce > floors.ContractCallInvalidationNonce {
+			floors.ContractCallInvalidationNonce = executed.InvalidationNonce
+		}
+	}
+	evrIter.Close()
+
+	store.Set([]byte{types.PastEthereumSignatureCheckpointFloorsKey}, cdc.MustMarshal(&floors))
+}
+
+// migratePendingEthereumEventVoteRecords indexes the event vote records that
+// have not been accepted and whose nonce has not been observed yet
+func migratePendingEthereumEventVoteRecords(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	var lastObservedEventNonce uint64
+	if bz := store.Get([]byte{types.LastObservedEventNonceKey}); len(bz) != 0 {
+		lastObservedEventNonce = binary.BigEndian.Uint64(bz)
+	}
+
+	prefixStore := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey})
+	iter := prefixStore.Iterator(sdk.Uint64ToBigEndian(lastObservedEventNonce+1), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.EthereumEventVoteRecord
+		cdc.MustUnmarshal(iter.Value(), &record)
+		if record.Accepted {
+			continue
+		}
+
+		key := iter.Key()
+		store.Set(types.MakePendingEthereumEventVoteRecordKey(binary.BigEndian.Uint64(key[:8]), key[8:]), []byte{0x1})
+	}
+}
+
+// pruneEthereumEventVoteRecords deletes the event vote records below the last
+// observed nonce. The accepted records at the last observed nonce are stamped
+// with the upgrade height so they are pruned once the retention window passes.
+func pruneEthereumEventVoteRecords(store storetypes.KVStore, cdc codec.BinaryCodec, height uint64) {
+	var lastObservedEventNonce uint64
+	if bz := store.Get([]byte{types.LastObservedEventNonceKey}); len(bz) != 0 {
+		lastObservedEventNonce = binary.BigEndian.Uint64(bz)
+	}
+	if lastObservedEventNonce == 0 {
+		return
+	}
+
+	prefixStore := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey})
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(lastObservedEventNonce+1))
+	defer iter.Close()
+
+	var (
+		expired [][]byte
+		stamped = make(map[string][]byte)
+	)
+	for ; iter.Valid(); iter.Next() {
+		if binary.BigEndian.Uint64(iter.Key()[:8]) < lastObservedEventNonce {
+			expired = append(expired, iter.Key())
+			continue
+		}
+
+		var record types.EthereumEventVoteRecord
+		cdc.MustUnmarshal(iter.Value(), &record)
+		if record.Accepted {
+			record.Height = height
+			stamped[string(iter.Key())] = cdc.MustMarshal(&record)
+		}
+	}
+
+	for _, key := range expired {
+		prefixStore.Delete(key)
+	}
+	for key, bz := range stamped {
+		prefixStore.Set([]byte(key), bz)
+	}
+}
+
+// migrateOutgoingTxTimeouts indexes the stored batch and contract call txs by their timeout
+func migrateOutgoingTxTimeouts(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	for _, prefixByte := range []byte{types.BatchTxPrefixByte, types.ContractCallTxPrefixByte} {
+		prefixStore := prefix.NewStore(store, types.MakeOutgoingTxKey([]byte{prefixByte}))
+		iter := prefixStore.Iterator(nil, nil)
+
+		for ; iter.Valid(); iter.Next() {
+			var otx types.OutgoingTx
+			if err := cdc.UnmarshalInterface(iter.Value(), &otx); err != nil {
+				panic(err)
+			}
+
+			var timeout uint64
+			switch tx := otx.(type) {
+			case *types.BatchTx:
+				timeout = tx.Timeout
+			case *types.ContractCallTx:
+				timeout = tx.Timeout
+			}
+			store.Set(types.MakeOutgoingTxTimeoutKey(timeout, otx.GetStoreIndex()), []byte{0x1})
+		}
+
+		iter.Close()
+	}
+}
+
+// migrateSendToEthereumIDs indexes the fee of each unbatched send to ethereum,
+// and the batch tx holding each batched one, by id
+func migrateSendToEthereumIDs(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	prefixStore := prefix.NewStore(store, []byte{types.SendToEthereumKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var ste types.SendToEthereum
+		cdc.MustUnmarshal(iter.Value(), &ste)
+		store.Set(types.MakeSendToEthereumIDKey(ste.Id), cdc.MustMarshal(&ste.Erc20Fee))
+	}
+
+	batchIter := prefix.NewStore(store, types.MakeOutgoingTxKey([]byte{types.BatchTxPrefixByte})).Iterator(nil, nil)
+	defer batchIter.Close()
+
+	for ; batchIter.Valid(); batchIter.Next() {
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(batchIter.Value(), &otx); err != nil {
+			panic(err)
+		}
+		btx := otx.(*types.BatchTx)
+		for _, ste := range btx.Transactions {
+			store.Set(types.MakeBatchedSendToEthereumIDKey(ste.Id), btx.GetStoreIndex())
+		}
+	}
+}
+
+// deleteOrphanedEthereumSignatures deletes the signatures left behind by
+// outgoing txs that were deleted before signatures were pruned with them
+func deleteOrphanedEthereumSignatures(store storetypes.KVStore) {
+	prefixStore := prefix.NewStore(store, []byte{types.EthereumSignatureKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var orphaned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		// signature keys end with the 20 byte validator address
+		storeIndex := iter.Key()[:len(iter.Key())-20]
+		if !store.Has(types.MakeOutgoingTxKey(storeIndex)) {
+			orphaned = append(orphaned, iter.Key())
+		}
+	}
+
+	for _, key := range orphaned {
+		prefixStore.Delete(key)
+	}
+}
+
+// migratePendingEthereumSignatures indexes, for every validator with a
+// registered Ethereum address, the stored outgoing txs it has yet to sign
+func migratePendingEthereumSignatures(store storetypes.KVStore) {
+	var validators []sdk.ValAddress
+	valIter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	for ; valIter.Valid(); valIter.Next() {
+		validators = append(validators, valIter.Key())
+	}
+	valIter.Close()
+
+	otxIter := prefix.NewStore(store, []byte{types.OutgoingTxKey}).Iterator(nil, nil)
+	defer otxIter.Close()
+
+	for ; otxIter.Valid(); otxIter.Next() {
+		storeIndex := otxIter.Key()
+		for _, val := range validators {
+			if !store.Has(types.MakeEthereumSignatureKey(storeIndex, val)) {
+				store.Set(types.MakePendingEthereumSignatureKey(val, storeIndex), []byte{0x1})
+			}
+		}
+	}
+}
+
+// migrateLastEventNonceByValidator sets the latest event nonce of the
+// validators with delegate keys that have yet to submit an event, which v2
+// computed from the stored event vote records on every read. They start at
+// the lowest accepted event left after pruning, or the last observed event
+// nonce if there is none.
+func migrateLastEventNonceByValidator(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	var lowestObserved uint64
+	if bz := store.Get([]byte{types.LastObservedEventNonceKey}); len(bz) != 0 {
+		lowestObserved = binary.BigEndian.Uint64(bz)
+	}
+
+	evrIter := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey}).Iterator(nil, nil)
+	for ; evrIter.Valid(); evrIter.Next() {
+		var record types.EthereumEventVoteRecord
+		cdc.MustUnmarshal(evrIter.Value(), &record)
+		if record.Accepted {
+			if nonce := binary.BigEndian.Uint64(evrIter.Key()[:8]); nonce < lowestObserved {
+				lowestObserved = nonce
+			}
+			if lowestObserved > 0 {
+				lowestObserved--
+			}
+			break
+		}
+	}
+	evrIter.Close()
+
+	var vals [][]byte
+	valIter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
+	for ; valIter.Valid(); valIter.Next() {
+		if !store.Has(types.MakeLastEventNonceByValidatorKey(valIter.Key())) {
+			vals = append(vals, valIter.Key())
+		}
+	}
+	valIter.Close()
+
+	for _, val := range vals {
+		store.Set(types.MakeLastEventNonceByValidatorKey(val), sdk.Uint64ToBigEndian(lowestObserved))
+	}
+}
+
+// migrateLastObservedEventHeight starts counting the blocks since the last
+// observed event nonce advanced from the upgrade height, v2 did not record the
+// height it advanced at
+func migrateLastObservedEventHeight(store storetypes.KVStore, height uint64) {
+	if !store.Has([]byte{types.LastObservedEventHeightKey}) {
+		store.Set([]byte{types.LastObservedEventHeightKey}, sdk.Uint64ToBigEndian(height))
+	}
+}
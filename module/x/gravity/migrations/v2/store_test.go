@@ -0,0 +1,269 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	v2 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v2"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigratePendingEthereumEventVoteRecords(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+
+	setRecord := func(event types.EthereumEvent, accepted bool) []byte {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		store.Set(
+			types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()),
+			cdc.MustMarshal(&types.EthereumEventVoteRecord{Event: any, Accepted: accepted}),
+		)
+		return event.Hash()
+	}
+
+	observed := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 1, EthereumHeight: 10}, true)
+	rejected := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 2, EthereumHeight: 10}, false)
+	pending := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: 2, EthereumHeight: 11}, false)
+	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(1))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	require.False(t, store.Has(types.MakePendingEthereumEventVoteRecordKey(1, observed)))
+	require.False(t, store.Has(types.MakePendingEthereumEventVoteRecordKey(1, rejected)))
+	require.True(t, store.Has(types.MakePendingEthereumEventVoteRecordKey(2, pending)))
+	require.Len(t, input.GravityKeeper.GetPendingEthereumEventVoteRecords(ctx, 2), 1)
+}
+
+func TestMigratePruneEthereumEventVoteRecords(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(50)
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+
+	setRecord := func(event types.EthereumEvent, accepted bool) []byte {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		key := types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash())
+		store.Set(key, cdc.MustMarshal(&types.EthereumEventVoteRecord{Event: any, Accepted: accepted}))
+		return key
+	}
+
+	old := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 1, EthereumHeight: 10}, true)
+	oldLoser := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 2, EthereumHeight: 10}, false)
+	last := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: 2, EthereumHeight: 11}, true)
+	pending := setRecord(&types.SignerSetTxExecutedEvent{EventNonce: 3, SignerSetTxNonce: 3, EthereumHeight: 12}, false)
+	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(2))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	require.False(t, store.Has(old))
+	require.False(t, store.Has(oldLoser))
+	require.True(t, store.Has(pending))
+
+	var record types.EthereumEventVoteRecord
+	cdc.MustUnmarshal(store.Get(last), &record)
+	require.True(t, record.Accepted)
+	require.EqualValues(t, 50, record.Height)
+}
+
+func TestMigrateOutgoingTxTimeouts(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+
+	batch := &types.BatchTx{BatchNonce: 1, Timeout: 50, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"}
+	call := &types.ContractCallTx{InvalidationScope: []byte("scope"), InvalidationNonce: 1, Timeout: 20}
+	for _, otx := range []types.OutgoingTx{batch, call} {
+		any, err := types.PackOutgoingTx(otx)
+		require.NoError(t, err)
+		store.Set(types.MakeOutgoingTxKey(otx.GetStoreIndex()), cdc.MustMarshal(any))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	require.True(t, store.Has(types.MakeOutgoingTxTimeoutKey(50, batch.GetStoreIndex())))
+	require.True(t, store.Has(types.MakeOutgoingTxTimeoutKey(20, call.GetStoreIndex())))
+	require.Len(t, input.GravityKeeper.GetTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, 21), 1)
+	require.Empty(t, input.GravityKeeper.GetTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, 21))
+	require.Len(t, input.GravityKeeper.GetTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, 51), 1)
+}
+
+func TestMigrateSendToEthereumIDs(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+
+	contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	send := &types.SendToEthereum{
+		Id:                7,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		Erc20Token:        types.NewERC20Token(100, contract),
+		Erc20Fee:          types.NewERC20Token(3, contract),
+	}
+	store.Set(types.MakeSendToEthereumKey(send.Id, send.Erc20Fee), cdc.MustMarshal(send))
+
+	batched := &types.SendToEthereum{
+		Id:                8,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		Erc20Token:        types.NewERC20Token(100, contract),
+		Erc20Fee:          types.NewERC20Token(5, contract),
+	}
+	batch := &types.BatchTx{BatchNonce: 2, Timeout: 50, TokenContract: contract.Hex(), Transactions: []*types.SendToEthereum{batched}}
+	any, err := types.PackOutgoingTx(batch)
+	require.NoError(t, err)
+	store.Set(types.MakeOutgoingTxKey(batch.GetStoreIndex()), cdc.MustMarshal(any))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	res, err := input.GravityKeeper.SendToEthereumByID(sdk.WrapSDKContext(ctx), &types.SendToEthereumByIDRequest{Id: send.Id})
+	require.NoError(t, err)
+	require.Equal(t, send, res.SendToEthereum)
+	require.Zero(t, res.BatchNonce)
+
+	res, err = input.GravityKeeper.SendToEthereumByID(sdk.WrapSDKContext(ctx), &types.SendToEthereumByIDRequest{Id: batched.Id})
+	require.NoError(t, err)
+	require.Equal(t, batched, res.SendToEthereum)
+	require.Equal(t, batch.BatchNonce, res.BatchNonce)
+}
+
+func TestDeleteOrphanedEthereumSignatures(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+	gk := input.GravityKeeper
+
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4")
+	require.NoError(t, err)
+
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	for _, nonce := range []uint64{signerSetTx.Nonce, signerSetTx.Nonce + 1} {
+		gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+			SignerSetNonce: nonce,
+			EthereumSigner: "0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09",
+			Signature:      []byte("fake-signature"),
+		}, valAddr)
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	require.True(t, store.Has(types.MakeEthereumSignatureKey(types.MakeSignerSetTxKey(signerSetTx.Nonce), valAddr)))
+	require.False(t, store.Has(types.MakeEthereumSignatureKey(types.MakeSignerSetTxKey(signerSetTx.Nonce+1), valAddr)))
+}
+
+func TestMigratePendingEthereumSignatures(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+	gk := input.GravityKeeper
+
+	signerSetTx := gk.CreateSignerSetTx(ctx, types.SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED)
+	gk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSetTx.Nonce,
+		EthereumSigner: keeper.EthAddrs[0].Hex(),
+		Signature:      []byte("fake-signature"),
+	}, keeper.ValAddrs[0])
+
+	// drop the index maintained by the keeper to start from a v2 store
+	for _, val := range keeper.ValAddrs {
+		store.Delete(types.MakePendingEthereumSignatureKey(val, signerSetTx.GetStoreIndex()))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	require.False(t, store.Has(types.MakePendingEthereumSignatureKey(keeper.ValAddrs[0], signerSetTx.GetStoreIndex())))
+	require.True(t, store.Has(types.MakePendingEthereumSignatureKey(keeper.ValAddrs[1], signerSetTx.GetStoreIndex())))
+}
+
+func TestMigrateLastObservedEventHeight(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(1234)
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	store.Delete([]byte{types.LastObservedEventHeightKey})
+	require.NoError(t, v2.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler, "gravitytest"))
+	require.Equal(t, uint64(1234), input.GravityKeeper.GetLastObservedEventHeight(ctx))
+}
+
+func TestMigrateLastEventNonceByValidator(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	cdc := input.Marshaler
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	var (
+		voted  = sdk.ValAddress([]byte("voted_______________"))
+		silent = sdk.ValAddress([]byte("silent______________"))
+	)
+	for _, val := range []sdk.ValAddress{voted, silent} {
+		store.Set(types.MakeValidatorEthereumAddressKey(val), []byte("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"))
+	}
+	store.Set(types.MakeLastEventNonceByValidatorKey(voted), sdk.Uint64ToBigEndian(8))
+	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(7))
+
+	// the lowest accepted event left after pruning is 7
+	for nonce, accepted := range map[uint64]bool{5: true, 7: true, 8: false} {
+		event := &types.SendToCosmosEvent{EventNonce: nonce, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Amount: sdk.NewInt(1), EthereumHeight: 10}
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		store.Set(types.MakeEthereumEventVoteRecordKey(nonce, event.Hash()), cdc.MustMarshal(&types.EthereumEventVoteRecord{Event: any, Accepted: accepted}))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, input.GravityStoreKey, cdc, "gravitytest"))
+	require.Equal(t, sdk.Uint64ToBigEndian(8), store.Get(types.MakeLastEventNonceByValidatorKey(voted)))
+	require.Equal(t, sdk.Uint64ToBigEndian(6), store.Get(types.MakeLastEventNonceByValidatorKey(silent)))
+}
+
+func TestMigratePastEthereumSignatureCheckpoints(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	storeKey := input.GravityStoreKey
+	cdc := input.Marshaler
+	store := ctx.KVStore(storeKey)
+
+	store.Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(3))
+	store.Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(5))
+
+	batch := &types.BatchTx{BatchNonce: 5, Timeout: 50, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"}
+	call := &types.ContractCallTx{InvalidationScope: []byte("scope"), InvalidationNonce: 4, Timeout: 20}
+	for _, otx := range []types.OutgoingTx{batch, call} {
+		any, err := types.PackOutgoingTx(otx)
+		require.NoError(t, err)
+		store.Set(types.MakeOutgoingTxKey(otx.GetStoreIndex()), cdc.MustMarshal(any))
+	}
+
+	// a contract call in another scope was executed and pruned
+	executed := &types.ContractCallExecutedEvent{EventNonce: 1, InvalidationScope: []byte("other"), InvalidationNonce: 9, EthereumHeight: 10}
+	any, err := types.PackEvent(executed)
+	require.NoError(t, err)
+	store.Set(types.MakeEthereumEventVoteRecordKey(1, executed.Hash()), cdc.MustMarshal(&types.EthereumEventVoteRecord{Event: any, Accepted: true}))
+	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(2))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, "gravitytest"))
+
+	for _, otx := range []types.OutgoingTx{batch, call} {
+		txType, nonce := types.OutgoingTxTypeAndNonce(otx)
+		require.True(t, store.Has(types.MakePastEthereumSignatureCheckpointKey(txType, nonce, otx.GetCheckpoint([]byte("gravitytest")))))
+	}
+
+	var floors types.PastEthereumSignatureCheckpointFloors
+	cdc.MustUnmarshal(store.Get([]byte{types.PastEthereumSignatureCheckpointFloorsKey}), &floors)
+	require.Equal(t, types.PastEthereumSignatureCheckpointFloors{SignerSetNonce: 3, BatchNonce: 5, ContractCallInvalidationNonce: 9}, floors)
+}
@@ -0,0 +1,43 @@
+package gravity_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestGRPCGatewayRoutes(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+
+	protoCodec, ok := input.Marshaler.(*codec.ProtoCodec)
+	require.True(t, ok)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, protoCodec.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, input.GravityKeeper)
+
+	// the app's API server marshals gogoproto responses with gogo/gateway
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
+		OrigName:    true,
+		AnyResolver: protoCodec.InterfaceRegistry(),
+	}))
+	require.NoError(t, types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(queryHelper)))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gravity/v1/params", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "signed_signer_set_txs_window")
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gravity/v1/batches/not-an-address/1", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalid hex address not-an-address")
+}
@@ -84,3 +84,17 @@ func TestValsetCheckpoint(t *testing.T) {
 	goldHash := "0x89731c26bab12cf0cb5363ef9abab6f9bd5496cf758a2309311c7946d54bca85"[2:]
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
+
+func TestCheckpointFields(t *testing.T) {
+	src := NewSignerSetTx(3, 0, EthereumSigners{
+		{Power: 6667, EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6"},
+		{Power: 3333, EthereumAddress: "0xE5904695748fe4A84b40b3fc79De2277660BD1D3"},
+	})
+
+	fields := CheckpointFields(src, []byte("foo"))
+	require.Len(t, fields, 7)
+	require.Equal(t, CheckpointField{Name: "gravityId", Type: "bytes32", Value: "0x666f6f0000000000000000000000000000000000000000000000000000000000"}, fields[0])
+	require.Equal(t, CheckpointField{Name: "valsetNonce", Type: "uint256", Value: "3"}, fields[2])
+	require.Equal(t, CheckpointField{Name: "validators", Type: "address[]", Value: "[0xc783df8a850f42e7F7e57013759C285caa701eB6,0xE5904695748fe4A84b40b3fc79De2277660BD1D3]"}, fields[3])
+	require.Equal(t, CheckpointField{Name: "powers", Type: "uint256[]", Value: "[6667,3333]"}, fields[4])
+}
@@ -16,6 +16,7 @@ import (
 // provided LegacyAmino codec. These types are used for Amino JSON serialization
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateKeys{}, "gravity-bridge/MsgDelegateKeys", nil)
+	cdc.RegisterConcrete(&MsgRotateEthereumKey{}, "gravity-bridge/MsgRotateEthereumKey", nil)
 	cdc.RegisterConcrete(&MsgSendToEthereum{}, "gravity-bridge/MsgSendToEthereum", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEthereum{}, "gravity-bridge/MsgCancelSendToEthereum", nil)
 }
@@ -47,6 +48,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgSubmitBadSignatureEvidence{},
+		&MsgRotateEthereumKey{},
 	)
 
 	registry.RegisterInterface(
@@ -77,6 +80,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&ForceSignerSetTxProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
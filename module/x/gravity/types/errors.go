@@ -14,4 +14,7 @@ var (
 	ErrInvalidEthereumProposalAmount    = sdkerrors.Register(ModuleName, 9, "invalid community pool Ethereum spend proposal amount")
 	ErrInvalidEthereumProposalBridgeFee = sdkerrors.Register(ModuleName, 10, "invalid community pool Ethereum spend proposal bridge fee")
 	ErrEthereumProposalDenomMismatch    = sdkerrors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
+	ErrBadSignatureEvidence             = sdkerrors.Register(ModuleName, 12, "invalid bad signature evidence")
+	ErrSendToEthereumBatched            = sdkerrors.Register(ModuleName, 13, "send to ethereum is already in a batch")
+	ErrSendToEthereumNotFound           = sdkerrors.Register(ModuleName, 14, "send to ethereum not found")
 )
@@ -10,6 +10,11 @@ import (
 
 const (
 	signaturePrefix = "\x19Ethereum Signed Message:\n32"
+
+	// EthereumSignaturePowerThreshold is the power threshold Gravity.sol is
+	// deployed with, 66% of the normalized signer set power of 2^32. A
+	// submission must carry signatures of strictly more power than this.
+	EthereumSignaturePowerThreshold = 2834678415
 )
 
 // NewEthereumSignature creates a new signuature over a given byte array
@@ -17,28 +22,47 @@ func NewEthereumSignature(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, er
 	if privateKey == nil {
 		return nil, sdkerrors.Wrap(ErrInvalid, "did not pass in private key")
 	}
-	protectedHash := crypto.Keccak256Hash(append([]byte(signaturePrefix), hash...))
-	return crypto.Sign(protectedHash.Bytes(), privateKey)
+	return crypto.Sign(EthereumSignatureDigest(hash), privateKey)
+}
+
+// EthereumSignatureDigest returns the Ethereum personal sign digest of a
+// message hash, which is what signatures over checkpoints actually sign
+func EthereumSignatureDigest(hash []byte) []byte {
+	return crypto.Keccak256Hash(append([]byte(signaturePrefix), hash...)).Bytes()
 }
 
 // ValidateEthereumSignature takes a message, an associated signature and public key and
 // returns an error if the signature isn't valid
 func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.Address) error {
+	addr, err := EthereumAddressFromSignature(hash, signature)
+	if err != nil {
+		return err
+	}
+
+	if addr != ethAddress {
+		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, append([]uint8(signaturePrefix), hash...))
+	}
+
+	return nil
+}
+
+// EthereumAddressFromSignature recovers the address of the Ethereum key that
+// produced the given signature over a message hash
+func EthereumAddressFromSignature(hash []byte, signature []byte) (common.Address, error) {
 
 	/// signature to public key: invalid signature length: invalid
 	/// signature not matching: invalid: invalid
 	if len(signature) < 65 {
-		return sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
 	}
 
 	// Copy to avoid mutating signature slice by accident
 	var sigCopy = make([]byte, len(signature))
 	copy(sigCopy, signature)
 
-	// To verify signature
+	// To recover the signer
 	// - use crypto.SigToPub to get the public key
 	// - use crypto.PubkeyToAddress to get the address
-	// - compare this to the address given.
 
 	// for backwards compatibility reasons  the V value of an Ethereum sig is presented
 	// as 27 or 28, internally though it should be a 0-3 value due to changed formats.
@@ -53,16 +77,24 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 		sigCopy[64] -= 27
 	}
 
-	hash = append([]uint8(signaturePrefix), hash...)
-
-	pubkey, err := crypto.SigToPub(crypto.Keccak256Hash(hash).Bytes(), sigCopy)
+	pubkey, err := crypto.SigToPub(EthereumSignatureDigest(hash), sigCopy)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
+		return common.Address{}, sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
 	}
 
-	if addr := crypto.PubkeyToAddress(*pubkey); addr != ethAddress {
-		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// SplitEthereumSignature splits a 65 byte signature into the v, r and s values
+// Gravity.sol verifies, with v in the 27 or 28 form
+func SplitEthereumSignature(signature []byte) (v uint32, r []byte, s []byte, err error) {
+	if len(signature) != 65 {
+		return 0, nil, nil, sdkerrors.Wrapf(ErrInvalid, "signature length %d", len(signature))
 	}
 
-	return nil
+	v = uint32(signature[64])
+	if v < 27 {
+		v += 27
+	}
+	return v, signature[:32], signature[32:64], nil
 }
@@ -1,14 +1,18 @@
 package types
 
 const (
-	EventTypeObservation              = "observation"
-	EventTypeOutgoingBatch            = "outgoing_batch"
-	EventTypeMultisigUpdateRequest    = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
-	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
-	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
-	EventTypeBridgeDepositReceived    = "deposit_received"
-	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeObservation               = "observation"
+	EventTypeOutgoingBatch             = "outgoing_batch"
+	EventTypeMultisigUpdateRequest     = "multisig_update_request"
+	EventTypeOutgoingBatchCanceled     = "outgoing_batch_canceled"
+	EventTypeContractCallTxCanceled    = "outgoing_logic_call_canceled"
+	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
+	EventTypeBridgeDepositReceived     = "deposit_received"
+	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeBadSignatureEvidence      = "bad_signature_evidence"
+	EventTypeEthereumKeyRotated        = "ethereum_key_rotated"
+	EventTypeRewardPoolDistribution    = "reward_pool_distribution"
+	EventTypeEthereumEventDisagreement = "ethereum_event_disagreement"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -16,13 +20,17 @@ const (
 	AttributeKeyOutgoingBatchID               = "batch_id"
 	AttributeKeyOutgoingTXID                  = "outgoing_tx_id"
 	AttributeKeyEthereumEventType             = "ethereum_event_type"
+	AttributeKeyEthereumEventHash             = "ethereum_event_hash"
+	AttributeKeyConflictingEthereumEventHash  = "conflicting_ethereum_event_hash"
 	AttributeKeyContract                      = "bridge_contract"
 	AttributeKeyNonce                         = "nonce"
 	AttributeKeySignerSetNonce                = "signerset_nonce"
+	AttributeKeySignerSetTxReason             = "signer_set_tx_reason"
 	AttributeKeyBatchNonce                    = "batch_nonce"
 	AttributeKeyBridgeChainID                 = "bridge_chain_id"
 	AttributeKeySetOrchestratorAddr           = "set_orchestrator_address"
 	AttributeKeySetEthereumAddr               = "set_ethereum_address"
+	AttributeKeyOldEthereumAddr               = "old_ethereum_address"
 	AttributeKeyValidatorAddr                 = "validator_address"
 	AttributeKeyContractCallInvalidationScope = "contract_call_invalidation_scope"
 	AttributeKeyContractCallInvalidationNonce = "contract_call_invalidation_nonce"
@@ -32,4 +40,7 @@ const (
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
 	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+	AttributeBadEthereumSignature             = "bad_ethereum_signature"
+	AttributeKeyCheckpoint                    = "checkpoint"
+	AttributeKeyParticipation                 = "participation"
 )
@@ -65,6 +65,48 @@ var (
 	//  ParamStoreUnbondSlashingSignerSetTxsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingSignerSetTxsWindow = []byte("UnbondSlashingSignerSetTxsWindow")
 
+	// ParamsStoreKeyBridgeFeeRewardPoolFraction stores the fraction of bridge fees paid into the reward pool
+	ParamsStoreKeyBridgeFeeRewardPoolFraction = []byte("BridgeFeeRewardPoolFraction")
+
+	// ParamsStoreKeyRewardPoolEpochBlocks stores the number of blocks between reward pool distributions
+	ParamsStoreKeyRewardPoolEpochBlocks = []byte("RewardPoolEpochBlocks")
+
+	// ParamsStoreKeyRewardPoolToDistribution stores whether rewards are allocated through the distribution module
+	ParamsStoreKeyRewardPoolToDistribution = []byte("RewardPoolToDistribution")
+
+	// ParamsStoreKeyEventVoteRecordRetentionBlocks stores the number of blocks accepted event vote records are kept for
+	ParamsStoreKeyEventVoteRecordRetentionBlocks = []byte("EventVoteRecordRetentionBlocks")
+
+	// ParamsStoreKeyObservedSignerSetHistoryRetentionBlocks stores the number of blocks replaced observed signer sets are kept for
+	ParamsStoreKeyObservedSignerSetHistoryRetentionBlocks = []byte("ObservedSignerSetHistoryRetentionBlocks")
+
+	// ParamsStoreKeyExecutedOutgoingTxRetentionBlocks stores the number of blocks executed outgoing txs are archived for
+	ParamsStoreKeyExecutedOutgoingTxRetentionBlocks = []byte("ExecutedOutgoingTxRetentionBlocks")
+
+	// ParamsStoreKeyBridgeHealthThresholds stores the thresholds of the values reported by the BridgeHealth query
+	ParamsStoreKeyBridgeHealthThresholds = []byte("BridgeHealthThresholds")
+
+	// ParamsStoreKeySignerSetTxPowerDiffThreshold stores the power diff above which a new signer set tx is created
+	ParamsStoreKeySignerSetTxPowerDiffThreshold = []byte("SignerSetTxPowerDiffThreshold")
+
+	// ParamsStoreKeySignerSetTxMinBlocks stores the minimum number of blocks between signer set txs
+	ParamsStoreKeySignerSetTxMinBlocks = []byte("SignerSetTxMinBlocks")
+
+	// ParamsStoreKeySignerSetTxMaxAgeBlocks stores the number of blocks after which a new signer set tx is created
+	ParamsStoreKeySignerSetTxMaxAgeBlocks = []byte("SignerSetTxMaxAgeBlocks")
+
+	// ParamsStoreKeyMaxSigners stores the maximum number of validators included in a signer set tx
+	ParamsStoreKeyMaxSigners = []byte("MaxSigners")
+
+	// ParamsStoreKeyMaxSignerPowerFraction stores the maximum fraction of the normalized power held by a single signer
+	ParamsStoreKeyMaxSignerPowerFraction = []byte("MaxSignerPowerFraction")
+
+	// ParamsStoreKeyValidatorBridgeStatsEpochBlocks stores the number of blocks after which the validator bridge stats are reset
+	ParamsStoreKeyValidatorBridgeStatsEpochBlocks = []byte("ValidatorBridgeStatsEpochBlocks")
+
+	// ParamsStoreKeyPastEthereumSignatureCheckpointRetentionBlocks stores the number of blocks the checkpoints of outgoing txs are kept for bad signature evidence
+	ParamsStoreKeyPastEthereumSignatureCheckpointRetentionBlocks = []byte("PastEthereumSignatureCheckpointRetentionBlocks")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -121,19 +163,50 @@ func DefaultGenesisState() *GenesisState {
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                                 "defaultgravityid",
-		BridgeEthereumAddress:                     "0x0000000000000000000000000000000000000000",
-		SignedSignerSetTxsWindow:                  10000,
-		SignedBatchesWindow:                       10000,
-		EthereumSignaturesWindow:                  10000,
-		TargetEthTxTimeout:                        43200000,
-		AverageBlockTime:                          5000,
-		AverageEthereumBlockTime:                  15000,
-		SlashFractionSignerSetTx:                  sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:                        sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionEthereumSignature:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionConflictingEthereumSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingSignerSetTxsWindow:          10000,
+		GravityId:                                      "defaultgravityid",
+		BridgeEthereumAddress:                          "0x0000000000000000000000000000000000000000",
+		SignedSignerSetTxsWindow:                       10000,
+		SignedBatchesWindow:                            10000,
+		EthereumSignaturesWindow:                       10000,
+		TargetEthTxTimeout:                             43200000,
+		AverageBlockTime:                               5000,
+		AverageEthereumBlockTime:                       15000,
+		SlashFractionSignerSetTx:                       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:                             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionEthereumSignature:                 sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionConflictingEthereumSignature:      sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingSignerSetTxsWindow:               10000,
+		BridgeFeeRewardPoolFraction:                    sdk.ZeroDec(),
+		RewardPoolEpochBlocks:                          10000,
+		RewardPoolToDistribution:                       true,
+		EventVoteRecordRetentionBlocks:                 10000,
+		ObservedSignerSetHistoryRetentionBlocks:        10000,
+		ExecutedOutgoingTxRetentionBlocks:              100000,
+		BridgeHealthThresholds:                         DefaultBridgeHealthThresholds(),
+		SignerSetTxPowerDiffThreshold:                  sdk.NewDecWithPrec(5, 2),
+		SignerSetTxMinBlocks:                           0,
+		SignerSetTxMaxAgeBlocks:                        0,
+		MaxSigners:                                     0,
+		MaxSignerPowerFraction:                         sdk.ZeroDec(),
+		ValidatorBridgeStatsEpochBlocks:                100000,
+		PastEthereumSignatureCheckpointRetentionBlocks: 100000,
+	}
+}
+
+// DefaultBridgeHealthThresholds returns the default bridge health thresholds,
+// the block counts assume the default average block times
+func DefaultBridgeHealthThresholds() BridgeHealthThresholds {
+	return BridgeHealthThresholds{
+		EventStallBlocksWarning:      720,
+		EventStallBlocksCritical:     2880,
+		EthereumHeightLagWarning:     200,
+		EthereumHeightLagCritical:    1000,
+		OutgoingTxAgeBlocksWarning:   1440,
+		OutgoingTxAgeBlocksCritical:  8640,
+		SignerSetSignedPowerWarning:  sdk.NewDecWithPrec(80, 2),
+		SignerSetSignedPowerCritical: sdk.NewDecWithPrec(66, 2),
+		PoolDepthWarning:             500,
+		PoolDepthCritical:            2000,
 	}
 }
 
@@ -184,6 +257,51 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateBridgeFeeRewardPoolFraction(p.BridgeFeeRewardPoolFraction); err != nil {
+		return sdkerrors.Wrap(err, "bridge fee reward pool fraction")
+	}
+	if err := validateRewardPoolEpochBlocks(p.RewardPoolEpochBlocks); err != nil {
+		return sdkerrors.Wrap(err, "reward pool epoch blocks")
+	}
+	if err := validateRewardPoolToDistribution(p.RewardPoolToDistribution); err != nil {
+		return sdkerrors.Wrap(err, "reward pool to distribution")
+	}
+	if err := validateEventVoteRecordRetentionBlocks(p.EventVoteRecordRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "event vote record retention blocks")
+	}
+	if err := validateObservedSignerSetHistoryRetentionBlocks(p.ObservedSignerSetHistoryRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "observed signer set history retention blocks")
+	}
+	if err := validateExecutedOutgoingTxRetentionBlocks(p.ExecutedOutgoingTxRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "executed outgoing tx retention blocks")
+	}
+	if err := validateBridgeHealthThresholds(p.BridgeHealthThresholds); err != nil {
+		return sdkerrors.Wrap(err, "bridge health thresholds")
+	}
+	if err := validateSignerSetTxPowerDiffThreshold(p.SignerSetTxPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "signer set tx power diff threshold")
+	}
+	if err := validateSignerSetTxMinBlocks(p.SignerSetTxMinBlocks); err != nil {
+		return sdkerrors.Wrap(err, "signer set tx min blocks")
+	}
+	if err := validateSignerSetTxMaxAgeBlocks(p.SignerSetTxMaxAgeBlocks); err != nil {
+		return sdkerrors.Wrap(err, "signer set tx max age blocks")
+	}
+	if p.SignerSetTxMaxAgeBlocks != 0 && p.SignerSetTxMaxAgeBlocks < p.SignerSetTxMinBlocks {
+		return fmt.Errorf("signer set tx max age blocks %d below min blocks %d", p.SignerSetTxMaxAgeBlocks, p.SignerSetTxMinBlocks)
+	}
+	if err := validateMaxSigners(p.MaxSigners); err != nil {
+		return sdkerrors.Wrap(err, "max signers")
+	}
+	if err := validateMaxSignerPowerFraction(p.MaxSignerPowerFraction); err != nil {
+		return sdkerrors.Wrap(err, "max signer power fraction")
+	}
+	if err := validateValidatorBridgeStatsEpochBlocks(p.ValidatorBridgeStatsEpochBlocks); err != nil {
+		return sdkerrors.Wrap(err, "validator bridge stats epoch blocks")
+	}
+	if err := validatePastEthereumSignatureCheckpointRetentionBlocks(p.PastEthereumSignatureCheckpointRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "past ethereum signature checkpoint retention blocks")
+	}
 
 	return nil
 }
@@ -212,6 +330,20 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionEthereumSignature, &p.SlashFractionEthereumSignature, validateSlashFractionEthereumSignature),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingEthereumSignature, &p.SlashFractionConflictingEthereumSignature, validateSlashFractionConflictingEthereumSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBridgeFeeRewardPoolFraction, &p.BridgeFeeRewardPoolFraction, validateBridgeFeeRewardPoolFraction),
+		paramtypes.NewParamSetPair(ParamsStoreKeyRewardPoolEpochBlocks, &p.RewardPoolEpochBlocks, validateRewardPoolEpochBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyRewardPoolToDistribution, &p.RewardPoolToDistribution, validateRewardPoolToDistribution),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEventVoteRecordRetentionBlocks, &p.EventVoteRecordRetentionBlocks, validateEventVoteRecordRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyObservedSignerSetHistoryRetentionBlocks, &p.ObservedSignerSetHistoryRetentionBlocks, validateObservedSignerSetHistoryRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyExecutedOutgoingTxRetentionBlocks, &p.ExecutedOutgoingTxRetentionBlocks, validateExecutedOutgoingTxRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBridgeHealthThresholds, &p.BridgeHealthThresholds, validateBridgeHealthThresholds),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignerSetTxPowerDiffThreshold, &p.SignerSetTxPowerDiffThreshold, validateSignerSetTxPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignerSetTxMinBlocks, &p.SignerSetTxMinBlocks, validateSignerSetTxMinBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignerSetTxMaxAgeBlocks, &p.SignerSetTxMaxAgeBlocks, validateSignerSetTxMaxAgeBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxSigners, &p.MaxSigners, validateMaxSigners),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMaxSignerPowerFraction, &p.MaxSignerPowerFraction, validateMaxSignerPowerFraction),
+		paramtypes.NewParamSetPair(ParamsStoreKeyValidatorBridgeStatsEpochBlocks, &p.ValidatorBridgeStatsEpochBlocks, validateValidatorBridgeStatsEpochBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyPastEthereumSignatureCheckpointRetentionBlocks, &p.PastEthereumSignatureCheckpointRetentionBlocks, validatePastEthereumSignatureCheckpointRetentionBlocks),
 	}
 }
 
@@ -360,6 +492,142 @@ func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
 	return nil
 }
 
+func validateBridgeFeeRewardPoolFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateRewardPoolEpochBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRewardPoolToDistribution(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEventVoteRecordRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateObservedSignerSetHistoryRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateExecutedOutgoingTxRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBridgeHealthThresholds(i interface{}) error {
+	t, ok := i.(BridgeHealthThresholds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, pair := range []struct {
+		name              string
+		warning, critical uint64
+	}{
+		{"event stall blocks", t.EventStallBlocksWarning, t.EventStallBlocksCritical},
+		{"ethereum height lag", t.EthereumHeightLagWarning, t.EthereumHeightLagCritical},
+		{"outgoing tx age blocks", t.OutgoingTxAgeBlocksWarning, t.OutgoingTxAgeBlocksCritical},
+		{"pool depth", t.PoolDepthWarning, t.PoolDepthCritical},
+	} {
+		if pair.warning != 0 && pair.critical != 0 && pair.warning > pair.critical {
+			return fmt.Errorf("%s warning %d is above critical %d", pair.name, pair.warning, pair.critical)
+		}
+	}
+
+	for _, d := range []sdk.Dec{t.SignerSetSignedPowerWarning, t.SignerSetSignedPowerCritical} {
+		if d.IsNil() || d.IsNegative() || d.GT(sdk.OneDec()) {
+			return fmt.Errorf("signer set signed power threshold must be between 0 and 1: %s", d)
+		}
+	}
+	if t.SignerSetSignedPowerWarning.LT(t.SignerSetSignedPowerCritical) {
+		return fmt.Errorf("signer set signed power warning %s is below critical %s", t.SignerSetSignedPowerWarning, t.SignerSetSignedPowerCritical)
+	}
+
+	return nil
+}
+
+func validateSignerSetTxPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the diff of two normalized signer sets is at most 2
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.NewDec(2)) {
+		return fmt.Errorf("power diff threshold must be between 0 and 2: %s", v)
+	}
+	return nil
+}
+
+func validateSignerSetTxMinBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignerSetTxMaxAgeBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSigners(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSignerPowerFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max signer power fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateValidatorBridgeStatsEpochBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePastEthereumSignatureCheckpointRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
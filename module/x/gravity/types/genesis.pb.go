@@ -103,6 +103,51 @@ type Params struct {
 	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
 	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
 	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
+	// fraction of each bridge fee paid into the reward pool
+	BridgeFeeRewardPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=bridge_fee_reward_pool_fraction,json=bridgeFeeRewardPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bridge_fee_reward_pool_fraction"`
+	// number of blocks between reward pool distributions, zero disables them
+	RewardPoolEpochBlocks uint64 `protobuf:"varint,19,opt,name=reward_pool_epoch_blocks,json=rewardPoolEpochBlocks,proto3" json:"reward_pool_epoch_blocks,omitempty"`
+	// if true rewards are allocated through the distribution module and shared
+	// with delegators, otherwise they are sent to the validator operator
+	RewardPoolToDistribution bool `protobuf:"varint,20,opt,name=reward_pool_to_distribution,json=rewardPoolToDistribution,proto3" json:"reward_pool_to_distribution,omitempty"`
+	// number of blocks accepted event vote records, and the losing records at
+	// the same nonce, are kept for before being pruned, zero disables pruning
+	EventVoteRecordRetentionBlocks uint64 `protobuf:"varint,21,opt,name=event_vote_record_retention_blocks,json=eventVoteRecordRetentionBlocks,proto3" json:"event_vote_record_retention_blocks,omitempty"`
+	// number of blocks an observed signer set is kept in the history for once
+	// it has been replaced, zero keeps the whole history
+	ObservedSignerSetHistoryRetentionBlocks uint64 `protobuf:"varint,22,opt,name=observed_signer_set_history_retention_blocks,json=observedSignerSetHistoryRetentionBlocks,proto3" json:"observed_signer_set_history_retention_blocks,omitempty"`
+	// number of blocks executed batch and contract call txs are kept in the
+	// archive for, zero keeps the whole archive
+	ExecutedOutgoingTxRetentionBlocks uint64 `protobuf:"varint,23,opt,name=executed_outgoing_tx_retention_blocks,json=executedOutgoingTxRetentionBlocks,proto3" json:"executed_outgoing_tx_retention_blocks,omitempty"`
+	// thresholds at which the BridgeHealth query reports a warning or a
+	// critical severity
+	BridgeHealthThresholds BridgeHealthThresholds `protobuf:"bytes,24,opt,name=bridge_health_thresholds,json=bridgeHealthThresholds,proto3" json:"bridge_health_thresholds"`
+	// normalized power diff between the current signer set and the latest
+	// signer set tx above which a new signer set tx is created
+	SignerSetTxPowerDiffThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=signer_set_tx_power_diff_threshold,json=signerSetTxPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_tx_power_diff_threshold"`
+	// minimum number of blocks between signer set txs created for a power
+	// change or their age, unbonding validators and governance are not held back
+	SignerSetTxMinBlocks uint64 `protobuf:"varint,26,opt,name=signer_set_tx_min_blocks,json=signerSetTxMinBlocks,proto3" json:"signer_set_tx_min_blocks,omitempty"`
+	// number of blocks after which a new signer set tx is created even if the
+	// power did not change, zero disables it, otherwise at least
+	// signer_set_tx_min_blocks
+	SignerSetTxMaxAgeBlocks uint64 `protobuf:"varint,27,opt,name=signer_set_tx_max_age_blocks,json=signerSetTxMaxAgeBlocks,proto3" json:"signer_set_tx_max_age_blocks,omitempty"`
+	// maximum number of validators included in a signer set tx, the ones with
+	// the most power are included, zero includes every bonded validator
+	MaxSigners uint64 `protobuf:"varint,28,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	// maximum fraction of the normalized power of a signer set tx held by a
+	// single signer, the excess is redistributed over the other signers in
+	// proportion to their power, zero disables the cap. The contract's threshold
+	// is 66% of the power so a fraction below 0.34 keeps any single signer from
+	// blocking it
+	MaxSignerPowerFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=max_signer_power_fraction,json=maxSignerPowerFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_signer_power_fraction"`
+	// number of blocks after which the validator bridge stats are reset, zero
+	// keeps counting them since the chain started
+	ValidatorBridgeStatsEpochBlocks uint64 `protobuf:"varint,30,opt,name=validator_bridge_stats_epoch_blocks,json=validatorBridgeStatsEpochBlocks,proto3" json:"validator_bridge_stats_epoch_blocks,omitempty"`
+	// number of blocks the checkpoints of outgoing txs are kept for bad
+	// signature evidence, the nonces of the pruned ones are then rejected by the
+	// checkpoint floors. Zero keeps them forever
+	PastEthereumSignatureCheckpointRetentionBlocks uint64 `protobuf:"varint,31,opt,name=past_ethereum_signature_checkpoint_retention_blocks,json=pastEthereumSignatureCheckpointRetentionBlocks,proto3" json:"past_ethereum_signature_checkpoint_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -215,25 +260,224 @@ func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardPoolEpochBlocks() uint64 {
+	if m != nil {
+		return m.RewardPoolEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRewardPoolToDistribution() bool {
+	if m != nil {
+		return m.RewardPoolToDistribution
+	}
+	return false
+}
+
+func (m *Params) GetEventVoteRecordRetentionBlocks() uint64 {
+	if m != nil {
+		return m.EventVoteRecordRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetObservedSignerSetHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ObservedSignerSetHistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetExecutedOutgoingTxRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ExecutedOutgoingTxRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeHealthThresholds() BridgeHealthThresholds {
+	if m != nil {
+		return m.BridgeHealthThresholds
+	}
+	return BridgeHealthThresholds{}
+}
+
+func (m *Params) GetSignerSetTxMinBlocks() uint64 {
+	if m != nil {
+		return m.SignerSetTxMinBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSignerSetTxMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.SignerSetTxMaxAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSigners() uint64 {
+	if m != nil {
+		return m.MaxSigners
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorBridgeStatsEpochBlocks() uint64 {
+	if m != nil {
+		return m.ValidatorBridgeStatsEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetPastEthereumSignatureCheckpointRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PastEthereumSignatureCheckpointRetentionBlocks
+	}
+	return 0
+}
+
+// BridgeHealthThresholds holds the warning and critical thresholds of each
+// value the BridgeHealth query reports, a zero threshold is never reached
+type BridgeHealthThresholds struct {
+	// blocks since the last observed event nonce advanced
+	EventStallBlocksWarning  uint64 `protobuf:"varint,1,opt,name=event_stall_blocks_warning,json=eventStallBlocksWarning,proto3" json:"event_stall_blocks_warning,omitempty"`
+	EventStallBlocksCritical uint64 `protobuf:"varint,2,opt,name=event_stall_blocks_critical,json=eventStallBlocksCritical,proto3" json:"event_stall_blocks_critical,omitempty"`
+	// Ethereum blocks the last observed Ethereum height is behind the median
+	// height vote
+	EthereumHeightLagWarning  uint64 `protobuf:"varint,3,opt,name=ethereum_height_lag_warning,json=ethereumHeightLagWarning,proto3" json:"ethereum_height_lag_warning,omitempty"`
+	EthereumHeightLagCritical uint64 `protobuf:"varint,4,opt,name=ethereum_height_lag_critical,json=ethereumHeightLagCritical,proto3" json:"ethereum_height_lag_critical,omitempty"`
+	// blocks since the oldest outgoing tx of a type that has not been relayed
+	// was created
+	OutgoingTxAgeBlocksWarning  uint64 `protobuf:"varint,5,opt,name=outgoing_tx_age_blocks_warning,json=outgoingTxAgeBlocksWarning,proto3" json:"outgoing_tx_age_blocks_warning,omitempty"`
+	OutgoingTxAgeBlocksCritical uint64 `protobuf:"varint,6,opt,name=outgoing_tx_age_blocks_critical,json=outgoingTxAgeBlocksCritical,proto3" json:"outgoing_tx_age_blocks_critical,omitempty"`
+	// share of the power of the latest signer set tx that has signed it, below
+	// which it is reported
+	SignerSetSignedPowerWarning  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=signer_set_signed_power_warning,json=signerSetSignedPowerWarning,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_signed_power_warning"`
+	SignerSetSignedPowerCritical github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=signer_set_signed_power_critical,json=signerSetSignedPowerCritical,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_signed_power_critical"`
+	// unbatched sends to Ethereum waiting in the pool of a token
+	PoolDepthWarning  uint64 `protobuf:"varint,9,opt,name=pool_depth_warning,json=poolDepthWarning,proto3" json:"pool_depth_warning,omitempty"`
+	PoolDepthCritical uint64 `protobuf:"varint,10,opt,name=pool_depth_critical,json=poolDepthCritical,proto3" json:"pool_depth_critical,omitempty"`
+}
+
+func (m *BridgeHealthThresholds) Reset()         { *m = BridgeHealthThresholds{} }
+func (m *BridgeHealthThresholds) String() string { return proto.CompactTextString(m) }
+func (*BridgeHealthThresholds) ProtoMessage()    {}
+func (*BridgeHealthThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *BridgeHealthThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeHealthThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeHealthThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeHealthThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHealthThresholds.Merge(m, src)
+}
+func (m *BridgeHealthThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeHealthThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHealthThresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeHealthThresholds proto.InternalMessageInfo
+
+func (m *BridgeHealthThresholds) GetEventStallBlocksWarning() uint64 {
+	if m != nil {
+		return m.EventStallBlocksWarning
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetEventStallBlocksCritical() uint64 {
+	if m != nil {
+		return m.EventStallBlocksCritical
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetEthereumHeightLagWarning() uint64 {
+	if m != nil {
+		return m.EthereumHeightLagWarning
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetEthereumHeightLagCritical() uint64 {
+	if m != nil {
+		return m.EthereumHeightLagCritical
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetOutgoingTxAgeBlocksWarning() uint64 {
+	if m != nil {
+		return m.OutgoingTxAgeBlocksWarning
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetOutgoingTxAgeBlocksCritical() uint64 {
+	if m != nil {
+		return m.OutgoingTxAgeBlocksCritical
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetPoolDepthWarning() uint64 {
+	if m != nil {
+		return m.PoolDepthWarning
+	}
+	return 0
+}
+
+func (m *BridgeHealthThresholds) GetPoolDepthCritical() uint64 {
+	if m != nil {
+		return m.PoolDepthCritical
+	}
+	return 0
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
 type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	Params                                *Params                               `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce                uint64                                `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                           []*types.Any                          `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                         []*types.Any                          `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords              []*EthereumEventVoteRecord            `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys                          []*MsgDelegateKeys                    `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                         []*ERC20ToDenom                       `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs            []*SendToEthereum                     `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	PastEthereumSignatureCheckpoints      []PastEthereumSignatureCheckpoint     `protobuf:"bytes,13,rep,name=past_ethereum_signature_checkpoints,json=pastEthereumSignatureCheckpoints,proto3" json:"past_ethereum_signature_checkpoints"`
+	PastEthereumSignatureCheckpointFloors PastEthereumSignatureCheckpointFloors `protobuf:"bytes,14,opt,name=past_ethereum_signature_checkpoint_floors,json=pastEthereumSignatureCheckpointFloors,proto3" json:"past_ethereum_signature_checkpoint_floors"`
+	BadSignatureEvidence                  []*BadSignatureEvidence               `protobuf:"bytes,15,rep,name=bad_signature_evidence,json=badSignatureEvidence,proto3" json:"bad_signature_evidence,omitempty"`
+	ValidatorBridgeStats                  []ValidatorBridgeStats                `protobuf:"bytes,16,rep,name=validator_bridge_stats,json=validatorBridgeStats,proto3" json:"validator_bridge_stats"`
+	BridgeStatsEpoch                      BridgeStatsEpoch                      `protobuf:"bytes,17,opt,name=bridge_stats_epoch,json=bridgeStatsEpoch,proto3" json:"bridge_stats_epoch"`
+	EthereumKeyRotations                  []EthereumKeyRotation                 `protobuf:"bytes,18,rep,name=ethereum_key_rotations,json=ethereumKeyRotations,proto3" json:"ethereum_key_rotations"`
+	ValidatorRewards                      []ValidatorRewards                    `protobuf:"bytes,19,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	ObservedSignerSets                    []ObservedSignerSet                   `protobuf:"bytes,20,rep,name=observed_signer_sets,json=observedSignerSets,proto3" json:"observed_signer_sets"`
+	ExecutedBatchTxs                      []ExecutedBatchTx                     `protobuf:"bytes,21,rep,name=executed_batch_txs,json=executedBatchTxs,proto3" json:"executed_batch_txs"`
+	ExecutedContractCallTxs               []ExecutedContractCallTx              `protobuf:"bytes,22,rep,name=executed_contract_call_txs,json=executedContractCallTxs,proto3" json:"executed_contract_call_txs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,6 +562,76 @@ func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	return nil
 }
 
+func (m *GenesisState) GetPastEthereumSignatureCheckpoints() []PastEthereumSignatureCheckpoint {
+	if m != nil {
+		return m.PastEthereumSignatureCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastEthereumSignatureCheckpointFloors() PastEthereumSignatureCheckpointFloors {
+	if m != nil {
+		return m.PastEthereumSignatureCheckpointFloors
+	}
+	return PastEthereumSignatureCheckpointFloors{}
+}
+
+func (m *GenesisState) GetBadSignatureEvidence() []*BadSignatureEvidence {
+	if m != nil {
+		return m.BadSignatureEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBridgeStats() []ValidatorBridgeStats {
+	if m != nil {
+		return m.ValidatorBridgeStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeStatsEpoch() BridgeStatsEpoch {
+	if m != nil {
+		return m.BridgeStatsEpoch
+	}
+	return BridgeStatsEpoch{}
+}
+
+func (m *GenesisState) GetEthereumKeyRotations() []EthereumKeyRotation {
+	if m != nil {
+		return m.EthereumKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRewards() []ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservedSignerSets() []ObservedSignerSet {
+	if m != nil {
+		return m.ObservedSignerSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedBatchTxs() []ExecutedBatchTx {
+	if m != nil {
+		return m.ExecutedBatchTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedContractCallTxs() []ExecutedContractCallTx {
+	if m != nil {
+		return m.ExecutedContractCallTxs
+	}
+	return nil
+}
+
 // This records the relationship between an ERC20 token and the denom
 // of the corresponding Cosmos originated asset
 type ERC20ToDenom struct {
@@ -329,7 +643,7 @@ func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
 func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenom) ProtoMessage()    {}
 func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+	return fileDescriptor_387b0aba880adb60, []int{3}
 }
 func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,6 +688,7 @@ func (m *ERC20ToDenom) GetDenom() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*BridgeHealthThresholds)(nil), "gravity.v1.BridgeHealthThresholds")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
 	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
 }
@@ -381,64 +696,117 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xb6, 0xa9, 0x1b, 0xda, 0xb1, 0x4d, 0xca, 0xe0, 0xc0, 0xd6, 0x29, 0xae, 0x09, 0xa2, 0x0a,
-	0x88, 0xec, 0x26, 0xae, 0x04, 0x22, 0xfc, 0xa8, 0xcd, 0x0f, 0x50, 0x21, 0x28, 0x5a, 0x1b, 0x90,
-	0xb8, 0x60, 0x18, 0xef, 0x9c, 0x8c, 0x97, 0xd8, 0x33, 0xd1, 0xce, 0xac, 0x6b, 0xdf, 0xf1, 0x08,
-	0x7d, 0x16, 0x1e, 0x81, 0xab, 0x5e, 0xf6, 0x12, 0x21, 0x54, 0xa1, 0xe4, 0x45, 0xd0, 0xfc, 0xac,
-	0xff, 0x52, 0x6e, 0x72, 0xb5, 0x9e, 0xf9, 0xbe, 0xef, 0x9c, 0x6f, 0xce, 0xf1, 0x9c, 0x41, 0x01,
-	0xcf, 0xe8, 0x38, 0xd5, 0xd3, 0x68, 0xbc, 0x17, 0x71, 0x10, 0xa0, 0x52, 0x15, 0x9e, 0x65, 0x52,
-	0x4b, 0x8c, 0x3c, 0x12, 0x8e, 0xf7, 0x9a, 0x0d, 0x2e, 0xb9, 0xb4, 0xdb, 0x91, 0xf9, 0xe5, 0x18,
-	0xcd, 0x25, 0xad, 0x27, 0x3b, 0x64, 0x63, 0x01, 0x19, 0x29, 0xee, 0x43, 0x36, 0x6f, 0x73, 0x29,
-	0xf9, 0x10, 0x22, 0xbb, 0xea, 0xe7, 0x27, 0x11, 0x15, 0x5e, 0xb1, 0xf5, 0xe7, 0x0d, 0xb4, 0xf6,
-	0x3d, 0xcd, 0xe8, 0x48, 0xe1, 0xb7, 0x51, 0x91, 0x9a, 0xa4, 0x2c, 0x28, 0xb7, 0xcb, 0xdb, 0x37,
-	0xe3, 0x9b, 0x7e, 0xe7, 0x11, 0xc3, 0xbb, 0xa8, 0x91, 0x48, 0xa1, 0x33, 0x9a, 0x68, 0xa2, 0x64,
-	0x9e, 0x25, 0x40, 0x06, 0x54, 0x0d, 0x82, 0x57, 0x2c, 0x11, 0x17, 0x58, 0xd7, 0x42, 0x5f, 0x53,
-	0x35, 0xc0, 0x1f, 0xa1, 0xb7, 0xfa, 0x59, 0xca, 0x38, 0x10, 0xd0, 0x03, 0xc8, 0x20, 0x1f, 0x11,
-	0xca, 0x58, 0x06, 0x4a, 0x05, 0x15, 0x2b, 0xda, 0x70, 0xf0, 0xb1, 0x47, 0x1f, 0x3a, 0x10, 0xdf,
-	0x43, 0xeb, 0x5e, 0x97, 0x0c, 0x68, 0x2a, 0x8c, 0x9b, 0xeb, 0xed, 0xf2, 0x76, 0x25, 0xae, 0xbb,
-	0xed, 0x43, 0xb3, 0xfb, 0x88, 0xe1, 0x2f, 0xd0, 0x1d, 0x95, 0x72, 0x01, 0x8c, 0xd8, 0x4f, 0x46,
-	0x14, 0x68, 0xa2, 0x27, 0x8a, 0x3c, 0x49, 0x05, 0x93, 0x4f, 0x82, 0x35, 0x2b, 0x0a, 0x1c, 0xa7,
-	0x6b, 0x29, 0x5d, 0xd0, 0xbd, 0x89, 0xfa, 0xc9, 0xe2, 0xb8, 0x83, 0x36, 0xbc, 0xbe, 0x4f, 0x75,
-	0x32, 0x80, 0x99, 0xf0, 0x55, 0x2b, 0x7c, 0xc3, 0x81, 0x07, 0x0e, 0xf3, 0x9a, 0xcf, 0x50, 0x73,
-	0x76, 0x18, 0x83, 0x53, 0x9d, 0x67, 0x73, 0xe1, 0x0d, 0x97, 0xb1, 0x60, 0x74, 0x67, 0x04, 0xaf,
-	0xde, 0x43, 0x1b, 0x9a, 0x66, 0x1c, 0xb4, 0xa9, 0x08, 0xd1, 0x13, 0xa2, 0xd3, 0x11, 0xc8, 0x5c,
-	0x07, 0xc8, 0x0a, 0xb1, 0x03, 0x8f, 0xf5, 0xa0, 0x37, 0xe9, 0x39, 0x04, 0x7f, 0x88, 0x30, 0x1d,
-	0x43, 0x46, 0x39, 0x90, 0xfe, 0x50, 0x26, 0xa7, 0x56, 0x12, 0x54, 0x2d, 0xff, 0x96, 0x47, 0x0e,
-	0x0c, 0x60, 0x04, 0xf8, 0x73, 0xb4, 0x59, 0xb0, 0x67, 0x36, 0x17, 0x64, 0x35, 0xe7, 0xcf, 0x53,
-	0x8a, 0xba, 0xcf, 0xe5, 0x02, 0xdd, 0x51, 0x43, 0xaa, 0x06, 0xe4, 0xc4, 0xb4, 0x32, 0x95, 0x62,
-	0xb9, 0xb2, 0x41, 0xbd, 0x5d, 0xde, 0xae, 0x1d, 0x84, 0xcf, 0x5e, 0xdc, 0x2d, 0xfd, 0xfd, 0xe2,
-	0xee, 0x3d, 0x9e, 0xea, 0x41, 0xde, 0x0f, 0x13, 0x39, 0x8a, 0x12, 0xa9, 0x46, 0x52, 0xf9, 0xcf,
-	0x8e, 0x62, 0xa7, 0x91, 0x9e, 0x9e, 0x81, 0x0a, 0x8f, 0x20, 0x89, 0x03, 0x1b, 0xf3, 0x4b, 0x1f,
-	0x72, 0xa1, 0x11, 0xf8, 0x57, 0xd4, 0x58, 0xc9, 0x67, 0x3b, 0x11, 0xbc, 0x76, 0xa5, 0x3c, 0x78,
-	0x29, 0x8f, 0xed, 0x1b, 0x9e, 0xa2, 0x77, 0x56, 0x32, 0x5c, 0x6e, 0x5f, 0xb0, 0x7e, 0xa5, 0x74,
-	0xad, 0xa5, 0x74, 0xc7, 0xab, 0x3d, 0xc7, 0x4f, 0xcb, 0x68, 0x67, 0x25, 0x77, 0x22, 0xc5, 0xc9,
-	0x30, 0x4d, 0x74, 0x2a, 0xf8, 0xcb, 0x7c, 0xdc, 0xba, 0x92, 0x8f, 0xf7, 0x97, 0x7c, 0x1c, 0xce,
-	0x53, 0x5c, 0xb6, 0xf4, 0x18, 0xbd, 0x97, 0x8b, 0xbe, 0x14, 0x8c, 0x58, 0x8d, 0xb1, 0xf1, 0xf2,
-	0xab, 0xf3, 0xba, 0xfd, 0xa3, 0xb4, 0x1d, 0xb9, 0xeb, 0xb9, 0x97, 0xaf, 0xd0, 0x7e, 0xe5, 0xf7,
-	0x7f, 0xda, 0xa5, 0xad, 0x3f, 0x2a, 0xa8, 0xf6, 0x95, 0x1b, 0x62, 0x5d, 0x4d, 0x35, 0xe0, 0x0f,
-	0xd0, 0xda, 0x99, 0x1d, 0x2a, 0x76, 0x8c, 0x54, 0x3b, 0x38, 0x9c, 0x0f, 0xb5, 0xd0, 0x8d, 0x9b,
-	0xd8, 0x33, 0xf0, 0x27, 0xe8, 0xf6, 0x90, 0x2a, 0x4d, 0x64, 0x5f, 0x41, 0x36, 0x06, 0x46, 0x60,
-	0x0c, 0x42, 0x13, 0x21, 0x45, 0x02, 0x76, 0xb8, 0x54, 0xe2, 0x37, 0x0d, 0xe1, 0xb1, 0xc7, 0x8f,
-	0x0d, 0xfc, 0x9d, 0x41, 0xf1, 0xc7, 0xa8, 0x26, 0x73, 0xcd, 0xa5, 0x39, 0x87, 0x9e, 0xa8, 0xe0,
-	0x5a, 0xfb, 0xda, 0x76, 0xb5, 0xd3, 0x08, 0xdd, 0xb8, 0x0b, 0x8b, 0x71, 0x17, 0x3e, 0x14, 0xd3,
-	0xb8, 0x5a, 0x30, 0x7b, 0x13, 0x85, 0xf7, 0x51, 0xdd, 0xb4, 0x22, 0xcd, 0x46, 0xd4, 0xd4, 0xcc,
-	0xcc, 0xa3, 0xff, 0x57, 0x2e, 0x53, 0x71, 0x1f, 0x6d, 0xce, 0x5a, 0xe7, 0xac, 0x8e, 0xa5, 0x06,
-	0x92, 0x41, 0x22, 0x33, 0xa6, 0x82, 0x9b, 0x36, 0xd2, 0xbb, 0x8b, 0x07, 0x2e, 0xfa, 0x60, 0x9d,
-	0xff, 0x28, 0x35, 0xc4, 0x96, 0x3b, 0x9f, 0x13, 0x2b, 0x80, 0xc2, 0x0f, 0x50, 0x9d, 0xc1, 0x10,
-	0x38, 0xd5, 0x40, 0x4e, 0x61, 0xaa, 0x02, 0x64, 0xa3, 0x6e, 0x2e, 0x46, 0xfd, 0x56, 0xf1, 0x23,
-	0xcf, 0xf9, 0x06, 0xa6, 0x2a, 0xae, 0xb1, 0x85, 0x15, 0x7e, 0x80, 0xd6, 0x21, 0x4b, 0x3a, 0xbb,
-	0x44, 0x4b, 0xc2, 0x40, 0xc8, 0x91, 0x0a, 0xaa, 0x36, 0x46, 0xb0, 0xe4, 0x2c, 0x3e, 0xec, 0xec,
-	0xf6, 0xe4, 0x91, 0x21, 0xc4, 0x75, 0x2b, 0xf0, 0x2b, 0x85, 0x7f, 0x41, 0xad, 0x5c, 0xb8, 0xc1,
-	0xc8, 0x88, 0x02, 0xc1, 0x4c, 0xa8, 0xd9, 0xc9, 0x4d, 0xb9, 0x6b, 0x36, 0x60, 0x73, 0x31, 0x60,
-	0x17, 0x04, 0xeb, 0xc9, 0xe2, 0xc0, 0x71, 0x73, 0x16, 0x61, 0x19, 0xe8, 0x4d, 0xd4, 0xd6, 0x3e,
-	0xaa, 0x2d, 0xa6, 0xc7, 0x0d, 0x74, 0xdd, 0x1a, 0xf0, 0x2f, 0x8f, 0x5b, 0x98, 0x5d, 0x6b, 0xdf,
-	0x3f, 0x33, 0x6e, 0x71, 0xf0, 0xc3, 0xb3, 0xf3, 0x56, 0xf9, 0xf9, 0x79, 0xab, 0xfc, 0xef, 0x79,
-	0xab, 0xfc, 0xf4, 0xa2, 0x55, 0x7a, 0x7e, 0xd1, 0x2a, 0xfd, 0x75, 0xd1, 0x2a, 0xfd, 0xfc, 0xe9,
-	0xc2, 0xa5, 0x39, 0x03, 0xce, 0xa7, 0xbf, 0x8d, 0x8b, 0x37, 0x72, 0xc7, 0xbd, 0x1e, 0xd1, 0x48,
-	0xb2, 0x7c, 0x08, 0xd1, 0xf8, 0x7e, 0x34, 0x29, 0x20, 0x77, 0x9b, 0xfa, 0x6b, 0xb6, 0xef, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x74, 0xfe, 0x63, 0x78, 0x9d, 0x07, 0x00, 0x00,
+	// 1752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x6d, 0x73, 0xdb, 0xc6,
+	0x11, 0x16, 0x23, 0xd9, 0xb1, 0x8f, 0x52, 0x2d, 0x9f, 0x29, 0xea, 0x44, 0xc9, 0x24, 0x23, 0x8f,
+	0x53, 0xa5, 0xb5, 0x49, 0x5b, 0x9e, 0x49, 0xa6, 0x4e, 0x5f, 0x62, 0xbd, 0xb8, 0x4e, 0x93, 0x54,
+	0x1a, 0x88, 0x71, 0x66, 0xfa, 0x86, 0x1e, 0x81, 0x23, 0x80, 0x0a, 0xc4, 0x71, 0x70, 0x47, 0x8a,
+	0xfc, 0x96, 0x5f, 0xd0, 0x49, 0xff, 0x55, 0xa6, 0x9f, 0xf2, 0xb1, 0xd3, 0xe9, 0x64, 0x3a, 0xf6,
+	0xb7, 0xfe, 0x8a, 0xce, 0xed, 0x1d, 0x8e, 0x20, 0x09, 0x37, 0x2e, 0x3f, 0x49, 0xc0, 0x3e, 0xcf,
+	0xb3, 0x7b, 0x77, 0xbb, 0x7b, 0x0b, 0x22, 0x12, 0xa4, 0x74, 0x14, 0xc9, 0x49, 0x7b, 0xf4, 0xb8,
+	0x1d, 0xb0, 0x84, 0x89, 0x48, 0xb4, 0x06, 0x29, 0x97, 0x1c, 0x23, 0x63, 0x69, 0x8d, 0x1e, 0xd7,
+	0x2a, 0x01, 0x0f, 0x38, 0xbc, 0x6e, 0xab, 0xff, 0x34, 0xa2, 0x36, 0xc3, 0x35, 0x60, 0x6d, 0xd9,
+	0xca, 0x59, 0xfa, 0x22, 0x30, 0x92, 0xb5, 0x9d, 0x80, 0xf3, 0x20, 0x66, 0x6d, 0x78, 0xea, 0x0e,
+	0x7b, 0x6d, 0x9a, 0x18, 0xc6, 0xfe, 0xdf, 0x6f, 0xa3, 0xeb, 0xe7, 0x34, 0xa5, 0x7d, 0x81, 0xef,
+	0xa2, 0xcc, 0xb5, 0x1b, 0xf9, 0xa4, 0xd4, 0x2c, 0x1d, 0xdc, 0x74, 0x6e, 0x9a, 0x37, 0x9f, 0xfa,
+	0xf8, 0x11, 0xaa, 0x78, 0x3c, 0x91, 0x29, 0xf5, 0xa4, 0x2b, 0xf8, 0x30, 0xf5, 0x98, 0x1b, 0x52,
+	0x11, 0x92, 0x77, 0x00, 0x88, 0x33, 0xdb, 0x05, 0x98, 0x5e, 0x50, 0x11, 0xe2, 0x0f, 0xd1, 0x76,
+	0x37, 0x8d, 0xfc, 0x80, 0xb9, 0x4c, 0x86, 0x2c, 0x65, 0xc3, 0xbe, 0x4b, 0x7d, 0x3f, 0x65, 0x42,
+	0x90, 0x35, 0x20, 0x6d, 0x69, 0xf3, 0xa9, 0xb1, 0x3e, 0xd3, 0x46, 0xfc, 0x3e, 0xba, 0x65, 0x78,
+	0x5e, 0x48, 0xa3, 0x44, 0x45, 0x73, 0xad, 0x59, 0x3a, 0x58, 0x73, 0x36, 0xf4, 0xeb, 0x63, 0xf5,
+	0xf6, 0x53, 0x1f, 0xff, 0x12, 0xed, 0x89, 0x28, 0x48, 0x98, 0xef, 0xc2, 0x9f, 0xd4, 0x15, 0x4c,
+	0xba, 0x72, 0x2c, 0xdc, 0xab, 0x28, 0xf1, 0xf9, 0x15, 0xb9, 0x0e, 0x24, 0xa2, 0x31, 0x17, 0x00,
+	0xb9, 0x60, 0xb2, 0x33, 0x16, 0x5f, 0x81, 0x1d, 0x1f, 0xa2, 0x2d, 0xc3, 0xef, 0x52, 0xe9, 0x85,
+	0xcc, 0x12, 0xdf, 0x05, 0xe2, 0x1d, 0x6d, 0x3c, 0xd2, 0x36, 0xc3, 0xf9, 0x39, 0xaa, 0xd9, 0xc5,
+	0x28, 0x3b, 0x95, 0xc3, 0x74, 0x4a, 0xbc, 0xa1, 0x3d, 0x66, 0x88, 0x0b, 0x0b, 0x30, 0xec, 0xc7,
+	0x68, 0x4b, 0xd2, 0x34, 0x60, 0x52, 0xed, 0x88, 0x2b, 0xc7, 0xae, 0x8c, 0xfa, 0x8c, 0x0f, 0x25,
+	0x41, 0x40, 0xc4, 0xda, 0x78, 0x2a, 0xc3, 0xce, 0xb8, 0xa3, 0x2d, 0xf8, 0x01, 0xc2, 0x74, 0xc4,
+	0x52, 0x1a, 0x30, 0xb7, 0x1b, 0x73, 0xef, 0x12, 0x28, 0xa4, 0x0c, 0xf8, 0x4d, 0x63, 0x39, 0x52,
+	0x06, 0x45, 0xc0, 0xbf, 0x40, 0xbb, 0x19, 0xda, 0x86, 0x99, 0xa3, 0xad, 0xeb, 0xf8, 0x0c, 0x24,
+	0xdb, 0xf7, 0x29, 0x3d, 0x41, 0x7b, 0x22, 0xa6, 0x22, 0x74, 0x7b, 0xea, 0x28, 0x23, 0x9e, 0xcc,
+	0xee, 0x2c, 0xd9, 0x68, 0x96, 0x0e, 0xd6, 0x8f, 0x5a, 0xdf, 0x7e, 0xdf, 0x58, 0xf9, 0xe7, 0xf7,
+	0x8d, 0xf7, 0x83, 0x48, 0x86, 0xc3, 0x6e, 0xcb, 0xe3, 0xfd, 0xb6, 0xc7, 0x45, 0x9f, 0x0b, 0xf3,
+	0xe7, 0xa1, 0xf0, 0x2f, 0xdb, 0x72, 0x32, 0x60, 0xa2, 0x75, 0xc2, 0x3c, 0x87, 0x80, 0xe6, 0x73,
+	0x23, 0x99, 0x3b, 0x08, 0xfc, 0x67, 0x54, 0x99, 0xf3, 0x07, 0x27, 0x41, 0x7e, 0xb4, 0x94, 0x1f,
+	0x3c, 0xe3, 0x07, 0xce, 0x0d, 0x4f, 0xd0, 0x7b, 0x73, 0x1e, 0x16, 0x8f, 0x8f, 0xdc, 0x5a, 0xca,
+	0x5d, 0x7d, 0xc6, 0xdd, 0xe9, 0xfc, 0x99, 0xe3, 0x6f, 0x4a, 0xe8, 0xe1, 0x9c, 0x6f, 0x8f, 0x27,
+	0xbd, 0x38, 0xf2, 0x64, 0x94, 0x04, 0x45, 0x71, 0x6c, 0x2e, 0x15, 0xc7, 0x07, 0x33, 0x71, 0x1c,
+	0x4f, 0x5d, 0x2c, 0x86, 0x74, 0x86, 0xee, 0x0f, 0x93, 0x2e, 0x4f, 0x7c, 0x17, 0x38, 0x2a, 0x8c,
+	0xe2, 0xd2, 0xb9, 0x0d, 0x89, 0xd2, 0xd4, 0xe0, 0x0b, 0x83, 0x2d, 0x28, 0x21, 0x89, 0x1a, 0xa6,
+	0x54, 0x7b, 0x8c, 0xb9, 0x29, 0xbb, 0xa2, 0xa9, 0xef, 0x0e, 0x38, 0x8f, 0xed, 0x9a, 0x09, 0x5e,
+	0x6a, 0x51, 0xbb, 0x5a, 0xf6, 0x39, 0x63, 0x0e, 0x88, 0x9e, 0x73, 0x1e, 0x67, 0x4b, 0xc4, 0x1f,
+	0x21, 0x92, 0x77, 0xc5, 0x06, 0xdc, 0x0b, 0x75, 0x9a, 0x0b, 0x72, 0x07, 0x22, 0xdf, 0x4a, 0x2d,
+	0xeb, 0x54, 0x59, 0x21, 0xc5, 0x85, 0x2a, 0x8f, 0x3c, 0x51, 0x72, 0xd7, 0x8f, 0x84, 0x4c, 0xa3,
+	0xee, 0x10, 0x42, 0xad, 0x34, 0x4b, 0x07, 0x37, 0x1c, 0x32, 0xe5, 0x76, 0xf8, 0x49, 0xce, 0x8e,
+	0x7f, 0x83, 0xf6, 0xd9, 0x88, 0x25, 0xd2, 0x1d, 0x71, 0xa9, 0x56, 0xeb, 0xf1, 0xd4, 0x77, 0x53,
+	0x26, 0x59, 0xa2, 0x73, 0x57, 0x47, 0xb0, 0x05, 0x11, 0xd4, 0x01, 0xf9, 0x92, 0x4b, 0xe6, 0x00,
+	0xce, 0xc9, 0x60, 0x26, 0x94, 0x3f, 0xa2, 0x07, 0xbc, 0x2b, 0x58, 0x3a, 0x9a, 0x6d, 0x5f, 0x61,
+	0x24, 0x24, 0x4f, 0x27, 0x8b, 0xaa, 0x55, 0x50, 0xfd, 0x71, 0xc6, 0xb1, 0x67, 0xf1, 0x42, 0x13,
+	0xe6, 0xe5, 0xcf, 0xd1, 0x7d, 0x36, 0x66, 0xde, 0x50, 0x32, 0xdf, 0xe5, 0x43, 0x19, 0x70, 0x75,
+	0xd6, 0x72, 0xbc, 0xa8, 0xbb, 0x0d, 0xba, 0xef, 0x65, 0xe0, 0x33, 0x83, 0xed, 0x8c, 0xe7, 0x15,
+	0xbb, 0x88, 0x98, 0xa3, 0x0e, 0x19, 0x8d, 0x55, 0xfb, 0x0a, 0x53, 0x26, 0x42, 0x1e, 0xfb, 0x82,
+	0x90, 0x66, 0xe9, 0xa0, 0x7c, 0xb8, 0xdf, 0x9a, 0x5e, 0x5d, 0xad, 0x23, 0xc0, 0xbe, 0x00, 0x68,
+	0xc7, 0x22, 0x8f, 0xd6, 0x54, 0x1e, 0x38, 0xd5, 0x6e, 0xa1, 0x15, 0x4f, 0xd0, 0xfe, 0x4c, 0x3e,
+	0xba, 0x03, 0x7e, 0xc5, 0x52, 0xd7, 0x8f, 0x7a, 0xbd, 0xa9, 0x3b, 0xb2, 0xb3, 0x54, 0x46, 0xdd,
+	0x15, 0xd3, 0xf4, 0x3d, 0x57, 0xb2, 0x27, 0x51, 0xaf, 0x67, 0x7d, 0xe3, 0x0f, 0x11, 0x99, 0x75,
+	0xdd, 0x8f, 0xec, 0x1e, 0xd5, 0x60, 0x8f, 0x2a, 0x39, 0x81, 0x2f, 0xa2, 0xc4, 0xa6, 0xd4, 0xde,
+	0x1c, 0x8f, 0x8e, 0x5d, 0xdb, 0xad, 0x05, 0xd9, 0x05, 0xee, 0x76, 0x9e, 0x4b, 0xc7, 0xcf, 0x4c,
+	0xcf, 0x16, 0xb8, 0x81, 0xca, 0x8a, 0xa0, 0xcd, 0x82, 0xec, 0x01, 0x1a, 0xf5, 0xe9, 0x58, 0x1f,
+	0xb0, 0xc0, 0x11, 0xda, 0x99, 0x02, 0xcc, 0x7e, 0xd8, 0xda, 0xba, 0xbb, 0xd4, 0x4e, 0x54, 0xad,
+	0x3c, 0xec, 0x83, 0x2d, 0xab, 0xcf, 0xd1, 0xbd, 0x11, 0x8d, 0x23, 0x9f, 0x4a, 0x9e, 0xba, 0xe6,
+	0xac, 0x85, 0xa4, 0x52, 0xcc, 0x56, 0x58, 0x1d, 0x62, 0x6c, 0x58, 0xa8, 0x3e, 0xe9, 0x0b, 0x05,
+	0xcc, 0xd7, 0xda, 0x25, 0x7a, 0x32, 0xa0, 0x42, 0x16, 0xf4, 0x39, 0xd7, 0x0b, 0x99, 0x77, 0x39,
+	0xe0, 0x51, 0x22, 0x17, 0xf3, 0xb1, 0x01, 0xea, 0x2d, 0x45, 0x5d, 0xe8, 0x5f, 0xc7, 0x96, 0x37,
+	0x97, 0x9c, 0x4f, 0xd7, 0xbe, 0xfe, 0x57, 0x73, 0x65, 0xff, 0x3f, 0xd7, 0x50, 0xb5, 0x38, 0xef,
+	0xf0, 0xc7, 0xa8, 0xa6, 0x4b, 0x57, 0x48, 0x1a, 0xc7, 0xc6, 0x99, 0x7b, 0x45, 0xd3, 0x24, 0x4a,
+	0x02, 0x18, 0x76, 0xd6, 0x9c, 0x6d, 0x40, 0x5c, 0x28, 0x80, 0x96, 0xfd, 0x4a, 0x9b, 0x55, 0xdb,
+	0x28, 0x20, 0x7b, 0x69, 0x24, 0x23, 0x8f, 0xc6, 0xe4, 0x1d, 0x73, 0xeb, 0xcf, 0xb1, 0x8f, 0x8d,
+	0x1d, 0xe8, 0xd9, 0x26, 0x84, 0x2c, 0x0a, 0x42, 0xe9, 0xc6, 0x34, 0xb0, 0xce, 0x57, 0x67, 0x87,
+	0x86, 0x17, 0x80, 0xf8, 0x9c, 0x06, 0x99, 0xf7, 0x5f, 0xa1, 0xbd, 0x22, 0xba, 0x75, 0xbf, 0x06,
+	0xfc, 0x9d, 0x05, 0xbe, 0xf5, 0x7f, 0x84, 0xea, 0xf9, 0x16, 0x30, 0x4d, 0x4e, 0x1b, 0x82, 0x1e,
+	0xaf, 0x6a, 0xdc, 0x16, 0xbf, 0x4d, 0xd0, 0x2c, 0x88, 0x13, 0xd4, 0x78, 0x83, 0x86, 0x8d, 0x43,
+	0x8f, 0x5b, 0xbb, 0x05, 0x22, 0x36, 0x12, 0x89, 0x1a, 0xb9, 0x62, 0x31, 0xc3, 0x97, 0xce, 0xe9,
+	0x2c, 0x94, 0x77, 0x97, 0xbb, 0x2e, 0x6c, 0x7d, 0x41, 0x62, 0xfb, 0x90, 0xd8, 0x59, 0xec, 0x23,
+	0xd4, 0x7c, 0x93, 0x57, 0x1b, 0xfc, 0x8d, 0xa5, 0xdc, 0xee, 0x15, 0xb9, 0xb5, 0xab, 0x7d, 0x80,
+	0x30, 0x5c, 0x33, 0x3e, 0x1b, 0xc8, 0xd0, 0x2e, 0xf0, 0xa6, 0x1e, 0xdd, 0x94, 0xe5, 0x44, 0x19,
+	0xb2, 0x28, 0x5b, 0xe8, 0x4e, 0x0e, 0x6d, 0x03, 0xd3, 0x93, 0xe1, 0x6d, 0x0b, 0xcf, 0xd4, 0xf7,
+	0xff, 0xba, 0x8e, 0xd6, 0x7f, 0xad, 0xbf, 0x1c, 0x54, 0xed, 0x31, 0xfc, 0x13, 0x74, 0x7d, 0x00,
+	0x93, 0x3c, 0xa4, 0x73, 0xf9, 0x10, 0xe7, 0xdb, 0xb1, 0x9e, 0xf1, 0x1d, 0x83, 0xc0, 0x3f, 0x43,
+	0x3b, 0xb1, 0x2a, 0x4e, 0x7b, 0x05, 0xe9, 0xfc, 0x4e, 0x78, 0xe2, 0x31, 0x93, 0xcf, 0x55, 0x05,
+	0x38, 0x33, 0xf6, 0x53, 0x65, 0xfe, 0xad, 0xb2, 0xe2, 0x8f, 0xd0, 0x7a, 0x2e, 0x13, 0x04, 0x59,
+	0x6d, 0xae, 0x1e, 0x94, 0x0f, 0x2b, 0x2d, 0xfd, 0x8d, 0xd1, 0xca, 0xbe, 0x31, 0x5a, 0xcf, 0x92,
+	0x89, 0x53, 0x9e, 0x26, 0x83, 0xc0, 0x4f, 0xd1, 0x86, 0x9a, 0x7f, 0xa2, 0xb4, 0x4f, 0x55, 0xe5,
+	0xaa, 0x8f, 0x80, 0x37, 0x33, 0x67, 0xa1, 0xb8, 0x9b, 0x2b, 0xa1, 0x85, 0x2b, 0x58, 0x90, 0x9b,
+	0xa0, 0x74, 0x2f, 0xbf, 0xe0, 0xac, 0x79, 0x9c, 0xce, 0x5d, 0xc3, 0x84, 0x15, 0x1b, 0x04, 0xfe,
+	0x04, 0x6d, 0xf8, 0x2c, 0x66, 0x01, 0x95, 0xcc, 0xbd, 0x64, 0x13, 0x41, 0x10, 0xa8, 0xee, 0xe6,
+	0x55, 0xbf, 0x10, 0xc1, 0x89, 0xc1, 0x7c, 0xc6, 0x26, 0xc2, 0x59, 0xf7, 0x73, 0x4f, 0xf8, 0x13,
+	0x74, 0x8b, 0xa5, 0xde, 0xe1, 0x23, 0x18, 0x2c, 0x58, 0xc2, 0xfb, 0x82, 0x94, 0x41, 0x83, 0xcc,
+	0x44, 0xe6, 0x1c, 0x1f, 0x3e, 0xea, 0xf0, 0x13, 0x05, 0x70, 0x36, 0x80, 0x60, 0x9e, 0x04, 0xfe,
+	0x13, 0xaa, 0x0f, 0x13, 0xfd, 0x35, 0xe2, 0xbb, 0x82, 0x25, 0xbe, 0x92, 0xb2, 0x2b, 0x57, 0xdb,
+	0xbd, 0x0e, 0x82, 0xb5, 0xbc, 0xe0, 0x05, 0x4b, 0xfc, 0x0e, 0xcf, 0x16, 0xec, 0xd4, 0xac, 0xc2,
+	0xac, 0x41, 0x9d, 0xc1, 0xd7, 0x25, 0x74, 0xef, 0x87, 0xbb, 0xb2, 0x20, 0x1b, 0xe0, 0xe5, 0xa7,
+	0xb3, 0x19, 0xf4, 0x3f, 0x3b, 0xb2, 0xb9, 0xd9, 0x9b, 0x3f, 0xd0, 0xb8, 0x05, 0xfe, 0x5b, 0x09,
+	0x7d, 0xf0, 0x16, 0x17, 0x43, 0x2f, 0xe6, 0x3c, 0x15, 0xf0, 0x25, 0x50, 0x3e, 0x7c, 0xfc, 0x7f,
+	0x04, 0xf2, 0x1c, 0x88, 0x26, 0x9c, 0xfb, 0x83, 0xb7, 0x01, 0xe3, 0x97, 0xa8, 0xda, 0xa5, 0x7e,
+	0x2e, 0x10, 0x36, 0x8a, 0x7c, 0xa6, 0x6a, 0xe1, 0x16, 0x6c, 0x44, 0x73, 0x66, 0xb2, 0xa1, 0xbe,
+	0x55, 0x3a, 0x35, 0x38, 0xa7, 0xd2, 0x2d, 0x78, 0x8b, 0xff, 0x80, 0xaa, 0xc5, 0x37, 0x2a, 0xd9,
+	0x5c, 0xd4, 0x7d, 0x59, 0x70, 0xa1, 0x9a, 0x65, 0x54, 0x8a, 0x2e, 0x5b, 0x7c, 0x8e, 0xf0, 0xe2,
+	0x2d, 0x0d, 0xa3, 0x7b, 0xf9, 0x70, 0x6f, 0x71, 0x16, 0xcb, 0xdd, 0xd0, 0x5a, 0x75, 0xb3, 0x3b,
+	0xf7, 0x1e, 0xff, 0x1e, 0x55, 0xed, 0xa9, 0x5c, 0xb2, 0x89, 0x9b, 0x72, 0x69, 0x6a, 0x15, 0x43,
+	0xbc, 0x8d, 0xa2, 0x0a, 0xfb, 0x8c, 0x4d, 0x1c, 0x83, 0xcb, 0xc2, 0x65, 0x8b, 0x26, 0x81, 0xcf,
+	0xd0, 0xed, 0xe9, 0x66, 0xe8, 0x19, 0x5b, 0x8d, 0xeb, 0xab, 0xf3, 0xd1, 0xda, 0x7d, 0xd0, 0x93,
+	0x7f, 0xb6, 0x07, 0x9b, 0xa3, 0xb9, 0xf7, 0xf8, 0x4b, 0x54, 0x29, 0x18, 0xa1, 0x05, 0xa9, 0x80,
+	0xe6, 0xdd, 0xbc, 0xe6, 0xd9, 0xfc, 0xd8, 0x6c, 0x44, 0xf1, 0xc2, 0x3c, 0xad, 0xe2, 0xc4, 0x76,
+	0x74, 0x86, 0x42, 0x82, 0xba, 0xdb, 0x5a, 0x6c, 0x06, 0xa7, 0x06, 0x05, 0x5f, 0x9a, 0x9d, 0x71,
+	0x16, 0x27, 0x9b, 0x7d, 0x2d, 0x30, 0x43, 0x35, 0x2b, 0x68, 0x7f, 0x42, 0xf1, 0xd4, 0x24, 0xa1,
+	0x84, 0xab, 0xcd, 0xd5, 0xf9, 0xd9, 0x39, 0x13, 0x3e, 0x36, 0xe0, 0x63, 0x1a, 0xc7, 0x56, 0x7f,
+	0x9b, 0x15, 0x5a, 0xc5, 0xfe, 0x53, 0xb4, 0x9e, 0x6f, 0x2d, 0xb8, 0x82, 0xae, 0x41, 0x73, 0x31,
+	0x3f, 0xe5, 0xe8, 0x07, 0xf5, 0x16, 0x5a, 0x93, 0xf9, 0xdd, 0x46, 0x3f, 0x1c, 0x7d, 0xf9, 0xed,
+	0xab, 0x7a, 0xe9, 0xbb, 0x57, 0xf5, 0xd2, 0xbf, 0x5f, 0xd5, 0x4b, 0xdf, 0xbc, 0xae, 0xaf, 0x7c,
+	0xf7, 0xba, 0xbe, 0xf2, 0x8f, 0xd7, 0xf5, 0x95, 0xdf, 0x7d, 0x9c, 0xbb, 0x0a, 0x07, 0x2c, 0x08,
+	0x26, 0x7f, 0x19, 0x65, 0x3f, 0x3a, 0x3d, 0xd4, 0xf9, 0xd3, 0xee, 0x73, 0x7f, 0x18, 0xb3, 0xf6,
+	0xe8, 0x49, 0x7b, 0x9c, 0x99, 0xf4, 0x1d, 0xd9, 0xbd, 0x0e, 0x3d, 0xfd, 0xc9, 0x7f, 0x07, 0x00,
+	0x6e, 0x6a, 0x47, 0x28, 0xee, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -461,6 +829,129 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PastEthereumSignatureCheckpointRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PastEthereumSignatureCheckpointRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.ValidatorBridgeStatsEpochBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorBridgeStatsEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.MaxSignerPowerFraction.Size()
+		i -= size
+		if _, err := m.MaxSignerPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.MaxSigners != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSigners))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.SignerSetTxMaxAgeBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetTxMaxAgeBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.SignerSetTxMinBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetTxMinBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.SignerSetTxPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.SignerSetTxPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size, err := m.BridgeHealthThresholds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.ExecutedOutgoingTxRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedOutgoingTxRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ObservedSignerSetHistoryRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ObservedSignerSetHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.EventVoteRecordRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventVoteRecordRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.RewardPoolToDistribution {
+		i--
+		if m.RewardPoolToDistribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RewardPoolEpochBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardPoolEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.BridgeFeeRewardPoolFraction.Size()
+		i -= size
+		if _, err := m.BridgeFeeRewardPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
 		i--
@@ -569,7 +1060,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *BridgeHealthThresholds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -579,24 +1070,253 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *BridgeHealthThresholds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BridgeHealthThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
+	if m.PoolDepthCritical != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolDepthCritical))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PoolDepthWarning != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolDepthWarning))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.SignerSetSignedPowerCritical.Size()
+		i -= size
+		if _, err := m.SignerSetSignedPowerCritical.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SignerSetSignedPowerWarning.Size()
+		i -= size
+		if _, err := m.SignerSetSignedPowerWarning.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OutgoingTxAgeBlocksCritical != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutgoingTxAgeBlocksCritical))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OutgoingTxAgeBlocksWarning != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutgoingTxAgeBlocksWarning))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EthereumHeightLagCritical != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeightLagCritical))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumHeightLagWarning != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeightLagWarning))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventStallBlocksCritical != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventStallBlocksCritical))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventStallBlocksWarning != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventStallBlocksWarning))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutedContractCallTxs) > 0 {
+		for iNdEx := len(m.ExecutedContractCallTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedContractCallTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ExecutedBatchTxs) > 0 {
+		for iNdEx := len(m.ExecutedBatchTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedBatchTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ObservedSignerSets) > 0 {
+		for iNdEx := len(m.ObservedSignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedSignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.EthereumKeyRotations) > 0 {
+		for iNdEx := len(m.EthereumKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	{
+		size, err := m.BridgeStatsEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.ValidatorBridgeStats) > 0 {
+		for iNdEx := len(m.ValidatorBridgeStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBridgeStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadSignatureEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size, err := m.PastEthereumSignatureCheckpointFloors.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthereumSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastEthereumSignatureCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
@@ -791,6 +1511,81 @@ func (m *Params) Size() (n int) {
 	if m.UnbondSlashingSignerSetTxsWindow != 0 {
 		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
 	}
+	l = m.BridgeFeeRewardPoolFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.RewardPoolEpochBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.RewardPoolEpochBlocks))
+	}
+	if m.RewardPoolToDistribution {
+		n += 3
+	}
+	if m.EventVoteRecordRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.EventVoteRecordRetentionBlocks))
+	}
+	if m.ObservedSignerSetHistoryRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.ObservedSignerSetHistoryRetentionBlocks))
+	}
+	if m.ExecutedOutgoingTxRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedOutgoingTxRetentionBlocks))
+	}
+	l = m.BridgeHealthThresholds.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SignerSetTxPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignerSetTxMinBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetTxMinBlocks))
+	}
+	if m.SignerSetTxMaxAgeBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetTxMaxAgeBlocks))
+	}
+	if m.MaxSigners != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxSigners))
+	}
+	l = m.MaxSignerPowerFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ValidatorBridgeStatsEpochBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.ValidatorBridgeStatsEpochBlocks))
+	}
+	if m.PastEthereumSignatureCheckpointRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.PastEthereumSignatureCheckpointRetentionBlocks))
+	}
+	return n
+}
+
+func (m *BridgeHealthThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventStallBlocksWarning != 0 {
+		n += 1 + sovGenesis(uint64(m.EventStallBlocksWarning))
+	}
+	if m.EventStallBlocksCritical != 0 {
+		n += 1 + sovGenesis(uint64(m.EventStallBlocksCritical))
+	}
+	if m.EthereumHeightLagWarning != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumHeightLagWarning))
+	}
+	if m.EthereumHeightLagCritical != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumHeightLagCritical))
+	}
+	if m.OutgoingTxAgeBlocksWarning != 0 {
+		n += 1 + sovGenesis(uint64(m.OutgoingTxAgeBlocksWarning))
+	}
+	if m.OutgoingTxAgeBlocksCritical != 0 {
+		n += 1 + sovGenesis(uint64(m.OutgoingTxAgeBlocksCritical))
+	}
+	l = m.SignerSetSignedPowerWarning.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SignerSetSignedPowerCritical.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.PoolDepthWarning != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolDepthWarning))
+	}
+	if m.PoolDepthCritical != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolDepthCritical))
+	}
 	return n
 }
 
@@ -843,6 +1638,58 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for _, e := range m.PastEthereumSignatureCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PastEthereumSignatureCheckpointFloors.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BadSignatureEvidence) > 0 {
+		for _, e := range m.BadSignatureEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBridgeStats) > 0 {
+		for _, e := range m.ValidatorBridgeStats {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BridgeStatsEpoch.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.EthereumKeyRotations) > 0 {
+		for _, e := range m.EthereumKeyRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedSignerSets) > 0 {
+		for _, e := range m.ObservedSignerSets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedBatchTxs) > 0 {
+		for _, e := range m.ExecutedBatchTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedContractCallTxs) > 0 {
+		for _, e := range m.ExecutedContractCallTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1051,11 +1898,915 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeRewardPoolFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFeeRewardPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolEpochBlocks", wireType)
+			}
+			m.RewardPoolEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPoolEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolToDistribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardPoolToDistribution = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteRecordRetentionBlocks", wireType)
+			}
+			m.EventVoteRecordRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventVoteRecordRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSignerSetHistoryRetentionBlocks", wireType)
+			}
+			m.ObservedSignerSetHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedSignerSetHistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedOutgoingTxRetentionBlocks", wireType)
+			}
+			m.ExecutedOutgoingTxRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedOutgoingTxRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHealthThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeHealthThresholds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetTxPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxMinBlocks", wireType)
+			}
+			m.SignerSetTxMinBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetTxMinBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxMaxAgeBlocks", wireType)
+			}
+			m.SignerSetTxMaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetTxMaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			}
+			m.MaxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerPowerFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSignerPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBridgeStatsEpochBlocks", wireType)
+			}
+			m.ValidatorBridgeStatsEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorBridgeStatsEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthereumSignatureCheckpointRetentionBlocks", wireType)
+			}
+			m.PastEthereumSignatureCheckpointRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PastEthereumSignatureCheckpointRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeHealthThresholds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeHealthThresholds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeHealthThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStallBlocksWarning", wireType)
+			}
+			m.EventStallBlocksWarning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStallBlocksWarning |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStallBlocksCritical", wireType)
+			}
+			m.EventStallBlocksCritical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStallBlocksCritical |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightLagWarning", wireType)
+			}
+			m.EthereumHeightLagWarning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeightLagWarning |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightLagCritical", wireType)
+			}
+			m.EthereumHeightLagCritical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeightLagCritical |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxAgeBlocksWarning", wireType)
+			}
+			m.OutgoingTxAgeBlocksWarning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxAgeBlocksWarning |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxAgeBlocksCritical", wireType)
+			}
+			m.OutgoingTxAgeBlocksCritical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxAgeBlocksCritical |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetSignedPowerWarning", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetSignedPowerWarning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetSignedPowerCritical", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetSignedPowerCritical.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDepthWarning", wireType)
+			}
+			m.PoolDepthWarning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolDepthWarning |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDepthCritical", wireType)
+			}
+			m.PoolDepthCritical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolDepthCritical |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
 			}
-			m.EthereumSignaturesWindow = 0
+			m.LastObservedEventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1065,16 +2816,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
 			}
-			m.TargetEthTxTimeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1084,16 +2835,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1103,16 +2869,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1122,16 +2903,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1141,30 +2937,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1174,30 +2971,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1207,30 +3005,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthereumSignatureCheckpoints", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,30 +3039,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PastEthereumSignatureCheckpoints = append(m.PastEthereumSignatureCheckpoints, PastEthereumSignatureCheckpoint{})
+			if err := m.PastEthereumSignatureCheckpoints[len(m.PastEthereumSignatureCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthereumSignatureCheckpointFloors", wireType)
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1273,64 +3073,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PastEthereumSignatureCheckpointFloors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1357,18 +3121,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BadSignatureEvidence = append(m.BadSignatureEvidence, &BadSignatureEvidence{})
+			if err := m.BadSignatureEvidence[len(m.BadSignatureEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBridgeStats", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1378,14 +3140,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBridgeStats = append(m.ValidatorBridgeStats, ValidatorBridgeStats{})
+			if err := m.ValidatorBridgeStats[len(m.ValidatorBridgeStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeStatsEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1412,14 +3189,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BridgeStatsEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumKeyRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1446,14 +3222,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthereumKeyRotations = append(m.EthereumKeyRotations, EthereumKeyRotation{})
+			if err := m.EthereumKeyRotations[len(m.EthereumKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1480,14 +3256,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewards{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSignerSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1514,14 +3290,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObservedSignerSets = append(m.ObservedSignerSets, ObservedSignerSet{})
+			if err := m.ObservedSignerSets[len(m.ObservedSignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1548,14 +3324,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutedBatchTxs = append(m.ExecutedBatchTxs, ExecutedBatchTx{})
+			if err := m.ExecutedBatchTxs[len(m.ExecutedBatchTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedContractCallTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1582,8 +3358,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecutedContractCallTxs = append(m.ExecutedContractCallTxs, ExecutedContractCallTx{})
+			if err := m.ExecutedContractCallTxs[len(m.ExecutedContractCallTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22,6 +22,12 @@ func TestGenesisStateValidate(t *testing.T) {
 				BridgeChainId:         3279089,
 			},
 		}, expErr: true},
+		"signer set tx max age below min blocks": {src: func() *GenesisState {
+			state := DefaultGenesisState()
+			state.Params.SignerSetTxMinBlocks = 100
+			state.Params.SignerSetTxMaxAgeBlocks = 50
+			return state
+		}(), expErr: true},
 		"valid delegate": {src: &GenesisState{
 			Params: DefaultParams(),
 			DelegateKeys: []*MsgDelegateKeys{
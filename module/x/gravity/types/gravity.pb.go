@@ -27,6 +27,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SignerSetTxReason is why a signer set tx was created. Signer set txs created
+// before reasons were recorded are unspecified.
+type SignerSetTxReason int32
+
+const (
+	SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED SignerSetTxReason = 0
+	// there was no signer set tx yet
+	SignerSetTxReason_SIGNER_SET_TX_REASON_INITIAL SignerSetTxReason = 1
+	// a validator started unbonding
+	SignerSetTxReason_SIGNER_SET_TX_REASON_UNBONDING SignerSetTxReason = 2
+	// the normalized power changed by more than the threshold
+	SignerSetTxReason_SIGNER_SET_TX_REASON_POWER_CHANGE SignerSetTxReason = 3
+	// a governance proposal asked for it
+	SignerSetTxReason_SIGNER_SET_TX_REASON_GOVERNANCE SignerSetTxReason = 4
+	// a validator rotated its Ethereum key
+	SignerSetTxReason_SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION SignerSetTxReason = 5
+	// the latest signer set tx reached the maximum age
+	SignerSetTxReason_SIGNER_SET_TX_REASON_MAX_AGE SignerSetTxReason = 6
+)
+
+var SignerSetTxReason_name = map[int32]string{
+	0: "SIGNER_SET_TX_REASON_UNSPECIFIED",
+	1: "SIGNER_SET_TX_REASON_INITIAL",
+	2: "SIGNER_SET_TX_REASON_UNBONDING",
+	3: "SIGNER_SET_TX_REASON_POWER_CHANGE",
+	4: "SIGNER_SET_TX_REASON_GOVERNANCE",
+	5: "SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION",
+	6: "SIGNER_SET_TX_REASON_MAX_AGE",
+}
+
+var SignerSetTxReason_value = map[string]int32{
+	"SIGNER_SET_TX_REASON_UNSPECIFIED":           0,
+	"SIGNER_SET_TX_REASON_INITIAL":               1,
+	"SIGNER_SET_TX_REASON_UNBONDING":             2,
+	"SIGNER_SET_TX_REASON_POWER_CHANGE":          3,
+	"SIGNER_SET_TX_REASON_GOVERNANCE":            4,
+	"SIGNER_SET_TX_REASON_ETHEREUM_KEY_ROTATION": 5,
+	"SIGNER_SET_TX_REASON_MAX_AGE":               6,
+}
+
+func (x SignerSetTxReason) String() string {
+	return proto.EnumName(SignerSetTxReason_name, int32(x))
+}
+
+func (SignerSetTxReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{0}
+}
+
 // EthereumEventVoteRecord is an event that is pending of confirmation by 2/3 of
 // the signer set. The event is then attested and executed in the state machine
 // once the required threshold is met.
@@ -34,6 +82,8 @@ type EthereumEventVoteRecord struct {
 	Event    *types.Any `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 	Accepted bool       `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// the cosmos height at which the record was accepted
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *EthereumEventVoteRecord) Reset()         { *m = EthereumEventVoteRecord{} }
@@ -90,6 +140,13 @@ func (m *EthereumEventVoteRecord) GetAccepted() bool {
 	return false
 }
 
+func (m *EthereumEventVoteRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // LatestEthereumBlockHeight defines the latest observed ethereum block height
 // and the corresponding timestamp value in nanoseconds.
 type LatestEthereumBlockHeight struct {
@@ -144,6 +201,313 @@ func (m *LatestEthereumBlockHeight) GetCosmosHeight() uint64 {
 	return 0
 }
 
+// ValidatorBridgeStats holds the participation counters of a validator in the
+// bridge over the current BridgeStatsEpoch. Signed confirmations are counted as
+// they are submitted while expected confirmations are counted once the signing
+// window of an outgoing tx has passed. Observed events are the events observed
+// since the epoch started, regardless of the validator's vote.
+type ValidatorBridgeStats struct {
+	ValidatorAddress      string                    `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SignedConfirmations   uint64                    `protobuf:"varint,2,opt,name=signed_confirmations,json=signedConfirmations,proto3" json:"signed_confirmations,omitempty"`
+	ExpectedConfirmations uint64                    `protobuf:"varint,3,opt,name=expected_confirmations,json=expectedConfirmations,proto3" json:"expected_confirmations,omitempty"`
+	EventVotes            uint64                    `protobuf:"varint,4,opt,name=event_votes,json=eventVotes,proto3" json:"event_votes,omitempty"`
+	ObservedEvents        uint64                    `protobuf:"varint,5,opt,name=observed_events,json=observedEvents,proto3" json:"observed_events,omitempty"`
+	LastHeightVote        LatestEthereumBlockHeight `protobuf:"bytes,6,opt,name=last_height_vote,json=lastHeightVote,proto3" json:"last_height_vote"`
+	// start height of the epoch the counters were counted over, counters of a
+	// past epoch are reset when they are next read
+	EpochStartHeight uint64 `protobuf:"varint,7,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	// BridgeStatsEpoch observed events when the counters were started, observed
+	// events are derived from it rather than counted for every validator
+	ObservedEventsBaseline uint64 `protobuf:"varint,8,opt,name=observed_events_baseline,json=observedEventsBaseline,proto3" json:"observed_events_baseline,omitempty"`
+}
+
+func (m *ValidatorBridgeStats) Reset()         { *m = ValidatorBridgeStats{} }
+func (m *ValidatorBridgeStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgeStats) ProtoMessage()    {}
+func (*ValidatorBridgeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{2}
+}
+func (m *ValidatorBridgeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBridgeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgeStats.Merge(m, src)
+}
+func (m *ValidatorBridgeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBridgeStats proto.InternalMessageInfo
+
+func (m *ValidatorBridgeStats) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBridgeStats) GetSignedConfirmations() uint64 {
+	if m != nil {
+		return m.SignedConfirmations
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeStats) GetExpectedConfirmations() uint64 {
+	if m != nil {
+		return m.ExpectedConfirmations
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeStats) GetEventVotes() uint64 {
+	if m != nil {
+		return m.EventVotes
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeStats) GetObservedEvents() uint64 {
+	if m != nil {
+		return m.ObservedEvents
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeStats) GetLastHeightVote() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastHeightVote
+	}
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *ValidatorBridgeStats) GetEpochStartHeight() uint64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeStats) GetObservedEventsBaseline() uint64 {
+	if m != nil {
+		return m.ObservedEventsBaseline
+	}
+	return 0
+}
+
+// BridgeStatsEpoch is the period the validator bridge stats are counted over,
+// a new one starts every ValidatorBridgeStatsEpochBlocks
+type BridgeStatsEpoch struct {
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// events observed since the chain started
+	ObservedEvents uint64 `protobuf:"varint,2,opt,name=observed_events,json=observedEvents,proto3" json:"observed_events,omitempty"`
+	// events observed since the chain started when the epoch started
+	StartObservedEvents uint64 `protobuf:"varint,3,opt,name=start_observed_events,json=startObservedEvents,proto3" json:"start_observed_events,omitempty"`
+}
+
+func (m *BridgeStatsEpoch) Reset()         { *m = BridgeStatsEpoch{} }
+func (m *BridgeStatsEpoch) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsEpoch) ProtoMessage()    {}
+func (*BridgeStatsEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{3}
+}
+func (m *BridgeStatsEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeStatsEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsEpoch.Merge(m, src)
+}
+func (m *BridgeStatsEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStatsEpoch proto.InternalMessageInfo
+
+func (m *BridgeStatsEpoch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BridgeStatsEpoch) GetObservedEvents() uint64 {
+	if m != nil {
+		return m.ObservedEvents
+	}
+	return 0
+}
+
+func (m *BridgeStatsEpoch) GetStartObservedEvents() uint64 {
+	if m != nil {
+		return m.StartObservedEvents
+	}
+	return 0
+}
+
+// EthereumKeyRotation records the delegate keys a validator is replacing.
+// They remain valid until the signer set tx with the given nonce, which is the
+// first to contain the new Ethereum key, is observed on Ethereum.
+type EthereumKeyRotation struct {
+	ValidatorAddress       string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OldEthereumAddress     string `protobuf:"bytes,2,opt,name=old_ethereum_address,json=oldEthereumAddress,proto3" json:"old_ethereum_address,omitempty"`
+	OldOrchestratorAddress string `protobuf:"bytes,3,opt,name=old_orchestrator_address,json=oldOrchestratorAddress,proto3" json:"old_orchestrator_address,omitempty"`
+	SignerSetNonce         uint64 `protobuf:"varint,4,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+}
+
+func (m *EthereumKeyRotation) Reset()         { *m = EthereumKeyRotation{} }
+func (m *EthereumKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*EthereumKeyRotation) ProtoMessage()    {}
+func (*EthereumKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{4}
+}
+func (m *EthereumKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumKeyRotation.Merge(m, src)
+}
+func (m *EthereumKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumKeyRotation proto.InternalMessageInfo
+
+func (m *EthereumKeyRotation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthereumKeyRotation) GetOldEthereumAddress() string {
+	if m != nil {
+		return m.OldEthereumAddress
+	}
+	return ""
+}
+
+func (m *EthereumKeyRotation) GetOldOrchestratorAddress() string {
+	if m != nil {
+		return m.OldOrchestratorAddress
+	}
+	return ""
+}
+
+func (m *EthereumKeyRotation) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+// ValidatorRewards holds a validator's participation in the current reward
+// pool epoch, one point per Ethereum tx confirmation and per vote for an
+// observed event, and the total it has been paid from the reward pool.
+type ValidatorRewards struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Participation    uint64                                   `protobuf:"varint,2,opt,name=participation,proto3" json:"participation,omitempty"`
+	Distributed      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{5}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewards) GetParticipation() uint64 {
+	if m != nil {
+		return m.Participation
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
 // EthereumSigner represents a cosmos validator with its corresponding bridge
 // operator ethereum address and its staking consensus power.
 type EthereumSigner struct {
@@ -155,7 +519,7 @@ func (m *EthereumSigner) Reset()         { *m = EthereumSigner{} }
 func (m *EthereumSigner) String() string { return proto.CompactTextString(m) }
 func (*EthereumSigner) ProtoMessage()    {}
 func (*EthereumSigner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{2}
+	return fileDescriptor_1715a041eadeb531, []int{6}
 }
 func (m *EthereumSigner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,16 +567,17 @@ func (m *EthereumSigner) GetEthereumAddress() string {
 // are used to check signatures on Ethereum in order to get significant gas
 // savings.
 type SignerSetTx struct {
-	Nonce   uint64          `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Height  uint64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Signers EthereumSigners `protobuf:"bytes,3,rep,name=signers,proto3,castrepeated=EthereumSigners" json:"signers,omitempty"`
+	Nonce   uint64            `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Height  uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Signers EthereumSigners   `protobuf:"bytes,3,rep,name=signers,proto3,castrepeated=EthereumSigners" json:"signers,omitempty"`
+	Reason  SignerSetTxReason `protobuf:"varint,4,opt,name=reason,proto3,enum=gravity.v1.SignerSetTxReason" json:"reason,omitempty"`
 }
 
 func (m *SignerSetTx) Reset()         { *m = SignerSetTx{} }
 func (m *SignerSetTx) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTx) ProtoMessage()    {}
 func (*SignerSetTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{3}
+	return fileDescriptor_1715a041eadeb531, []int{7}
 }
 func (m *SignerSetTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,6 +627,85 @@ func (m *SignerSetTx) GetSigners() EthereumSigners {
 	return nil
 }
 
+func (m *SignerSetTx) GetReason() SignerSetTxReason {
+	if m != nil {
+		return m.Reason
+	}
+	return SignerSetTxReason_SIGNER_SET_TX_REASON_UNSPECIFIED
+}
+
+// ObservedSignerSet is a signer set the bridge contract switched to, with the
+// Ethereum height of the SignerSetTxExecutedEvent and the Cosmos height at
+// which that event was observed. It controls the bridge from that Ethereum
+// height until the next observed signer set.
+type ObservedSignerSet struct {
+	SignerSetNonce uint64          `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	Signers        EthereumSigners `protobuf:"bytes,2,rep,name=signers,proto3,castrepeated=EthereumSigners" json:"signers,omitempty"`
+	EthereumHeight uint64          `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	CosmosHeight   uint64          `protobuf:"varint,4,opt,name=cosmos_height,json=cosmosHeight,proto3" json:"cosmos_height,omitempty"`
+}
+
+func (m *ObservedSignerSet) Reset()         { *m = ObservedSignerSet{} }
+func (m *ObservedSignerSet) String() string { return proto.CompactTextString(m) }
+func (*ObservedSignerSet) ProtoMessage()    {}
+func (*ObservedSignerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{8}
+}
+func (m *ObservedSignerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedSignerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedSignerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservedSignerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedSignerSet.Merge(m, src)
+}
+func (m *ObservedSignerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedSignerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedSignerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservedSignerSet proto.InternalMessageInfo
+
+func (m *ObservedSignerSet) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *ObservedSignerSet) GetSigners() EthereumSigners {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ObservedSignerSet) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *ObservedSignerSet) GetCosmosHeight() uint64 {
+	if m != nil {
+		return m.CosmosHeight
+	}
+	return 0
+}
+
 // BatchTx represents a batch of transactions going from Cosmos to Ethereum.
 // Batch txs are are identified by a unique hash and the token contract that is
 // shared by all the SendToEthereum
@@ -277,7 +721,7 @@ func (m *BatchTx) Reset()         { *m = BatchTx{} }
 func (m *BatchTx) String() string { return proto.CompactTextString(m) }
 func (*BatchTx) ProtoMessage()    {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{4}
+	return fileDescriptor_1715a041eadeb531, []int{9}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,13 +793,17 @@ type SendToEthereum struct {
 	EthereumRecipient string     `protobuf:"bytes,3,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Erc20Token        ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee          ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// the reward pool's fraction of the bridge fee, held by the gravity module
+	// until the batch containing the send is executed and refunded if the send
+	// is canceled, unset if the reward pool takes no fraction of the fee
+	Erc20RewardPoolFee *ERC20Token `protobuf:"bytes,6,opt,name=erc20_reward_pool_fee,json=erc20RewardPoolFee,proto3" json:"erc20_reward_pool_fee,omitempty"`
 }
 
 func (m *SendToEthereum) Reset()         { *m = SendToEthereum{} }
 func (m *SendToEthereum) String() string { return proto.CompactTextString(m) }
 func (*SendToEthereum) ProtoMessage()    {}
 func (*SendToEthereum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{5}
+	return fileDescriptor_1715a041eadeb531, []int{10}
 }
 func (m *SendToEthereum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,10 +867,17 @@ func (m *SendToEthereum) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
-// ContractCallTx represents an individual arbitrary logic call transaction
-// from Cosmos to Ethereum.
-type ContractCallTx struct {
-	InvalidationNonce uint64       `protobuf:"varint,1,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+func (m *SendToEthereum) GetErc20RewardPoolFee() *ERC20Token {
+	if m != nil {
+		return m.Erc20RewardPoolFee
+	}
+	return nil
+}
+
+// ContractCallTx represents an individual arbitrary logic call transaction
+// from Cosmos to Ethereum.
+type ContractCallTx struct {
+	InvalidationNonce uint64       `protobuf:"varint,1,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	InvalidationScope []byte       `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
 	Address           string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	Payload           []byte       `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -436,7 +891,7 @@ func (m *ContractCallTx) Reset()         { *m = ContractCallTx{} }
 func (m *ContractCallTx) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTx) ProtoMessage()    {}
 func (*ContractCallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{6}
+	return fileDescriptor_1715a041eadeb531, []int{11}
 }
 func (m *ContractCallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +976,187 @@ func (m *ContractCallTx) GetHeight() uint64 {
 	return 0
 }
 
+// OutgoingTxExecution records when and under which signer set an outgoing tx
+// was executed on Ethereum
+type OutgoingTxExecution struct {
+	// nonce of the event reporting the execution
+	EventNonce     uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,2,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// height at which the event was observed
+	CosmosHeight uint64 `protobuf:"varint,3,opt,name=cosmos_height,json=cosmosHeight,proto3" json:"cosmos_height,omitempty"`
+	// nonce of the last observed signer set when the tx was executed, the one
+	// whose signatures the bridge contract checked
+	SignerSetNonce uint64 `protobuf:"varint,4,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+}
+
+func (m *OutgoingTxExecution) Reset()         { *m = OutgoingTxExecution{} }
+func (m *OutgoingTxExecution) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxExecution) ProtoMessage()    {}
+func (*OutgoingTxExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{12}
+}
+func (m *OutgoingTxExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxExecution.Merge(m, src)
+}
+func (m *OutgoingTxExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxExecution proto.InternalMessageInfo
+
+func (m *OutgoingTxExecution) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *OutgoingTxExecution) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *OutgoingTxExecution) GetCosmosHeight() uint64 {
+	if m != nil {
+		return m.CosmosHeight
+	}
+	return 0
+}
+
+func (m *OutgoingTxExecution) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+// ExecutedBatchTx is a batch kept in the archive after it was executed
+type ExecutedBatchTx struct {
+	Batch     BatchTx             `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	Execution OutgoingTxExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution"`
+}
+
+func (m *ExecutedBatchTx) Reset()         { *m = ExecutedBatchTx{} }
+func (m *ExecutedBatchTx) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchTx) ProtoMessage()    {}
+func (*ExecutedBatchTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{13}
+}
+func (m *ExecutedBatchTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedBatchTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchTx.Merge(m, src)
+}
+func (m *ExecutedBatchTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedBatchTx proto.InternalMessageInfo
+
+func (m *ExecutedBatchTx) GetBatch() BatchTx {
+	if m != nil {
+		return m.Batch
+	}
+	return BatchTx{}
+}
+
+func (m *ExecutedBatchTx) GetExecution() OutgoingTxExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return OutgoingTxExecution{}
+}
+
+// ExecutedContractCallTx is a contract call kept in the archive after it was
+// executed
+type ExecutedContractCallTx struct {
+	LogicCall ContractCallTx      `protobuf:"bytes,1,opt,name=logic_call,json=logicCall,proto3" json:"logic_call"`
+	Execution OutgoingTxExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution"`
+}
+
+func (m *ExecutedContractCallTx) Reset()         { *m = ExecutedContractCallTx{} }
+func (m *ExecutedContractCallTx) String() string { return proto.CompactTextString(m) }
+func (*ExecutedContractCallTx) ProtoMessage()    {}
+func (*ExecutedContractCallTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{14}
+}
+func (m *ExecutedContractCallTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedContractCallTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedContractCallTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedContractCallTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedContractCallTx.Merge(m, src)
+}
+func (m *ExecutedContractCallTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedContractCallTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedContractCallTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedContractCallTx proto.InternalMessageInfo
+
+func (m *ExecutedContractCallTx) GetLogicCall() ContractCallTx {
+	if m != nil {
+		return m.LogicCall
+	}
+	return ContractCallTx{}
+}
+
+func (m *ExecutedContractCallTx) GetExecution() OutgoingTxExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return OutgoingTxExecution{}
+}
+
 type ERC20Token struct {
 	Contract string                                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -530,7 +1166,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{7}
+	return fileDescriptor_1715a041eadeb531, []int{15}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +1210,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{8}
+	return fileDescriptor_1715a041eadeb531, []int{16}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,6 +1246,205 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
+// BadSignatureEvidence records that a signature by the Ethereum address over
+// the checkpoint was punished, and the height it was punished at
+type BadSignatureEvidence struct {
+	Checkpoint      []byte `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	EthereumAddress string `protobuf:"bytes,2,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	Height          uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BadSignatureEvidence) Reset()         { *m = BadSignatureEvidence{} }
+func (m *BadSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*BadSignatureEvidence) ProtoMessage()    {}
+func (*BadSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{17}
+}
+func (m *BadSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadSignatureEvidence.Merge(m, src)
+}
+func (m *BadSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadSignatureEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadSignatureEvidence proto.InternalMessageInfo
+
+func (m *BadSignatureEvidence) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *BadSignatureEvidence) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+func (m *BadSignatureEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PastEthereumSignatureCheckpoint is a checkpoint the chain produced for the
+// outgoing tx of the given type and nonce, along with the height it was
+// produced at
+type PastEthereumSignatureCheckpoint struct {
+	// store index prefix byte of the outgoing tx type
+	TxType     uint32 `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	Nonce      uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Checkpoint []byte `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Height     uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PastEthereumSignatureCheckpoint) Reset()         { *m = PastEthereumSignatureCheckpoint{} }
+func (m *PastEthereumSignatureCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*PastEthereumSignatureCheckpoint) ProtoMessage()    {}
+func (*PastEthereumSignatureCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{18}
+}
+func (m *PastEthereumSignatureCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PastEthereumSignatureCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PastEthereumSignatureCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PastEthereumSignatureCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PastEthereumSignatureCheckpoint.Merge(m, src)
+}
+func (m *PastEthereumSignatureCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PastEthereumSignatureCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PastEthereumSignatureCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PastEthereumSignatureCheckpoint proto.InternalMessageInfo
+
+func (m *PastEthereumSignatureCheckpoint) GetTxType() uint32 {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *PastEthereumSignatureCheckpoint) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PastEthereumSignatureCheckpoint) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *PastEthereumSignatureCheckpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PastEthereumSignatureCheckpointFloors holds, for each outgoing tx type, the
+// nonce at or below which the checkpoints were produced before the chain
+// indexed them, or have since been pruned. Signatures over those checkpoints
+// can't be told apart from bad ones so they are never accepted as bad
+// signature evidence.
+type PastEthereumSignatureCheckpointFloors struct {
+	SignerSetNonce                uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	BatchNonce                    uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	ContractCallInvalidationNonce uint64 `protobuf:"varint,3,opt,name=contract_call_invalidation_nonce,json=contractCallInvalidationNonce,proto3" json:"contract_call_invalidation_nonce,omitempty"`
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) Reset()         { *m = PastEthereumSignatureCheckpointFloors{} }
+func (m *PastEthereumSignatureCheckpointFloors) String() string { return proto.CompactTextString(m) }
+func (*PastEthereumSignatureCheckpointFloors) ProtoMessage()    {}
+func (*PastEthereumSignatureCheckpointFloors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{19}
+}
+func (m *PastEthereumSignatureCheckpointFloors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PastEthereumSignatureCheckpointFloors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PastEthereumSignatureCheckpointFloors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PastEthereumSignatureCheckpointFloors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PastEthereumSignatureCheckpointFloors.Merge(m, src)
+}
+func (m *PastEthereumSignatureCheckpointFloors) XXX_Size() int {
+	return m.Size()
+}
+func (m *PastEthereumSignatureCheckpointFloors) XXX_DiscardUnknown() {
+	xxx_messageInfo_PastEthereumSignatureCheckpointFloors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PastEthereumSignatureCheckpointFloors proto.InternalMessageInfo
+
+func (m *PastEthereumSignatureCheckpointFloors) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) GetContractCallInvalidationNonce() uint64 {
+	if m != nil {
+		return m.ContractCallInvalidationNonce
+	}
+	return 0
+}
+
 type CommunityPoolEthereumSpendProposal struct {
 	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -621,7 +1456,7 @@ type CommunityPoolEthereumSpendProposal struct {
 func (m *CommunityPoolEthereumSpendProposal) Reset()      { *m = CommunityPoolEthereumSpendProposal{} }
 func (*CommunityPoolEthereumSpendProposal) ProtoMessage() {}
 func (*CommunityPoolEthereumSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{9}
+	return fileDescriptor_1715a041eadeb531, []int{20}
 }
 func (m *CommunityPoolEthereumSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +1502,7 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Reset() {
 func (m *CommunityPoolEthereumSpendProposalForCLI) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolEthereumSpendProposalForCLI) ProtoMessage()    {}
 func (*CommunityPoolEthereumSpendProposalForCLI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{10}
+	return fileDescriptor_1715a041eadeb531, []int{21}
 }
 func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,98 +1531,246 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
-	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
-	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
-	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
-	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
-	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
-	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
-	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
-	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+// ForceSignerSetTxProposal creates a new signer set tx when it passes, for
+// instance to resume the bridge after an incident
+type ForceSignerSetTxProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
-
-var fileDescriptor_1715a041eadeb531 = []byte{
-	// 1046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x36, 0x6d, 0x26, 0x69, 0xb6, 0x1d, 0xca, 0xe2, 0x56, 0x28, 0x8e, 0x8c, 0x58,
-	0xb2, 0x12, 0xb5, 0xdb, 0xec, 0x4a, 0x40, 0xd1, 0xae, 0xb4, 0x0e, 0x5b, 0x51, 0x69, 0x85, 0x16,
-	0xb7, 0x70, 0xe0, 0x52, 0x39, 0xf6, 0xab, 0x63, 0xea, 0x78, 0x2c, 0x7b, 0x12, 0x9a, 0x23, 0x17,
-	0x84, 0x38, 0x71, 0xe4, 0xd8, 0x33, 0x67, 0x8e, 0xdc, 0xb8, 0xac, 0x38, 0xed, 0x11, 0x38, 0x04,
-	0x68, 0x2f, 0x9c, 0xf3, 0x17, 0x20, 0xcf, 0x0f, 0xd7, 0xde, 0x5d, 0x51, 0x4e, 0x9d, 0xf7, 0xde,
-	0xf7, 0xbd, 0x79, 0xf3, 0xcd, 0x57, 0x4f, 0x90, 0xea, 0x27, 0xce, 0x34, 0xa0, 0x33, 0x73, 0xba,
-	0x67, 0x8a, 0xa5, 0x11, 0x27, 0x84, 0x12, 0x8c, 0x64, 0x38, 0xdd, 0xdb, 0xee, 0xb8, 0x24, 0x1d,
-	0x93, 0xd4, 0x1c, 0x3a, 0x29, 0x98, 0xd3, 0xbd, 0x21, 0x50, 0x67, 0xcf, 0x74, 0x49, 0x10, 0x71,
-	0xec, 0xf6, 0x16, 0xaf, 0x9f, 0xb0, 0xc8, 0xe4, 0x81, 0x28, 0x6d, 0xfa, 0xc4, 0x27, 0x3c, 0x9f,
-	0xad, 0x24, 0xc1, 0x27, 0xc4, 0x0f, 0xc1, 0x64, 0xd1, 0x70, 0x72, 0x6a, 0x3a, 0x91, 0xd8, 0x57,
-	0xff, 0x4e, 0x41, 0x6f, 0x3c, 0xa6, 0x23, 0x48, 0x60, 0x32, 0x7e, 0x3c, 0x85, 0x88, 0x7e, 0x4e,
-	0x28, 0xd8, 0xe0, 0x92, 0xc4, 0xc3, 0x0f, 0xd0, 0x32, 0x64, 0x29, 0x55, 0xe9, 0x2a, 0xbd, 0x66,
-	0x7f, 0xd3, 0xe0, 0x6d, 0x0c, 0xd9, 0xc6, 0x78, 0x14, 0xcd, 0xac, 0x8d, 0x5f, 0x7f, 0xda, 0x59,
-	0x2b, 0x75, 0xb0, 0x39, 0x0b, 0x6f, 0xa2, 0xe5, 0x29, 0xa1, 0x90, 0xaa, 0xd5, 0x6e, 0xad, 0xd7,
-	0xb0, 0x79, 0x80, 0xb7, 0xd1, 0xaa, 0xe3, 0xba, 0x10, 0x53, 0xf0, 0xd4, 0x5a, 0x57, 0xe9, 0xad,
-	0xda, 0x79, 0xac, 0x07, 0x68, 0xeb, 0x89, 0x43, 0x21, 0xa5, 0xb2, 0x9f, 0x15, 0x12, 0xf7, 0xec,
-	0x63, 0x08, 0xfc, 0x11, 0xc5, 0xef, 0xa0, 0x5b, 0x20, 0xd2, 0x27, 0x23, 0x96, 0x62, 0x73, 0x2d,
-	0xd9, 0x6d, 0x99, 0x16, 0xc0, 0xb7, 0xd0, 0x9a, 0x10, 0x48, 0xc0, 0xaa, 0x0c, 0xd6, 0xe2, 0x49,
-	0x0e, 0xd2, 0x3f, 0x45, 0x6d, 0xb9, 0xc9, 0x51, 0xe0, 0x47, 0x90, 0x64, 0xe3, 0xc6, 0xe4, 0x2b,
-	0x48, 0x44, 0x57, 0x1e, 0xe0, 0xbb, 0x68, 0x3d, 0xdf, 0xd5, 0xf1, 0xbc, 0x04, 0xd2, 0x94, 0xf5,
-	0x6b, 0xd8, 0xf9, 0x34, 0x8f, 0x78, 0x5a, 0xff, 0x46, 0x41, 0x4d, 0xde, 0xeb, 0x08, 0xe8, 0xf1,
-	0x79, 0xd6, 0x30, 0x22, 0x91, 0x0b, 0xb2, 0x21, 0x0b, 0xf0, 0x6d, 0x54, 0x2f, 0x8d, 0x25, 0x22,
-	0x7c, 0x88, 0x56, 0x52, 0x46, 0x4e, 0xd5, 0x5a, 0xb7, 0xd6, 0x6b, 0xf6, 0xb7, 0x8d, 0x6b, 0x4b,
-	0x18, 0xe5, 0x59, 0xad, 0xd7, 0x7e, 0xfc, 0x53, 0xbb, 0x55, 0xce, 0xa5, 0xb6, 0xe4, 0xeb, 0xbf,
-	0x28, 0x68, 0xc5, 0x72, 0xa8, 0x3b, 0x3a, 0x3e, 0xc7, 0x1a, 0x6a, 0x0e, 0xb3, 0xe5, 0x49, 0x71,
-	0x14, 0xc4, 0x52, 0x9f, 0xb0, 0x79, 0x54, 0xb4, 0x42, 0x83, 0x31, 0x90, 0x89, 0x1c, 0x48, 0x86,
-	0xf8, 0x21, 0x6a, 0xd1, 0xc4, 0x89, 0x52, 0xc7, 0xa5, 0x01, 0x89, 0x5e, 0x39, 0xd6, 0x11, 0x44,
-	0xde, 0x31, 0x91, 0x83, 0xd8, 0x25, 0x3c, 0x7e, 0x1b, 0xb5, 0x29, 0x39, 0x83, 0xe8, 0xc4, 0x25,
-	0x11, 0x4d, 0x1c, 0x97, 0xaa, 0x4b, 0x4c, 0xb8, 0x35, 0x96, 0x1d, 0x88, 0x64, 0x41, 0x90, 0xe5,
-	0xa2, 0x20, 0xfa, 0xdf, 0x0a, 0x6a, 0x97, 0xfb, 0xe3, 0x36, 0xaa, 0x06, 0x9e, 0x38, 0x43, 0x35,
-	0xf0, 0x32, 0x6a, 0x0a, 0x91, 0x07, 0x89, 0xb8, 0x12, 0x11, 0xe1, 0x1d, 0x84, 0xf3, 0x4b, 0x4b,
-	0xc0, 0x0d, 0xe2, 0x20, 0x73, 0x71, 0x8d, 0x61, 0x36, 0x64, 0xc5, 0x96, 0x05, 0xfc, 0x00, 0x35,
-	0x21, 0x71, 0xfb, 0xbb, 0x27, 0x6c, 0x30, 0x36, 0x65, 0xb3, 0x7f, 0xbb, 0x24, 0xbf, 0x3d, 0xe8,
-	0xef, 0x1e, 0x67, 0x55, 0x6b, 0xe9, 0xd9, 0x5c, 0xab, 0xd8, 0x88, 0x11, 0x58, 0x06, 0x7f, 0x80,
-	0x1a, 0x9c, 0x7e, 0x0a, 0xc0, 0xce, 0x70, 0x13, 0x79, 0x95, 0xc1, 0x0f, 0x00, 0xf4, 0x9f, 0xab,
-	0xa8, 0x2d, 0x85, 0x18, 0x38, 0x61, 0x78, 0x7c, 0x9e, 0xcd, 0x1e, 0x44, 0x53, 0x27, 0x0c, 0x3c,
-	0x27, 0x93, 0xb1, 0x74, 0x6f, 0x1b, 0xc5, 0x0a, 0xbf, 0xbe, 0x17, 0xe1, 0xa9, 0x4b, 0x62, 0x60,
-	0x72, 0xb4, 0xca, 0xf0, 0xa3, 0xac, 0x90, 0xdd, 0xb6, 0x74, 0x31, 0x97, 0x43, 0x86, 0x59, 0x25,
-	0x76, 0x66, 0x21, 0x71, 0x3c, 0x26, 0x40, 0xcb, 0x96, 0x61, 0xd1, 0x21, 0xcb, 0x65, 0x87, 0xdc,
-	0x47, 0x75, 0x26, 0x59, 0xaa, 0xd6, 0x99, 0x37, 0xfe, 0xfb, 0xd8, 0x02, 0x8b, 0x77, 0xd1, 0xd2,
-	0x29, 0x40, 0xaa, 0xae, 0xfc, 0x0f, 0x0e, 0x43, 0x16, 0x2c, 0xb2, 0x5a, 0xb2, 0x48, 0x8c, 0xd0,
-	0x35, 0x23, 0xfb, 0xb2, 0xe4, 0x4e, 0x53, 0xd8, 0xe1, 0xf2, 0x18, 0x1f, 0xa0, 0xba, 0x33, 0x26,
-	0x93, 0x88, 0x9b, 0xbc, 0x61, 0x19, 0x59, 0xf7, 0x3f, 0xe6, 0xda, 0x1d, 0x3f, 0xa0, 0xa3, 0xc9,
-	0xd0, 0x70, 0xc9, 0x58, 0x7c, 0x48, 0xc5, 0x9f, 0x9d, 0xd4, 0x3b, 0x33, 0xe9, 0x2c, 0x86, 0xd4,
-	0x38, 0x8c, 0xa8, 0x2d, 0xd8, 0xfa, 0x16, 0x5a, 0x3e, 0xfc, 0xe8, 0x08, 0x28, 0x5e, 0x47, 0xb5,
-	0xc0, 0x4b, 0x55, 0xa5, 0x5b, 0xeb, 0x2d, 0xd9, 0xd9, 0x52, 0xff, 0xba, 0x8a, 0xf4, 0x01, 0x19,
-	0x8f, 0x27, 0x51, 0x40, 0x67, 0x4f, 0x09, 0x09, 0xf3, 0xff, 0xcf, 0x18, 0x22, 0xef, 0x69, 0x42,
-	0x62, 0x92, 0x3a, 0x61, 0xf6, 0x55, 0xa0, 0x01, 0x0d, 0x41, 0x8c, 0xc8, 0x03, 0xdc, 0x45, 0x4d,
-	0x0f, 0x52, 0x37, 0x09, 0xe2, 0xec, 0xae, 0x84, 0x9d, 0x8b, 0x29, 0xfc, 0x26, 0x6a, 0xbc, 0x68,
-	0xe5, 0xeb, 0x04, 0x7e, 0x2f, 0x3f, 0x1f, 0x77, 0xef, 0x96, 0x21, 0x9e, 0x85, 0xec, 0x0d, 0x31,
-	0xc4, 0x1b, 0x62, 0x0c, 0x48, 0x90, 0x5f, 0x06, 0x87, 0xe3, 0x87, 0x08, 0x0d, 0x93, 0xc0, 0xf3,
-	0xa1, 0xe0, 0xde, 0x1b, 0xc9, 0x0d, 0x4e, 0x39, 0x00, 0xd8, 0x6f, 0x7d, 0x7b, 0xa1, 0x55, 0x7e,
-	0xb8, 0xd0, 0x2a, 0xff, 0x5c, 0x68, 0x15, 0xfd, 0xf7, 0x2a, 0xea, 0xdd, 0xac, 0xc1, 0x01, 0x49,
-	0x06, 0x4f, 0x0e, 0xf1, 0x9d, 0x92, 0x12, 0xd6, 0xfa, 0x62, 0xae, 0xb5, 0x66, 0xce, 0x38, 0xdc,
-	0xd7, 0x59, 0x5a, 0x97, 0xda, 0xbc, 0xff, 0x0a, 0x6d, 0xac, 0xdb, 0x8b, 0xb9, 0x86, 0x39, 0xba,
-	0x50, 0xd4, 0xcb, 0x9a, 0xf5, 0x5f, 0xd2, 0xcc, 0xda, 0x5c, 0xcc, 0xb5, 0x75, 0xce, 0xcb, 0x4b,
-	0x7a, 0x51, 0xc9, 0xbb, 0x25, 0x25, 0x1b, 0xd6, 0xc6, 0x62, 0xae, 0xad, 0x71, 0x82, 0xf0, 0x40,
-	0xae, 0xdd, 0xfd, 0x97, 0xb4, 0x6b, 0x58, 0xaf, 0x2f, 0xe6, 0xda, 0x06, 0x87, 0x5f, 0xd7, 0xf4,
-	0x82, 0x62, 0xf8, 0x5d, 0xb4, 0xe2, 0x41, 0x4c, 0xd2, 0x80, 0xaa, 0x75, 0x46, 0xc1, 0x8b, 0xb9,
-	0xd6, 0x96, 0x47, 0x61, 0x05, 0xdd, 0x96, 0x90, 0xfd, 0x55, 0xa1, 0xaf, 0x62, 0x7d, 0xf6, 0xec,
-	0xb2, 0xa3, 0x3c, 0xbf, 0xec, 0x28, 0x7f, 0x5d, 0x76, 0x94, 0xef, 0xaf, 0x3a, 0x95, 0xe7, 0x57,
-	0x9d, 0xca, 0x6f, 0x57, 0x9d, 0xca, 0x17, 0x1f, 0x16, 0x4c, 0x1c, 0x83, 0xef, 0xcf, 0xbe, 0x9c,
-	0xca, 0x5f, 0x17, 0x3b, 0x7c, 0x5f, 0x73, 0x4c, 0xbc, 0x49, 0x08, 0xe6, 0xf4, 0x9e, 0x79, 0x2e,
-	0x4b, 0xdc, 0xdd, 0xc3, 0x3a, 0x7b, 0xcd, 0xef, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x80,
-	0xb2, 0x8e, 0x9b, 0x08, 0x00, 0x00,
+func (m *ForceSignerSetTxProposal) Reset()      { *m = ForceSignerSetTxProposal{} }
+func (*ForceSignerSetTxProposal) ProtoMessage() {}
+func (*ForceSignerSetTxProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{22}
 }
-
-func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForceSignerSetTxProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceSignerSetTxProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceSignerSetTxProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceSignerSetTxProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceSignerSetTxProposal.Merge(m, src)
+}
+func (m *ForceSignerSetTxProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceSignerSetTxProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceSignerSetTxProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceSignerSetTxProposal proto.InternalMessageInfo
+
+// This format of the force signer set tx proposal is specifically for the CLI
+// to allow simple text serialization.
+type ForceSignerSetTxProposalForCLI struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Deposit     string `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *ForceSignerSetTxProposalForCLI) Reset()         { *m = ForceSignerSetTxProposalForCLI{} }
+func (m *ForceSignerSetTxProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*ForceSignerSetTxProposalForCLI) ProtoMessage()    {}
+func (*ForceSignerSetTxProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{23}
+}
+func (m *ForceSignerSetTxProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceSignerSetTxProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceSignerSetTxProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceSignerSetTxProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceSignerSetTxProposalForCLI.Merge(m, src)
+}
+func (m *ForceSignerSetTxProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceSignerSetTxProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceSignerSetTxProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceSignerSetTxProposalForCLI proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gravity.v1.SignerSetTxReason", SignerSetTxReason_name, SignerSetTxReason_value)
+	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
+	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
+	proto.RegisterType((*ValidatorBridgeStats)(nil), "gravity.v1.ValidatorBridgeStats")
+	proto.RegisterType((*BridgeStatsEpoch)(nil), "gravity.v1.BridgeStatsEpoch")
+	proto.RegisterType((*EthereumKeyRotation)(nil), "gravity.v1.EthereumKeyRotation")
+	proto.RegisterType((*ValidatorRewards)(nil), "gravity.v1.ValidatorRewards")
+	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
+	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
+	proto.RegisterType((*ObservedSignerSet)(nil), "gravity.v1.ObservedSignerSet")
+	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
+	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
+	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
+	proto.RegisterType((*OutgoingTxExecution)(nil), "gravity.v1.OutgoingTxExecution")
+	proto.RegisterType((*ExecutedBatchTx)(nil), "gravity.v1.ExecutedBatchTx")
+	proto.RegisterType((*ExecutedContractCallTx)(nil), "gravity.v1.ExecutedContractCallTx")
+	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+	proto.RegisterType((*BadSignatureEvidence)(nil), "gravity.v1.BadSignatureEvidence")
+	proto.RegisterType((*PastEthereumSignatureCheckpoint)(nil), "gravity.v1.PastEthereumSignatureCheckpoint")
+	proto.RegisterType((*PastEthereumSignatureCheckpointFloors)(nil), "gravity.v1.PastEthereumSignatureCheckpointFloors")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+	proto.RegisterType((*ForceSignerSetTxProposal)(nil), "gravity.v1.ForceSignerSetTxProposal")
+	proto.RegisterType((*ForceSignerSetTxProposalForCLI)(nil), "gravity.v1.ForceSignerSetTxProposalForCLI")
+}
+
+func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
+
+var fileDescriptor_1715a041eadeb531 = []byte{
+	// 1916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0x5b, 0xb6, 0x9e, 0x6c, 0xad, 0x3c, 0xf6, 0x3a, 0xda, 0x45, 0x22, 0x39, 0x4c,
+	0x36, 0x75, 0xd2, 0xac, 0x64, 0x3b, 0x09, 0x9a, 0x6e, 0x91, 0x14, 0xa6, 0x96, 0xf6, 0x0a, 0xd9,
+	0x48, 0x2e, 0xa5, 0xdd, 0xa6, 0xbd, 0xb0, 0x14, 0x39, 0x2b, 0xb3, 0x4b, 0x71, 0x08, 0x72, 0xa4,
+	0x48, 0xc7, 0x9e, 0x9a, 0x63, 0xd1, 0x53, 0x2f, 0x2d, 0x16, 0x3d, 0x06, 0x45, 0x4f, 0x45, 0x0f,
+	0xfd, 0x38, 0xf5, 0x12, 0xf4, 0x94, 0x43, 0x51, 0xa4, 0x3d, 0x38, 0xc5, 0xee, 0xa5, 0x67, 0xff,
+	0x05, 0x05, 0xe7, 0x43, 0x26, 0x6d, 0x39, 0x6b, 0x23, 0x45, 0x4e, 0xe6, 0xbc, 0xaf, 0x79, 0xf3,
+	0x7b, 0xbf, 0x79, 0x6f, 0x64, 0x28, 0xf7, 0x43, 0x6b, 0xe4, 0xd2, 0x49, 0x7d, 0xb4, 0x53, 0x17,
+	0x9f, 0xb5, 0x20, 0x24, 0x94, 0x20, 0x90, 0xcb, 0xd1, 0xce, 0xcd, 0x8a, 0x4d, 0xa2, 0x01, 0x89,
+	0xea, 0x3d, 0x2b, 0xc2, 0xf5, 0xd1, 0x4e, 0x0f, 0x53, 0x6b, 0xa7, 0x6e, 0x13, 0xd7, 0xe7, 0xb6,
+	0x37, 0x6f, 0x70, 0xbd, 0xc9, 0x56, 0x75, 0xbe, 0x10, 0xaa, 0xf5, 0x3e, 0xe9, 0x13, 0x2e, 0x8f,
+	0xbf, 0xa4, 0x43, 0x9f, 0x90, 0xbe, 0x87, 0xeb, 0x6c, 0xd5, 0x1b, 0x3e, 0xaa, 0x5b, 0xbe, 0xd8,
+	0x57, 0xfd, 0xad, 0x02, 0x2f, 0xe8, 0xf4, 0x08, 0x87, 0x78, 0x38, 0xd0, 0x47, 0xd8, 0xa7, 0x0f,
+	0x09, 0xc5, 0x06, 0xb6, 0x49, 0xe8, 0xa0, 0xf7, 0x60, 0x01, 0xc7, 0xa2, 0xb2, 0xb2, 0xa9, 0x6c,
+	0x15, 0x76, 0xd7, 0x6b, 0x3c, 0x4c, 0x4d, 0x86, 0xa9, 0xed, 0xf9, 0x13, 0x6d, 0xf5, 0xef, 0x7f,
+	0xb8, 0xbd, 0x92, 0x8a, 0x60, 0x70, 0x2f, 0xb4, 0x0e, 0x0b, 0x23, 0x42, 0x71, 0x54, 0xce, 0x6c,
+	0x66, 0xb7, 0xf2, 0x06, 0x5f, 0xa0, 0x9b, 0xb0, 0x64, 0xd9, 0x36, 0x0e, 0x28, 0x76, 0xca, 0xd9,
+	0x4d, 0x65, 0x6b, 0xc9, 0x98, 0xae, 0xd1, 0x06, 0xe4, 0x8e, 0xb0, 0xdb, 0x3f, 0xa2, 0xe5, 0xf9,
+	0x4d, 0x65, 0x6b, 0xde, 0x10, 0x2b, 0xd5, 0x85, 0x1b, 0xf7, 0x2d, 0x8a, 0x23, 0x2a, 0xf7, 0xd1,
+	0x3c, 0x62, 0x3f, 0xbe, 0xc7, 0x94, 0xe8, 0x5b, 0x70, 0x0d, 0x0b, 0xb1, 0x29, 0xbc, 0x15, 0xe6,
+	0x5d, 0x94, 0x62, 0x61, 0xf8, 0x0a, 0xac, 0x08, 0xe0, 0x84, 0x59, 0x86, 0x99, 0x2d, 0x73, 0x21,
+	0x37, 0x52, 0xff, 0x98, 0x85, 0xf5, 0x87, 0x96, 0xe7, 0x3a, 0x16, 0x25, 0xa1, 0x16, 0xba, 0x4e,
+	0x1f, 0x77, 0xa8, 0x45, 0x23, 0xf4, 0x6d, 0x58, 0x1d, 0x49, 0xb9, 0x69, 0x39, 0x4e, 0x88, 0xa3,
+	0x88, 0x6d, 0x94, 0x37, 0x4a, 0x53, 0xc5, 0x1e, 0x97, 0xa3, 0x1d, 0x58, 0x8f, 0xdc, 0xbe, 0x8f,
+	0x1d, 0xd3, 0x26, 0xfe, 0x23, 0x37, 0x1c, 0x58, 0xd4, 0x25, 0x7e, 0x24, 0x76, 0x5c, 0xe3, 0xba,
+	0x46, 0x52, 0x85, 0xde, 0x81, 0x0d, 0x3c, 0x0e, 0xb0, 0x4d, 0xcf, 0x39, 0x65, 0x99, 0xd3, 0x75,
+	0xa9, 0x4d, 0xbb, 0x55, 0xa1, 0xc0, 0xd0, 0x36, 0x39, 0xd4, 0x1c, 0x37, 0xc0, 0xb2, 0x92, 0x51,
+	0x0c, 0x0f, 0xe9, 0x45, 0x38, 0x1c, 0x61, 0xc7, 0x64, 0xe2, 0xa8, 0xbc, 0xc0, 0xe1, 0x91, 0x62,
+	0x56, 0xb4, 0x08, 0x3d, 0x80, 0x92, 0x67, 0x45, 0x54, 0x80, 0xc3, 0xe2, 0x95, 0x73, 0xac, 0xf0,
+	0xb7, 0x6a, 0xa7, 0xe4, 0xac, 0x5d, 0x58, 0x08, 0x6d, 0xfe, 0xb3, 0xe3, 0xea, 0x9c, 0x51, 0x8c,
+	0x83, 0x70, 0x49, 0x9c, 0x00, 0x7a, 0x13, 0x10, 0x0e, 0x88, 0x7d, 0x64, 0x46, 0xd4, 0x0a, 0x65,
+	0xf4, 0xf2, 0x22, 0x4b, 0xa1, 0xc4, 0x34, 0x9d, 0x58, 0x21, 0x6a, 0xf4, 0x2e, 0x94, 0xcf, 0x64,
+	0x6b, 0xc6, 0xb7, 0xc0, 0x73, 0x7d, 0x5c, 0x5e, 0x62, 0x3e, 0x1b, 0xe9, 0xb4, 0x35, 0xa1, 0x55,
+	0x7f, 0xa9, 0x40, 0x29, 0x51, 0x2f, 0x3d, 0x8e, 0x8c, 0x5e, 0x86, 0xe5, 0xd4, 0xb6, 0x9c, 0x18,
+	0x85, 0x28, 0xb1, 0xe3, 0x0c, 0x7c, 0x32, 0x33, 0xf1, 0xd9, 0x85, 0xeb, 0x3c, 0xd6, 0x59, 0xf3,
+	0xac, 0x28, 0x6a, 0xac, 0x6c, 0xa7, 0x7c, 0xd4, 0x2f, 0x14, 0x58, 0x93, 0x50, 0x7d, 0x80, 0x27,
+	0x06, 0xa1, 0xac, 0x6c, 0x57, 0x23, 0xd3, 0x36, 0xac, 0x13, 0xcf, 0x31, 0xa7, 0x24, 0x97, 0xf6,
+	0x19, 0x66, 0x8f, 0x88, 0xe7, 0xc8, 0x2d, 0xa4, 0x47, 0x8c, 0xa2, 0xe7, 0x98, 0x24, 0xb4, 0x8f,
+	0x70, 0x44, 0xc3, 0xd4, 0x2e, 0x59, 0xe6, 0xb5, 0x41, 0x3c, 0xa7, 0x9d, 0x50, 0x4b, 0xcf, 0x2d,
+	0x28, 0x31, 0x72, 0x86, 0x66, 0x84, 0xa9, 0xe9, 0x13, 0xdf, 0xc6, 0x82, 0x53, 0x45, 0x2e, 0xef,
+	0x60, 0xda, 0x8a, 0xa5, 0xea, 0x3f, 0x14, 0x28, 0x4d, 0x2f, 0x8a, 0x81, 0x3f, 0xb6, 0x42, 0xe7,
+	0x8a, 0x97, 0xe4, 0x55, 0x58, 0x09, 0xac, 0x90, 0xba, 0xb6, 0x1b, 0x30, 0x54, 0x04, 0xee, 0x69,
+	0x21, 0x1a, 0x40, 0xc1, 0x71, 0x23, 0x1a, 0xba, 0xbd, 0x21, 0x6f, 0x19, 0xd9, 0xad, 0xc2, 0xee,
+	0x8d, 0x9a, 0xe8, 0x7a, 0x31, 0x39, 0x6a, 0xa2, 0x45, 0xd6, 0x1a, 0xc4, 0xf5, 0xb5, 0xed, 0x98,
+	0x85, 0x9f, 0x7e, 0x59, 0xdd, 0xea, 0xbb, 0xf4, 0x68, 0xd8, 0xab, 0xd9, 0x64, 0x20, 0x5a, 0xa4,
+	0xf8, 0x73, 0x3b, 0x72, 0x1e, 0xd7, 0xe9, 0x24, 0xc0, 0x11, 0x73, 0x88, 0x8c, 0x64, 0x7c, 0xf5,
+	0x07, 0x50, 0x94, 0x68, 0x76, 0xd8, 0x81, 0xe3, 0x36, 0x16, 0x90, 0x8f, 0x71, 0x28, 0xc8, 0xc3,
+	0x17, 0xe8, 0x75, 0x28, 0x5d, 0x50, 0x90, 0x69, 0x37, 0x12, 0xe7, 0x54, 0xff, 0xaa, 0x40, 0xa1,
+	0x23, 0xc1, 0xeb, 0x8e, 0xe3, 0x80, 0x1c, 0x58, 0x11, 0x90, 0x2d, 0x12, 0xbd, 0x2f, 0x93, 0xec,
+	0x7d, 0xa8, 0x09, 0x8b, 0x1c, 0xf9, 0x48, 0x9c, 0xfd, 0x66, 0xf2, 0x36, 0xa6, 0x73, 0xd5, 0xd6,
+	0x3e, 0xfd, 0xb2, 0x7a, 0x2d, 0x2d, 0x8b, 0x0c, 0xe9, 0x8f, 0xde, 0x81, 0x5c, 0x88, 0xad, 0x88,
+	0xf8, 0xac, 0xa4, 0xc5, 0xdd, 0x97, 0x92, 0x91, 0x12, 0x19, 0x1a, 0xcc, 0xc8, 0x10, 0xc6, 0xea,
+	0x3f, 0x15, 0x58, 0x95, 0xbc, 0x9e, 0x5a, 0xcd, 0x64, 0x8a, 0x32, 0x8b, 0x29, 0xc9, 0x13, 0x64,
+	0xbe, 0xe6, 0x09, 0x66, 0xf4, 0xfa, 0xec, 0xe5, 0x7a, 0xfd, 0xfc, 0x8c, 0x5e, 0xff, 0x37, 0x05,
+	0x16, 0x35, 0x8b, 0xda, 0x47, 0xdd, 0x71, 0xdc, 0x47, 0x7b, 0xf1, 0x67, 0xea, 0x24, 0xc0, 0x44,
+	0xfc, 0x14, 0x65, 0x58, 0xa4, 0xee, 0x00, 0x93, 0xa1, 0x2c, 0x90, 0x5c, 0xa2, 0xf7, 0x61, 0x99,
+	0x86, 0x96, 0x1f, 0x59, 0xb6, 0xec, 0xd7, 0xe7, 0x0e, 0xd9, 0xc1, 0xbe, 0xd3, 0x25, 0xf2, 0x58,
+	0x46, 0xca, 0x1e, 0xdd, 0x82, 0x22, 0x25, 0x8f, 0xb1, 0x1f, 0xb7, 0x7d, 0x1a, 0x5a, 0x36, 0x4f,
+	0x36, 0x6f, 0xac, 0x30, 0x69, 0x43, 0x08, 0x13, 0x04, 0x59, 0x48, 0x0d, 0xc7, 0xdf, 0x65, 0xa0,
+	0x98, 0x8e, 0x8f, 0x8a, 0x90, 0x71, 0x1d, 0x71, 0x86, 0x8c, 0xcb, 0xe6, 0x6a, 0x84, 0x7d, 0x07,
+	0x87, 0x82, 0xa2, 0x62, 0x85, 0x6e, 0x03, 0x9a, 0xc2, 0x19, 0x62, 0xdb, 0x0d, 0x5c, 0xec, 0x73,
+	0x44, 0xf3, 0xc6, 0xaa, 0xd4, 0x18, 0x52, 0x81, 0xde, 0x83, 0x02, 0x0e, 0xed, 0xdd, 0x6d, 0x93,
+	0x25, 0xc6, 0xb2, 0x2c, 0xec, 0x6e, 0xa4, 0x8a, 0x69, 0x34, 0x76, 0xb7, 0xbb, 0xb1, 0x56, 0x4c,
+	0x03, 0x60, 0x0e, 0x4c, 0x82, 0xbe, 0x0b, 0x79, 0xee, 0xfe, 0x08, 0xe3, 0xf2, 0xc2, 0x25, 0x9c,
+	0x97, 0x98, 0xf9, 0x3e, 0x8e, 0x29, 0x74, 0x9d, 0xbb, 0x86, 0xac, 0xd1, 0x98, 0x01, 0x21, 0x1e,
+	0x0b, 0x93, 0xfb, 0xaa, 0x30, 0x06, 0x62, 0x4e, 0xbc, 0x39, 0x1d, 0x12, 0xe2, 0xed, 0x63, 0xac,
+	0xfe, 0x25, 0x03, 0x45, 0x89, 0x69, 0xc3, 0xf2, 0xbc, 0xee, 0x38, 0x86, 0xc1, 0xf5, 0x45, 0x7b,
+	0x72, 0x89, 0x9f, 0xa2, 0xc0, 0x6a, 0x52, 0xc3, 0x99, 0x70, 0xd6, 0x3c, 0xb2, 0x49, 0x80, 0x19,
+	0xb2, 0xcb, 0x69, 0xf3, 0x4e, 0xac, 0x88, 0x89, 0x93, 0xee, 0xbd, 0x72, 0x19, 0x6b, 0x02, 0x6b,
+	0xe2, 0x11, 0xcb, 0x61, 0x58, 0x2e, 0x1b, 0x72, 0x99, 0x24, 0xdb, 0x42, 0x9a, 0x6c, 0x6f, 0x43,
+	0x8e, 0xa1, 0x1f, 0x95, 0x73, 0x9b, 0xd9, 0x8b, 0x8f, 0x2e, 0x10, 0x14, 0xb6, 0x68, 0x1b, 0xe6,
+	0x1f, 0x61, 0x1c, 0x95, 0x17, 0x2f, 0xe1, 0xc3, 0x2c, 0x13, 0x6c, 0x5b, 0x4a, 0xb1, 0xed, 0xf7,
+	0x0a, 0xac, 0xb5, 0x87, 0xb4, 0x4f, 0x5c, 0xbf, 0xdf, 0x1d, 0xeb, 0x63, 0x6c, 0x0f, 0x59, 0x9b,
+	0x9e, 0xbe, 0x43, 0x52, 0xf7, 0x87, 0x89, 0x38, 0x6a, 0x33, 0xae, 0x6e, 0xe6, 0x72, 0x57, 0x37,
+	0x7b, 0xfe, 0xea, 0x5e, 0x61, 0x4e, 0xfd, 0x5c, 0x81, 0x6b, 0x3c, 0x4d, 0xec, 0xc8, 0xcb, 0x5e,
+	0x87, 0x05, 0x76, 0xb3, 0xc5, 0xc3, 0x76, 0x2d, 0x89, 0x87, 0xb0, 0x11, 0x60, 0x70, 0x3b, 0xd4,
+	0x80, 0x3c, 0x96, 0x47, 0x65, 0x69, 0x17, 0x76, 0xab, 0x49, 0xa7, 0x19, 0x88, 0x88, 0x00, 0xa7,
+	0x7e, 0xea, 0x6f, 0x14, 0xd8, 0x90, 0x99, 0x9c, 0x61, 0xe0, 0xf7, 0x01, 0x3c, 0xd2, 0x77, 0x6d,
+	0xd3, 0xb6, 0x3c, 0x4f, 0x64, 0x95, 0x6a, 0x20, 0x69, 0x7b, 0x19, 0x9b, 0xf9, 0xc4, 0xa2, 0xff,
+	0x4f, 0x82, 0x01, 0xc0, 0x29, 0x1b, 0xe2, 0x87, 0xfa, 0xb4, 0x21, 0xf1, 0x11, 0x3e, 0x5d, 0xa3,
+	0x7d, 0xc8, 0x59, 0x03, 0x32, 0xf4, 0x79, 0x0d, 0xf3, 0x5a, 0x2d, 0x0e, 0xf5, 0xef, 0xe3, 0xea,
+	0x6b, 0x97, 0x18, 0xba, 0x4d, 0x9f, 0x1a, 0xc2, 0x5b, 0xbd, 0x01, 0x0b, 0xcd, 0xbb, 0xf1, 0x34,
+	0x29, 0x41, 0xd6, 0x75, 0xe2, 0xa7, 0x42, 0x76, 0x6b, 0xde, 0x88, 0x3f, 0xd5, 0x09, 0xac, 0x6b,
+	0x16, 0x9b, 0x37, 0x16, 0x1d, 0x86, 0x58, 0x1f, 0xb9, 0x0e, 0x8e, 0x79, 0x54, 0x01, 0xb0, 0x8f,
+	0xb0, 0xfd, 0x38, 0x20, 0xae, 0xf8, 0x65, 0xb2, 0x6c, 0x24, 0x24, 0x57, 0x18, 0xcc, 0x09, 0x8e,
+	0x67, 0x53, 0x1c, 0xff, 0x44, 0x81, 0xea, 0xa1, 0x75, 0xfa, 0xc8, 0x9d, 0x26, 0xd1, 0x38, 0xdd,
+	0xe6, 0x05, 0x58, 0xa4, 0x63, 0x33, 0x3e, 0x11, 0xcb, 0x61, 0xc5, 0xc8, 0xd1, 0x71, 0x77, 0x12,
+	0xe0, 0xd3, 0xe9, 0x9e, 0x49, 0x4e, 0xf7, 0x74, 0xd6, 0xd9, 0x73, 0x59, 0x5f, 0xf4, 0xcb, 0xe7,
+	0xcf, 0x0a, 0xdc, 0x7a, 0x4e, 0x2a, 0xfb, 0x1e, 0x21, 0x61, 0x74, 0x85, 0x79, 0x7c, 0x66, 0xd4,
+	0x65, 0xce, 0x8d, 0xba, 0x03, 0xd8, 0x94, 0x95, 0x66, 0x84, 0x34, 0x67, 0x74, 0x47, 0x8e, 0xd8,
+	0x4b, 0x76, 0x82, 0x97, 0xcd, 0xb3, 0x9d, 0x52, 0xfd, 0x59, 0x06, 0xd4, 0x06, 0x19, 0x0c, 0x86,
+	0xbe, 0x4b, 0x27, 0x71, 0x03, 0x9e, 0x1e, 0x23, 0xc0, 0xbe, 0x73, 0x18, 0x92, 0x80, 0x44, 0x96,
+	0x17, 0x43, 0x46, 0x5d, 0xea, 0x61, 0x41, 0x33, 0xbe, 0x40, 0x9b, 0x50, 0x70, 0x70, 0x64, 0x87,
+	0x6e, 0x30, 0x25, 0x75, 0xde, 0x48, 0x8a, 0xd0, 0x8b, 0x90, 0x3f, 0x3b, 0xb5, 0x4e, 0x05, 0xe8,
+	0x3b, 0x53, 0x8e, 0xf2, 0x41, 0xf5, 0x15, 0x6f, 0x46, 0xd1, 0x2c, 0xb9, 0x39, 0x7a, 0x1f, 0xa0,
+	0xc7, 0x7e, 0x48, 0x24, 0x06, 0xd5, 0x73, 0x9d, 0xf3, 0xdc, 0x65, 0x1f, 0xe3, 0x3b, 0xcb, 0x9f,
+	0x3c, 0xa9, 0xce, 0xfd, 0xea, 0x49, 0x75, 0xee, 0xbf, 0x4f, 0xaa, 0x73, 0xea, 0xbf, 0x32, 0xb0,
+	0xf5, 0x7c, 0x0c, 0xf6, 0x49, 0xd8, 0xb8, 0xdf, 0x44, 0xaf, 0xa5, 0x90, 0xd0, 0x4a, 0x27, 0xc7,
+	0xd5, 0xe5, 0x89, 0x35, 0xf0, 0xee, 0xa8, 0x4c, 0xac, 0x4a, 0x6c, 0xde, 0x9d, 0x81, 0x8d, 0xb6,
+	0x71, 0x72, 0x5c, 0x45, 0xdc, 0x3a, 0xa1, 0x54, 0xd3, 0x98, 0xed, 0x9e, 0xc3, 0x4c, 0x5b, 0x3f,
+	0x39, 0xae, 0x96, 0xb8, 0xdf, 0x54, 0xa5, 0x26, 0x91, 0x7c, 0x3d, 0x85, 0x64, 0x5e, 0x5b, 0x3d,
+	0x39, 0xae, 0xae, 0x70, 0x07, 0x71, 0x8f, 0xa7, 0xd8, 0xbd, 0x7d, 0x0e, 0xbb, 0xbc, 0x76, 0xfd,
+	0xe4, 0xb8, 0xba, 0xca, 0xcd, 0x4f, 0x75, 0x6a, 0x02, 0x31, 0xf4, 0x26, 0x2c, 0x3a, 0x38, 0x20,
+	0x91, 0x4b, 0xd9, 0x40, 0xcf, 0x6b, 0xe8, 0xe4, 0xb8, 0x5a, 0x94, 0x47, 0x61, 0x0a, 0xd5, 0x90,
+	0x26, 0x77, 0x96, 0x04, 0xbe, 0x8a, 0xfa, 0x13, 0x28, 0xef, 0x93, 0xd0, 0xc6, 0x89, 0xb7, 0xeb,
+	0xd7, 0x25, 0xd5, 0x99, 0xea, 0xfd, 0x49, 0x81, 0xca, 0x45, 0x5b, 0x7c, 0x63, 0x35, 0x4b, 0xc0,
+	0x93, 0xbd, 0x02, 0x3c, 0x6f, 0xfc, 0x3a, 0x03, 0xab, 0xe7, 0x9e, 0xf5, 0xe8, 0x55, 0xd8, 0xec,
+	0x34, 0x0f, 0x5a, 0xba, 0x61, 0x76, 0xf4, 0xae, 0xd9, 0xfd, 0xc8, 0x34, 0xf4, 0xbd, 0x4e, 0xbb,
+	0x65, 0x3e, 0x68, 0x75, 0x0e, 0xf5, 0x46, 0x73, 0xbf, 0xa9, 0xdf, 0x2d, 0xcd, 0xa1, 0x4d, 0x78,
+	0x71, 0xa6, 0x55, 0xb3, 0xd5, 0xec, 0x36, 0xf7, 0xee, 0x97, 0x14, 0xa4, 0x42, 0xe5, 0x82, 0x38,
+	0x5a, 0xbb, 0x75, 0xb7, 0xd9, 0x3a, 0x28, 0x65, 0xd0, 0x2d, 0x78, 0x79, 0xa6, 0xcd, 0x61, 0xfb,
+	0x87, 0xba, 0x61, 0x36, 0xee, 0xed, 0xb5, 0x0e, 0xf4, 0x52, 0x16, 0xbd, 0x02, 0xd5, 0x99, 0x66,
+	0x07, 0xed, 0x87, 0xba, 0xd1, 0xda, 0x6b, 0x35, 0xf4, 0xd2, 0x3c, 0xaa, 0xc1, 0x1b, 0x33, 0x8d,
+	0xf4, 0xee, 0x3d, 0xdd, 0xd0, 0x1f, 0x7c, 0x68, 0x7e, 0xa0, 0xff, 0xc8, 0x34, 0xda, 0xdd, 0xbd,
+	0x6e, 0xb3, 0xdd, 0x2a, 0x2d, 0x5c, 0x78, 0x82, 0x0f, 0xf7, 0x3e, 0x32, 0xf7, 0x0e, 0xf4, 0x52,
+	0x4e, 0x7b, 0xf0, 0xd9, 0xd3, 0x8a, 0xf2, 0xf9, 0xd3, 0x8a, 0xf2, 0x9f, 0xa7, 0x15, 0xe5, 0x17,
+	0xcf, 0x2a, 0x73, 0x9f, 0x3f, 0xab, 0xcc, 0x7d, 0xf1, 0xac, 0x32, 0xf7, 0xe3, 0xef, 0x25, 0xe6,
+	0x58, 0x80, 0xfb, 0xfd, 0xc9, 0x4f, 0x47, 0xf2, 0xff, 0x75, 0xb7, 0x39, 0x6d, 0xeb, 0x03, 0xe2,
+	0x0c, 0x3d, 0x5c, 0x1f, 0xbd, 0x55, 0x1f, 0x4b, 0x15, 0x1f, 0x70, 0xbd, 0x1c, 0xfb, 0xff, 0xd8,
+	0x5b, 0xff, 0x1b, 0x00, 0xcb, 0xba, 0xc8, 0x55, 0xed, 0x13, 0x00, 0x00,
+}
+
+func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -802,6 +1785,11 @@ func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Accepted {
 		i--
 		if m.Accepted {
@@ -869,7 +1857,7 @@ func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorBridgeStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -879,32 +1867,67 @@ func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorBridgeStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorBridgeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+	if m.ObservedEventsBaseline != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ObservedEventsBaseline))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x40
 	}
-	if m.Power != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EpochStartHeight))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.LastHeightVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ObservedEvents != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ObservedEvents))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EventVotes != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpectedConfirmations != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExpectedConfirmations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedConfirmations != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignedConfirmations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
+func (m *BridgeStatsEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -914,44 +1937,35 @@ func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *BridgeStatsEpoch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BridgeStatsEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.StartObservedEvents != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.StartObservedEvents))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+	if m.ObservedEvents != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ObservedEvents))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+	if m.StartHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.StartHeight))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+func (m *EthereumKeyRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -961,32 +1975,69 @@ func (m *BatchTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthereumKeyRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthereumKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetNonce))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+	if len(m.OldOrchestratorAddress) > 0 {
+		i -= len(m.OldOrchestratorAddress)
+		copy(dAtA[i:], m.OldOrchestratorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.OldOrchestratorAddress)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OldEthereumAddress) > 0 {
+		i -= len(m.OldEthereumAddress)
+		copy(dAtA[i:], m.OldEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.OldEthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -997,20 +2048,22 @@ func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+	if m.Participation != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Participation))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
+func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1020,59 +2073,32 @@ func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
+func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1082,39 +2108,25 @@ func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+	if m.Reason != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Reason))
 		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+		dAtA[i] = 0x20
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1122,44 +2134,23 @@ func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGravity(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x10
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+func (m *ObservedSignerSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1169,37 +2160,49 @@ func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObservedSignerSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObservedSignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.CosmosHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetNonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IDSet) Marshal() (dAtA []byte, err error) {
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1209,38 +2212,56 @@ func (m *IDSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGravity(dAtA, i, uint64(j4))
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
+func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1250,18 +2271,30 @@ func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Erc20RewardPoolFee != nil {
+		{
+			size, err := m.Erc20RewardPoolFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1271,7 +2304,7 @@ func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (
 	i--
 	dAtA[i] = 0x2a
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1280,31 +2313,29 @@ func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
+func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1314,336 +2345,2616 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x40
 	}
-	if len(m.BridgeFee) > 0 {
-		i -= len(m.BridgeFee)
-		copy(dAtA[i:], m.BridgeFee)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGravity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OutgoingTxExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EthereumEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovGravity(uint64(l))
-		}
-	}
-	if m.Accepted {
-		n += 2
-	}
-	return n
+
+func (m *OutgoingTxExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LatestEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OutgoingTxExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.CosmosHeight != 0 {
-		n += 1 + sovGravity(uint64(m.CosmosHeight))
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EthereumSigner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecutedBatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Power != 0 {
-		n += 1 + sovGravity(uint64(m.Power))
+	{
+		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SignerSetTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecutedContractCallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovGravity(uint64(m.Nonce))
-	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	{
+		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	if len(m.Signers) > 0 {
-		for _, e := range m.Signers {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovGravity(uint64(m.BatchNonce))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
-	}
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGravity(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGravity(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Erc20Token.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.Erc20Fee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractCallTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BadSignatureEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovGravity(uint64(m.InvalidationNonce))
-	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	return len(dAtA) - i, nil
+}
+
+func (m *PastEthereumSignatureCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *PastEthereumSignatureCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PastEthereumSignatureCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TxType != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ERC20Token) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PastEthereumSignatureCheckpointFloors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.ContractCallInvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ContractCallInvalidationNonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IDSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovGravity(uint64(e))
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovGravity(uint64(l)) + l
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.BridgeFee) > 0 {
+		i -= len(m.BridgeFee)
+		copy(dAtA[i:], m.BridgeFee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ForceSignerSetTxProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceSignerSetTxProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceSignerSetTxProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Recipient)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceSignerSetTxProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceSignerSetTxProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceSignerSetTxProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGravity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthereumEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
 		n += 1 + l + sovGravity(uint64(l))
 	}
-	l = len(m.Amount)
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LatestEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	return n
+}
+
+func (m *ValidatorBridgeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovGravity(uint64(l))
 	}
-	l = len(m.BridgeFee)
+	if m.SignedConfirmations != 0 {
+		n += 1 + sovGravity(uint64(m.SignedConfirmations))
+	}
+	if m.ExpectedConfirmations != 0 {
+		n += 1 + sovGravity(uint64(m.ExpectedConfirmations))
+	}
+	if m.EventVotes != 0 {
+		n += 1 + sovGravity(uint64(m.EventVotes))
+	}
+	if m.ObservedEvents != 0 {
+		n += 1 + sovGravity(uint64(m.ObservedEvents))
+	}
+	l = m.LastHeightVote.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EpochStartHeight))
+	}
+	if m.ObservedEventsBaseline != 0 {
+		n += 1 + sovGravity(uint64(m.ObservedEventsBaseline))
+	}
+	return n
+}
+
+func (m *BridgeStatsEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovGravity(uint64(m.StartHeight))
+	}
+	if m.ObservedEvents != 0 {
+		n += 1 + sovGravity(uint64(m.ObservedEvents))
+	}
+	if m.StartObservedEvents != 0 {
+		n += 1 + sovGravity(uint64(m.StartObservedEvents))
+	}
+	return n
+}
+
+func (m *EthereumKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovGravity(uint64(l))
 	}
-	l = len(m.Deposit)
+	l = len(m.OldEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.OldOrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Participation != 0 {
+		n += 1 + sovGravity(uint64(m.Participation))
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Reason != 0 {
+		n += 1 + sovGravity(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *ObservedSignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetNonce))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	return n
+}
+
+func (m *BatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
 	if l > 0 {
 		n += 1 + l + sovGravity(uint64(l))
 	}
-	return n
-}
+	l = m.Erc20Token.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Erc20Fee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.Erc20RewardPoolFee != nil {
+		l = m.Erc20RewardPoolFee.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OutgoingTxExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *ExecutedBatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Execution.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ExecutedContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LogicCall.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Execution.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *IDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PastEthereumSignatureCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovGravity(uint64(m.TxType))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PastEthereumSignatureCheckpointFloors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetNonce))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.ContractCallInvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.ContractCallInvalidationNonce))
+	}
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.BridgeFee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ForceSignerSetTxProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ForceSignerSetTxProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func sovGravity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGravity(x uint64) (n int) {
+	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBridgeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBridgeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBridgeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedConfirmations", wireType)
+			}
+			m.SignedConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedConfirmations", wireType)
+			}
+			m.ExpectedConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVotes", wireType)
+			}
+			m.EventVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEvents", wireType)
+			}
+			m.ObservedEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeightVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHeightVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEventsBaseline", wireType)
+			}
+			m.ObservedEventsBaseline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedEventsBaseline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeStatsEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeStatsEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeStatsEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEvents", wireType)
+			}
+			m.ObservedEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartObservedEvents", wireType)
+			}
+			m.StartObservedEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartObservedEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldOrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldOrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			m.Participation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Participation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types1.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &EthereumSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SignerSetTxReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservedSignerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservedSignerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservedSignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &EthereumSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &SendToEthereum{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGravity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGravity(x uint64) (n int) {
-	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,15 +4977,98 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,18 +5095,15 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1722,29 +5113,30 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20RewardPoolFee", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1754,12 +5146,28 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Erc20RewardPoolFee == nil {
+				m.Erc20RewardPoolFee = &ERC20Token{}
+			}
+			if err := m.Erc20RewardPoolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1781,7 +5189,7 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,17 +5212,17 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.EthereumHeight = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1824,16 +5232,16 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			m.CosmosHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1843,66 +5251,31 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1912,16 +5285,29 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1931,79 +5317,31 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.Nonce = 0
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2013,16 +5351,16 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Timeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2032,14 +5370,29 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2066,11 +5419,30 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &EthereumSigner{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -2092,7 +5464,7 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTx) Unmarshal(dAtA []byte) error {
+func (m *OutgoingTxExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2115,17 +5487,17 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingTxExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingTxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.BatchNonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2135,16 +5507,16 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			m.Timeout = 0
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2154,16 +5526,16 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
 			}
-			var msglen int
+			m.CosmosHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2173,63 +5545,16 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CosmosHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transactions = append(m.Transactions, &SendToEthereum{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			m.Height = 0
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2239,7 +5564,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2265,7 +5590,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *ExecutedBatchTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,17 +5613,17 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedBatchTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2308,16 +5633,30 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2327,59 +5666,78 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedContractCallTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedContractCallTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2406,13 +5764,13 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2439,7 +5797,7 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2464,7 +5822,7 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,36 +5845,17 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2526,29 +5865,27 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2576,66 +5913,191 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGravity
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2645,31 +6107,31 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, ERC20Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2679,27 +6141,25 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -2739,7 +6199,7 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *PastEthereumSignatureCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2762,17 +6222,17 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: PastEthereumSignatureCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PastEthereumSignatureCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			var stringLen uint64
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2782,29 +6242,35 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxType |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2814,26 +6280,45 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -2855,7 +6340,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
+func (m *PastEthereumSignatureCheckpointFloors) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2878,87 +6363,68 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: PastEthereumSignatureCheckpointFloors: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PastEthereumSignatureCheckpointFloors: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGravity
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGravity
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallInvalidationNonce", wireType)
+			}
+			m.ContractCallInvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGravity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallInvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3435,6 +6901,266 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *ForceSignerSetTxProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceSignerSetTxProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceSignerSetTxProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceSignerSetTxProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceSignerSetTxProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceSignerSetTxProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGravity(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
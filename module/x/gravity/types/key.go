@@ -20,6 +20,9 @@ const (
 
 	// QuerierRoute to be used for query msgs
 	QuerierRoute = ModuleName
+
+	// RewardPoolName is the name of the module account holding the validator reward pool
+	RewardPoolName = "gravity_reward_pool"
 )
 
 const (
@@ -62,6 +65,60 @@ const (
 
 	// EthereumHeightVoteKey indexes the latest heights observed by each validator
 	EthereumHeightVoteKey
+
+	// PastEthereumSignatureCheckpointKey indexes the checkpoints the chain has
+	// produced for outgoing txs by tx type and nonce, even after the txs
+	// themselves are pruned, until the checkpoint retention window passes
+	PastEthereumSignatureCheckpointKey
+
+	// BadSignatureEvidenceKey indexes submitted bad signature evidence so that a
+	// signature can only be punished once
+	BadSignatureEvidenceKey
+
+	// ValidatorBridgeStatsKey indexes the bridge participation counters of each validator
+	ValidatorBridgeStatsKey
+
+	// EthereumKeyRotationKey indexes the pending delegate key rotation of each validator
+	EthereumKeyRotationKey
+
+	// ValidatorRewardsKey indexes the reward pool participation and payouts of each validator
+	ValidatorRewardsKey
+
+	// PendingEthereumEventVoteRecordKey indexes the event vote records that may still be observed
+	PendingEthereumEventVoteRecordKey
+
+	// OutgoingTxTimeoutKey indexes the batch and contract call txs by their Ethereum timeout height
+	OutgoingTxTimeoutKey
+
+	// SendToEthereumIDKey indexes the fee, and so the pool key, of each unbatched send to ethereum by id
+	SendToEthereumIDKey
+
+	// PendingEthereumSignatureKey indexes the outgoing txs each validator has yet to sign
+	PendingEthereumSignatureKey
+
+	// ObservedSignerSetKey indexes the history of observed signer sets by Ethereum height
+	ObservedSignerSetKey
+
+	// ExecutedOutgoingTxKey indexes the archived batch and contract call txs executed on Ethereum
+	ExecutedOutgoingTxKey
+
+	// ExecutedOutgoingTxHeightKey indexes the archived outgoing txs by the cosmos height they were archived at
+	ExecutedOutgoingTxHeightKey
+
+	// LastObservedEventHeightKey indexes the cosmos height at which the last observed event nonce was set
+	LastObservedEventHeightKey
+
+	// PastEthereumSignatureCheckpointFloorsKey indexes the nonces at or below which checkpoints were not indexed
+	PastEthereumSignatureCheckpointFloorsKey
+
+	// BridgeStatsEpochKey indexes the epoch the validator bridge stats are counted over
+	BridgeStatsEpochKey
+
+	// RemovedValidatorDelegateKeysCursorKey indexes the validator the removed validator delegate keys scan resumes from
+	RemovedValidatorDelegateKeysCursorKey
+
+	// BatchedSendToEthereumIDKey indexes the batch tx store index of each batched send to ethereum by id
+	BatchedSendToEthereumIDKey
 )
 
 ////////////////////
@@ -97,6 +154,13 @@ func MakeEthereumSignatureKey(storeIndex []byte, validator sdk.ValAddress) []byt
 	return bytes.Join([][]byte{{EthereumSignatureKey}, storeIndex, validator.Bytes()}, []byte{})
 }
 
+// MakePendingEthereumSignatureKey returns the following key format
+// prefix              validator-address                       store-index
+// [0x1d][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0x0][0 0 0 0 0 0 0 1]
+func MakePendingEthereumSignatureKey(validator sdk.ValAddress, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{PendingEthereumSignatureKey}, validator.Bytes(), storeIndex}, []byte{})
+}
+
 /////////////////////////////////
 // Ethereum Event Vote Records //
 /////////////////////////////////
@@ -108,6 +172,13 @@ func MakeEthereumEventVoteRecordKey(eventNonce uint64, claimHash []byte) []byte
 	return bytes.Join([][]byte{{EthereumEventVoteRecordKey}, sdk.Uint64ToBigEndian(eventNonce), claimHash}, []byte{})
 }
 
+// MakePendingEthereumEventVoteRecordKey returns the following key format
+// prefix     nonce                             claim-details-hash
+// [0x1a][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+func MakePendingEthereumEventVoteRecordKey(eventNonce uint64, claimHash []byte) []byte {
+	return bytes.Join([][]byte{{PendingEthereumEventVoteRecordKey}, sdk.Uint64ToBigEndian(eventNonce), claimHash}, []byte{})
+}
+
 //////////////////
 // Outgoing Txs //
 //////////////////
@@ -117,6 +188,13 @@ func MakeOutgoingTxKey(storeIndex []byte) []byte {
 	return append([]byte{OutgoingTxKey}, storeIndex...)
 }
 
+// MakeOutgoingTxTimeoutKey returns the following key format
+// prefix      timeout               store-index
+// [0x1b][0 0 0 0 0 0 0 1][0x1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeOutgoingTxTimeoutKey(timeout uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{OutgoingTxTimeoutKey}, sdk.Uint64ToBigEndian(timeout), storeIndex}, []byte{})
+}
+
 //////////////////////
 // Send To Ethereum //
 //////////////////////
@@ -129,6 +207,20 @@ func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeSendToEthereumIDKey returns the following key format
+// prefix         id
+// [0x1c][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumIDKey(id uint64) []byte {
+	return append([]byte{SendToEthereumIDKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeBatchedSendToEthereumIDKey returns the following key format
+// prefix         id
+// [0x25][0 0 0 0 0 0 0 1]
+func MakeBatchedSendToEthereumIDKey(id uint64) []byte {
+	return append([]byte{BatchedSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
@@ -160,3 +252,59 @@ func MakeContractCallTxKey(invalscope []byte, invalnonce uint64) []byte {
 func MakeEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
 	return append([]byte{EthereumHeightVoteKey}, validator.Bytes()...)
 }
+
+// MakePastEthereumSignatureCheckpointKey returns the following key format
+// prefix tx-type nonce              checkpoint
+// [0x15][0x2][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+func MakePastEthereumSignatureCheckpointKey(txType byte, nonce uint64, checkpoint []byte) []byte {
+	return bytes.Join([][]byte{{PastEthereumSignatureCheckpointKey, txType}, sdk.Uint64ToBigEndian(nonce), checkpoint}, []byte{})
+}
+
+// MakeBadSignatureEvidenceKey returns the following key format
+// prefix   checkpoint                                                          ethereum-address
+// [0x16][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeBadSignatureEvidenceKey(checkpoint []byte, eth common.Address) []byte {
+	return bytes.Join([][]byte{{BadSignatureEvidenceKey}, checkpoint, eth.Bytes()}, []byte{})
+}
+
+// MakeValidatorBridgeStatsKey returns the following key format
+// prefix              cosmos-validator
+// [0x17][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeValidatorBridgeStatsKey(validator sdk.ValAddress) []byte {
+	return append([]byte{ValidatorBridgeStatsKey}, validator.Bytes()...)
+}
+
+// MakeEthereumKeyRotationKey returns the following key format
+// prefix              cosmos-validator
+// [0x18][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumKeyRotationKey(validator sdk.ValAddress) []byte {
+	return append([]byte{EthereumKeyRotationKey}, validator.Bytes()...)
+}
+
+// MakeValidatorRewardsKey returns the following key format
+// prefix              cosmos-validator
+// [0x19][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeValidatorRewardsKey(validator sdk.ValAddress) []byte {
+	return append([]byte{ValidatorRewardsKey}, validator.Bytes()...)
+}
+
+// MakeObservedSignerSetKey returns the following key format
+// prefix   ethereum-height            signer-set-nonce
+// [0x1e][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func MakeObservedSignerSetKey(ethereumHeight, signerSetNonce uint64) []byte {
+	return bytes.Join([][]byte{{ObservedSignerSetKey}, sdk.Uint64ToBigEndian(ethereumHeight), sdk.Uint64ToBigEndian(signerSetNonce)}, []byte{})
+}
+
+// MakeExecutedOutgoingTxKey returns the following key format
+// prefix   store-index
+// [0x1f][0x2][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeExecutedOutgoingTxKey(storeIndex []byte) []byte {
+	return append([]byte{ExecutedOutgoingTxKey}, storeIndex...)
+}
+
+// MakeExecutedOutgoingTxHeightKey returns the following key format
+// prefix    cosmos-height         store-index
+// [0x20][0 0 0 0 0 0 0 1][0x2][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeExecutedOutgoingTxHeightKey(cosmosHeight uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{ExecutedOutgoingTxHeightKey}, sdk.Uint64ToBigEndian(cosmosHeight), storeIndex}, []byte{})
+}
@@ -16,9 +16,12 @@ var (
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
+	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgRotateEthereumKey{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitBadSignatureEvidence{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
 )
 
@@ -289,3 +292,109 @@ func (msg MsgEthereumHeightVote) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgSubmitBadSignatureEvidence returns a new MsgSubmitBadSignatureEvidence
+func NewMsgSubmitBadSignatureEvidence(subject OutgoingTx, signature []byte, signer sdk.AccAddress) (*MsgSubmitBadSignatureEvidence, error) {
+	any, err := PackOutgoingTx(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitBadSignatureEvidence{
+		Subject:   any,
+		Signature: signature,
+		Signer:    signer.String(),
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitBadSignatureEvidence) Type() string { return "submit_bad_signature_evidence" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgSubmitBadSignatureEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	if _, err := UnpackOutgoingTx(msg.Subject); err != nil {
+		return err
+	}
+
+	if len(msg.Signature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitBadSignatureEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitBadSignatureEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+func (msg MsgSubmitBadSignatureEvidence) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var subject OutgoingTx
+	return unpacker.UnpackAny(msg.Subject, &subject)
+}
+
+// NewMsgRotateEthereumKey returns a reference to a new MsgRotateEthereumKey.
+func NewMsgRotateEthereumKey(val sdk.ValAddress, orchAddr sdk.AccAddress, ethAddr string, oldEthSig, newEthSig []byte) *MsgRotateEthereumKey {
+	return &MsgRotateEthereumKey{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr,
+		OldEthSignature:     oldEthSig,
+		NewEthSignature:     newEthSig,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRotateEthereumKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRotateEthereumKey) Type() string { return "rotate_ethereum_key" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRotateEthereumKey) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+	if !common.IsHexAddress(msg.EthereumAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+	if len(msg.OldEthSignature) == 0 || len(msg.NewEthSignature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRotateEthereumKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRotateEthereumKey) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
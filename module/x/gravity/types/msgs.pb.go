@@ -850,6 +850,282 @@ func (m *MsgEthereumHeightVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEthereumHeightVoteResponse proto.InternalMessageInfo
 
+// MsgSubmitBadSignatureEvidence submits evidence that a validator's Ethereum
+// key signed the checkpoint of an outgoing tx that was never produced by the
+// chain. The subject is the outgoing tx whose checkpoint was signed and the
+// signature is the offending Ethereum signature over that checkpoint.
+type MsgSubmitBadSignatureEvidence struct {
+	Subject   *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Signature []byte      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signer    string      `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSignatureEvidence{} }
+func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidence proto.InternalMessageInfo
+
+type MsgSubmitBadSignatureEvidenceResponse struct {
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmitBadSignatureEvidenceResponse{} }
+func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
+
+// MsgRotateEthereumKey allows a validator to replace its delegated Ethereum
+// key and orchestrator address. Both the current and the new Ethereum keys
+// must sign over a RotateEthereumKeySignMsg. Confirmations signed with the
+// current key keep being accepted until the signer set tx containing the new
+// key is observed on Ethereum.
+type MsgRotateEthereumKey struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	OldEthSignature     []byte `protobuf:"bytes,4,opt,name=old_eth_signature,json=oldEthSignature,proto3" json:"old_eth_signature,omitempty"`
+	NewEthSignature     []byte `protobuf:"bytes,5,opt,name=new_eth_signature,json=newEthSignature,proto3" json:"new_eth_signature,omitempty"`
+}
+
+func (m *MsgRotateEthereumKey) Reset()         { *m = MsgRotateEthereumKey{} }
+func (m *MsgRotateEthereumKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateEthereumKey) ProtoMessage()    {}
+func (*MsgRotateEthereumKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
+}
+func (m *MsgRotateEthereumKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateEthereumKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateEthereumKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateEthereumKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateEthereumKey.Merge(m, src)
+}
+func (m *MsgRotateEthereumKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateEthereumKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateEthereumKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateEthereumKey proto.InternalMessageInfo
+
+func (m *MsgRotateEthereumKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateEthereumKey) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateEthereumKey) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateEthereumKey) GetOldEthSignature() []byte {
+	if m != nil {
+		return m.OldEthSignature
+	}
+	return nil
+}
+
+func (m *MsgRotateEthereumKey) GetNewEthSignature() []byte {
+	if m != nil {
+		return m.NewEthSignature
+	}
+	return nil
+}
+
+type MsgRotateEthereumKeyResponse struct {
+}
+
+func (m *MsgRotateEthereumKeyResponse) Reset()         { *m = MsgRotateEthereumKeyResponse{} }
+func (m *MsgRotateEthereumKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateEthereumKeyResponse) ProtoMessage()    {}
+func (*MsgRotateEthereumKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
+}
+func (m *MsgRotateEthereumKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateEthereumKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateEthereumKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateEthereumKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateEthereumKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateEthereumKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateEthereumKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateEthereumKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateEthereumKeyResponse proto.InternalMessageInfo
+
+// RotateEthereumKeySignMsg defines the message structure both the current and
+// the new Ethereum keys are expected to sign when submitting a
+// MsgRotateEthereumKey message. The resulting signatures should populate the
+// old_eth_signature and new_eth_signature fields.
+type RotateEthereumKeySignMsg struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OldEthereumAddress  string `protobuf:"bytes,2,opt,name=old_ethereum_address,json=oldEthereumAddress,proto3" json:"old_ethereum_address,omitempty"`
+	NewEthereumAddress  string `protobuf:"bytes,3,opt,name=new_ethereum_address,json=newEthereumAddress,proto3" json:"new_ethereum_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,4,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	Nonce               uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *RotateEthereumKeySignMsg) Reset()         { *m = RotateEthereumKeySignMsg{} }
+func (m *RotateEthereumKeySignMsg) String() string { return proto.CompactTextString(m) }
+func (*RotateEthereumKeySignMsg) ProtoMessage()    {}
+func (*RotateEthereumKeySignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
+}
+func (m *RotateEthereumKeySignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateEthereumKeySignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateEthereumKeySignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateEthereumKeySignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEthereumKeySignMsg.Merge(m, src)
+}
+func (m *RotateEthereumKeySignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateEthereumKeySignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEthereumKeySignMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEthereumKeySignMsg proto.InternalMessageInfo
+
+func (m *RotateEthereumKeySignMsg) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RotateEthereumKeySignMsg) GetOldEthereumAddress() string {
+	if m != nil {
+		return m.OldEthereumAddress
+	}
+	return ""
+}
+
+func (m *RotateEthereumKeySignMsg) GetNewEthereumAddress() string {
+	if m != nil {
+		return m.NewEthereumAddress
+	}
+	return ""
+}
+
+func (m *RotateEthereumKeySignMsg) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+func (m *RotateEthereumKeySignMsg) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
 // gravity contract. ERC20 representation coins are minted to the cosmosreceiver
 // address.
@@ -866,7 +1142,7 @@ func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
 func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
 func (*SendToCosmosEvent) ProtoMessage()    {}
 func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +1219,7 @@ func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
 func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*BatchExecutedEvent) ProtoMessage()    {}
 func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1289,7 @@ func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedE
 func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractCallExecutedEvent) ProtoMessage()    {}
 func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1362,7 @@ func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
 func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
 func (*ERC20DeployedEvent) ProtoMessage()    {}
 func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,7 +1453,7 @@ func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEve
 func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
 func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,6 +1527,11 @@ func init() {
 	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*MsgEthereumHeightVote)(nil), "gravity.v1.MsgEthereumHeightVote")
 	proto.RegisterType((*MsgEthereumHeightVoteResponse)(nil), "gravity.v1.MsgEthereumHeightVoteResponse")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgRotateEthereumKey)(nil), "gravity.v1.MsgRotateEthereumKey")
+	proto.RegisterType((*MsgRotateEthereumKeyResponse)(nil), "gravity.v1.MsgRotateEthereumKeyResponse")
+	proto.RegisterType((*RotateEthereumKeySignMsg)(nil), "gravity.v1.RotateEthereumKeySignMsg")
 	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
 	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
 	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
@@ -1261,88 +1542,100 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x8f, 0xd3, 0x46,
-	0x14, 0x5f, 0x27, 0x61, 0xd1, 0xbe, 0xec, 0xa7, 0x77, 0x81, 0x6c, 0x0a, 0xc9, 0x62, 0x44, 0x59,
-	0x8a, 0x62, 0xb3, 0x0b, 0x52, 0x2b, 0xaa, 0x56, 0x22, 0xd9, 0x45, 0x54, 0xd5, 0x72, 0x48, 0xa0,
-	0x42, 0xbd, 0x44, 0xfe, 0x78, 0x38, 0x86, 0xd8, 0x13, 0x79, 0x26, 0xd1, 0xe6, 0xda, 0x53, 0xd5,
-	0x53, 0x7b, 0xe8, 0x9d, 0x03, 0xea, 0x5f, 0xc0, 0x3f, 0xc0, 0x8d, 0x72, 0x42, 0xea, 0xa5, 0xea,
-	0x01, 0x55, 0x70, 0xe9, 0xdf, 0x50, 0xb5, 0x52, 0xe5, 0x99, 0x71, 0xd6, 0x76, 0xbc, 0x5f, 0x52,
-	0x4f, 0xf1, 0xbc, 0xf7, 0x9b, 0x37, 0xef, 0xe3, 0x37, 0xef, 0x4d, 0xe0, 0x9c, 0x1b, 0x9a, 0x23,
-	0x8f, 0x8d, 0x8d, 0xd1, 0x96, 0xe1, 0x53, 0x97, 0xea, 0x83, 0x90, 0x30, 0xa2, 0x82, 0x14, 0xeb,
-	0xa3, 0xad, 0x6a, 0xcd, 0x26, 0xd4, 0x27, 0xd4, 0xb0, 0x4c, 0x8a, 0xc6, 0x68, 0xcb, 0x42, 0x66,
-	0x6e, 0x19, 0x36, 0xf1, 0x02, 0x81, 0xad, 0xae, 0x0b, 0x7d, 0x97, 0xaf, 0x0c, 0xb1, 0x90, 0xaa,
-	0x4a, 0xc2, 0x7a, 0x6c, 0x51, 0x68, 0xd6, 0x5c, 0xe2, 0x12, 0xb1, 0x23, 0xfa, 0x92, 0xd2, 0x8b,
-	0x2e, 0x21, 0x6e, 0x1f, 0x0d, 0x73, 0xe0, 0x19, 0x66, 0x10, 0x10, 0x66, 0x32, 0x8f, 0x04, 0xb1,
-	0xb5, 0x75, 0xa9, 0xe5, 0x2b, 0x6b, 0xf8, 0xc4, 0x30, 0x03, 0x69, 0x4e, 0xfb, 0x4d, 0x81, 0x95,
-	0x3d, 0xea, 0x76, 0x30, 0x70, 0x1e, 0x92, 0x5d, 0xd6, 0xc3, 0x10, 0x87, 0xbe, 0x7a, 0x1e, 0x66,
-	0x29, 0x06, 0x0e, 0x86, 0x15, 0x65, 0x43, 0xd9, 0x9c, 0x6b, 0xcb, 0x95, 0xda, 0x00, 0x15, 0x25,
-	0xa6, 0x1b, 0xa2, 0xed, 0x0d, 0x3c, 0x0c, 0x58, 0xa5, 0xc0, 0x31, 0x2b, 0xb1, 0xa6, 0x1d, 0x2b,
-	0xd4, 0x4f, 0x61, 0xd6, 0xf4, 0xc9, 0x30, 0x60, 0x95, 0xe2, 0x86, 0xb2, 0x59, 0xde, 0x5e, 0xd7,
-	0x65, 0x90, 0x51, 0x46, 0x74, 0x99, 0x11, 0xbd, 0x45, 0xbc, 0xa0, 0x59, 0x7a, 0xfd, 0xae, 0x3e,
-	0xd3, 0x96, 0x70, 0xf5, 0x4b, 0x00, 0x2b, 0xf4, 0x1c, 0x17, 0xbb, 0x4f, 0x10, 0x2b, 0xa5, 0x93,
-	0x6d, 0x9e, 0x13, 0x5b, 0xee, 0x21, 0x6a, 0x37, 0x60, 0x7d, 0x2a, 0xa8, 0x36, 0xd2, 0x01, 0x09,
-	0x28, 0xaa, 0x8b, 0x50, 0xf0, 0x1c, 0x1e, 0x58, 0xa9, 0x5d, 0xf0, 0x1c, 0xed, 0x2e, 0x5c, 0xd8,
-	0xa3, 0x6e, 0xcb, 0x0c, 0x6c, 0xec, 0x67, 0xf2, 0x90, 0x81, 0x26, 0xf2, 0x52, 0x48, 0xe6, 0x45,
-	0xbb, 0x0c, 0xf5, 0x43, 0x4c, 0xc4, 0xa7, 0x6a, 0x3f, 0x2b, 0x1c, 0xd3, 0x19, 0x5a, 0xbe, 0xc7,
-	0x62, 0xed, 0xc3, 0xfd, 0x16, 0x09, 0x9e, 0x78, 0xa1, 0xcf, 0xcb, 0xa5, 0x3e, 0x84, 0x79, 0x3b,
-	0xb1, 0xe6, 0x07, 0x97, 0xb7, 0xd7, 0x74, 0x51, 0x3e, 0x3d, 0x2e, 0x9f, 0x7e, 0x37, 0x18, 0x37,
-	0xab, 0x6f, 0x5e, 0x36, 0xce, 0xe7, 0xdb, 0x69, 0xa7, 0xac, 0x70, 0xa7, 0x3d, 0x37, 0x48, 0x38,
-	0xcd, 0x57, 0x77, 0x4a, 0xdf, 0x3f, 0xaf, 0xcf, 0x68, 0xaf, 0x14, 0xa8, 0xb6, 0x48, 0xc0, 0x42,
-	0xd3, 0x66, 0x2d, 0xb3, 0xdf, 0xcf, 0xb8, 0xd4, 0x00, 0xd5, 0x0b, 0x46, 0x66, 0xdf, 0x73, 0xf8,
-	0xba, 0x4b, 0x6d, 0x32, 0x40, 0xee, 0xd8, 0x7c, 0x7b, 0x25, 0xa9, 0xe9, 0x44, 0x8a, 0x29, 0x78,
-	0x40, 0x02, 0x1b, 0xf9, 0xb9, 0xa5, 0x34, 0xfc, 0x41, 0xa4, 0x50, 0xaf, 0xc1, 0xd2, 0x84, 0x4f,
-	0xd2, 0xc7, 0x22, 0xf7, 0x71, 0x31, 0x16, 0x77, 0xb8, 0x54, 0xbd, 0x08, 0x73, 0x91, 0xde, 0x64,
-	0xc3, 0x50, 0xf0, 0x61, 0xbe, 0x7d, 0x20, 0xd0, 0x5e, 0x28, 0xb0, 0xda, 0x34, 0x99, 0xdd, 0xcb,
-	0x38, 0x7f, 0x15, 0x16, 0x19, 0x79, 0x86, 0x41, 0xd7, 0x96, 0x01, 0x4a, 0x3a, 0x2f, 0x70, 0x69,
-	0x1c, 0xb5, 0x5a, 0x87, 0xb2, 0x15, 0xed, 0x4e, 0x79, 0x0b, 0x5c, 0xf4, 0xbf, 0xba, 0xf9, 0x83,
-	0x02, 0x17, 0x04, 0xb0, 0x83, 0x2c, 0xe3, 0xea, 0x26, 0x2c, 0x0b, 0xcb, 0x5d, 0x8a, 0x4c, 0x3a,
-	0x22, 0x78, 0xb7, 0x48, 0xe3, 0x2d, 0x87, 0x3a, 0x53, 0x38, 0xde, 0x99, 0x62, 0xd6, 0x99, 0xeb,
-	0x70, 0xed, 0x18, 0x3a, 0x4e, 0xa8, 0x3b, 0x84, 0xf3, 0x53, 0xd0, 0xdd, 0x51, 0x74, 0xc1, 0xbf,
-	0x80, 0x33, 0x18, 0x7d, 0x1c, 0xc9, 0xd4, 0x95, 0x37, 0x2f, 0x1b, 0x0b, 0xa9, 0x7d, 0x6d, 0xb1,
-	0xeb, 0x18, 0x66, 0x6e, 0x40, 0x2d, 0xff, 0xd8, 0x89, 0x63, 0xaf, 0x14, 0x58, 0xda, 0xa3, 0xee,
-	0x0e, 0xf6, 0xd1, 0x35, 0x19, 0x7e, 0x8d, 0x63, 0xaa, 0xde, 0x80, 0x15, 0xc9, 0x32, 0x12, 0x76,
-	0x4d, 0xc7, 0x09, 0x91, 0x52, 0x59, 0xf6, 0xe5, 0x89, 0xe2, 0xae, 0x90, 0xab, 0x5b, 0xb0, 0x46,
-	0x42, 0xbb, 0x87, 0x94, 0x85, 0x29, 0xbc, 0x70, 0x67, 0x35, 0xa9, 0x8b, 0xb7, 0x5c, 0x87, 0xe5,
-	0x49, 0xfa, 0x63, 0xb8, 0x20, 0xc3, 0xa4, 0x2c, 0x31, 0xf4, 0x0a, 0x2c, 0x20, 0xeb, 0x75, 0xb3,
-	0x8c, 0x98, 0x47, 0xd6, 0xeb, 0x4c, 0xea, 0xb0, 0xce, 0xbb, 0x4f, 0x32, 0x84, 0x49, 0x78, 0x8f,
-	0x61, 0x35, 0x29, 0x8f, 0xf6, 0xec, 0x51, 0xf7, 0x74, 0x11, 0xae, 0xc1, 0x99, 0x24, 0xab, 0xc5,
-	0x42, 0x7b, 0x0c, 0xe7, 0xf6, 0xa8, 0x1b, 0x27, 0xf5, 0x3e, 0x7a, 0x6e, 0x8f, 0x7d, 0x43, 0x58,
-	0x9a, 0x5c, 0x3d, 0x2e, 0x8e, 0x59, 0x88, 0x29, 0xf0, 0x61, 0xa5, 0xd3, 0xea, 0x70, 0x29, 0xd7,
-	0xf2, 0x24, 0xa8, 0x17, 0x05, 0x58, 0x11, 0x2d, 0xb2, 0xc5, 0xdb, 0xb9, 0x20, 0x52, 0x1d, 0xca,
-	0x9c, 0x12, 0x29, 0xe6, 0x03, 0x17, 0x09, 0xd6, 0x4f, 0x5f, 0xe5, 0x42, 0xde, 0x55, 0xbe, 0x97,
-	0x9a, 0x38, 0x73, 0x4d, 0x3d, 0x9a, 0x0c, 0x7f, 0xbc, 0xab, 0x7f, 0xec, 0x7a, 0xac, 0x37, 0xb4,
-	0x74, 0x9b, 0xf8, 0x72, 0xd0, 0xca, 0x9f, 0x06, 0x75, 0x9e, 0x19, 0x6c, 0x3c, 0x40, 0xaa, 0x7f,
-	0x15, 0xb0, 0xc9, 0x00, 0x4a, 0x5d, 0x32, 0xd1, 0xf1, 0x4b, 0x99, 0x4b, 0x26, 0x26, 0xe2, 0x35,
-	0x58, 0x92, 0x53, 0x3c, 0x44, 0x1b, 0xbd, 0x11, 0x86, 0x95, 0x33, 0x02, 0x28, 0xc4, 0x6d, 0x29,
-	0xcd, 0xcb, 0xec, 0x6c, 0x5e, 0x66, 0xef, 0x94, 0xfe, 0x7a, 0x5e, 0x57, 0xb4, 0x5f, 0x14, 0x50,
-	0x79, 0x4b, 0xdb, 0xdd, 0x47, 0x7b, 0xc8, 0xd0, 0x11, 0x79, 0x3a, 0x79, 0x47, 0x4b, 0xa6, 0xb3,
-	0x30, 0x95, 0xce, 0x1c, 0x6f, 0x8a, 0xb9, 0x75, 0xce, 0xf4, 0xc6, 0x52, 0xb6, 0x37, 0x6a, 0xff,
-	0x2a, 0xb0, 0x9e, 0x9c, 0x1f, 0x69, 0x7f, 0x8f, 0xad, 0xab, 0x9b, 0x3b, 0x5f, 0x22, 0x87, 0xe7,
-	0x9b, 0x9f, 0xfd, 0xfd, 0xae, 0x7e, 0x3b, 0x51, 0x38, 0xc6, 0x53, 0xee, 0x7b, 0x01, 0x4b, 0x7e,
-	0xf6, 0x3d, 0x8b, 0x1a, 0xd6, 0x98, 0x21, 0xd5, 0xef, 0xe3, 0x7e, 0x33, 0xfa, 0x38, 0xf9, 0x64,
-	0x2a, 0x9e, 0x64, 0x32, 0xc9, 0x04, 0x95, 0xf2, 0x12, 0xa4, 0xfd, 0x54, 0x00, 0x75, 0xb7, 0xdd,
-	0xda, 0xbe, 0xb9, 0x83, 0x83, 0x3e, 0x19, 0x9f, 0x38, 0xf0, 0xcb, 0xd1, 0xac, 0xe7, 0xc4, 0x71,
-	0x30, 0x20, 0xbe, 0xa4, 0x73, 0x59, 0xc8, 0x76, 0x22, 0x51, 0x4e, 0xb1, 0x8b, 0x79, 0xc5, 0xbe,
-	0x04, 0x80, 0xa1, 0xbd, 0x7d, 0xb3, 0x1b, 0x98, 0x3e, 0x4a, 0x9a, 0xce, 0x71, 0xc9, 0x03, 0xd3,
-	0xe7, 0x07, 0x09, 0x35, 0x1d, 0xfb, 0x16, 0xe9, 0x4b, 0x7a, 0x96, 0xb9, 0xac, 0xc3, 0x45, 0xd1,
-	0x41, 0x02, 0xe2, 0xa0, 0xed, 0xf9, 0x66, 0x9f, 0x4a, 0x6a, 0x2e, 0x70, 0xe9, 0x8e, 0x14, 0xe6,
-	0xe5, 0xe4, 0x6c, 0x6e, 0x4e, 0x7e, 0x55, 0xa0, 0x92, 0x18, 0x74, 0xa7, 0xa4, 0x44, 0x03, 0x56,
-	0x13, 0xa3, 0x90, 0xed, 0xa7, 0x48, 0xbc, 0x4c, 0x0f, 0xec, 0x9e, 0x92, 0xca, 0xb7, 0xe1, 0xac,
-	0x8f, 0xbe, 0x85, 0x21, 0xad, 0x94, 0x36, 0x8a, 0x9b, 0xe5, 0xed, 0xaa, 0x7e, 0xf0, 0x58, 0xd7,
-	0x77, 0x53, 0xc3, 0xb3, 0x1d, 0x43, 0xb7, 0xff, 0x29, 0x41, 0x31, 0xea, 0xba, 0x8f, 0x61, 0x31,
-	0xf3, 0x38, 0xbc, 0x94, 0xdc, 0x3e, 0xf5, 0xdc, 0xac, 0x5e, 0x3d, 0x52, 0x3d, 0xe9, 0x87, 0x33,
-	0xea, 0x53, 0x58, 0xcb, 0x7d, 0x7c, 0x5e, 0xc9, 0x18, 0xc8, 0x03, 0x55, 0x6f, 0x9c, 0x00, 0x94,
-	0x38, 0xeb, 0x3b, 0x05, 0x2e, 0x1e, 0xf9, 0x04, 0xcd, 0xda, 0x3b, 0x0a, 0x5c, 0xbd, 0x75, 0x0a,
-	0x70, 0xc2, 0x09, 0x17, 0x56, 0xf3, 0x1e, 0x13, 0xda, 0x91, 0xd6, 0x38, 0xa6, 0xfa, 0xc9, 0xf1,
-	0x98, 0xc4, 0x41, 0x8f, 0x60, 0xa9, 0x83, 0x2c, 0xf5, 0x3c, 0xf8, 0x28, 0x63, 0x20, 0xa9, 0xac,
-	0x5e, 0x39, 0x42, 0x99, 0x2a, 0x58, 0x25, 0x7d, 0x6e, 0x62, 0x80, 0x5e, 0xce, 0x98, 0x98, 0x86,
-	0x54, 0xaf, 0x1f, 0x0b, 0x39, 0x38, 0xab, 0xf9, 0xe8, 0xf5, 0xfb, 0x9a, 0xf2, 0xf6, 0x7d, 0x4d,
-	0xf9, 0xf3, 0x7d, 0x4d, 0xf9, 0xf1, 0x43, 0x6d, 0xe6, 0xed, 0x87, 0xda, 0xcc, 0xef, 0x1f, 0x6a,
-	0x33, 0xdf, 0x7e, 0x9e, 0xe8, 0x8c, 0x03, 0x74, 0xdd, 0xf1, 0xd3, 0x51, 0xfc, 0x57, 0xb1, 0x21,
-	0xfe, 0x09, 0x19, 0x3e, 0x71, 0x86, 0x7d, 0x34, 0x46, 0xb7, 0x8c, 0xfd, 0x58, 0x25, 0x66, 0x9d,
-	0x35, 0xcb, 0x5f, 0x68, 0xb7, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x42, 0xc5, 0xab, 0xc6,
-	0x0e, 0x00, 0x00,
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x25, 0xd9, 0x81, 0x9f, 0xff, 0x8a, 0x56, 0x12, 0x59, 0xb5, 0x25, 0x47, 0x41, 0x1a,
+	0x3b, 0x81, 0x24, 0xdb, 0x09, 0xd0, 0x22, 0x45, 0x03, 0x44, 0xb6, 0x83, 0x14, 0x81, 0x53, 0x80,
+	0x72, 0x8a, 0xa0, 0x8b, 0x40, 0x91, 0x2f, 0x14, 0x13, 0x91, 0x27, 0xf0, 0x4e, 0x8a, 0x05, 0x74,
+	0xea, 0x54, 0x74, 0x6a, 0x87, 0x4e, 0x5d, 0x32, 0x04, 0xfd, 0x04, 0xf9, 0x02, 0xd9, 0xd2, 0x4c,
+	0x01, 0xba, 0x14, 0x05, 0x1a, 0x14, 0xc9, 0xd2, 0x0f, 0xd0, 0xa5, 0x05, 0x0a, 0x14, 0xbc, 0x23,
+	0x69, 0x92, 0xa2, 0x65, 0xb9, 0xe8, 0xd0, 0x49, 0xbc, 0xf7, 0x7e, 0xf7, 0xee, 0xfd, 0xf9, 0xf1,
+	0xde, 0xa3, 0xe0, 0xac, 0xe1, 0xa8, 0x7d, 0x93, 0x0d, 0x6a, 0xfd, 0xad, 0x9a, 0x45, 0x0d, 0x5a,
+	0xed, 0x3a, 0x84, 0x11, 0x19, 0x3c, 0x71, 0xb5, 0xbf, 0x55, 0x28, 0x6a, 0x84, 0x5a, 0x84, 0xd6,
+	0x5a, 0x2a, 0xc5, 0x5a, 0x7f, 0xab, 0x85, 0x4c, 0xdd, 0xaa, 0x69, 0xc4, 0xb4, 0x05, 0xb6, 0xb0,
+	0x2c, 0xf4, 0x4d, 0xbe, 0xaa, 0x89, 0x85, 0xa7, 0xca, 0x87, 0xac, 0xfb, 0x16, 0x85, 0x26, 0x67,
+	0x10, 0x83, 0x88, 0x1d, 0xee, 0x93, 0x27, 0x5d, 0x31, 0x08, 0x31, 0x3a, 0x58, 0x53, 0xbb, 0x66,
+	0x4d, 0xb5, 0x6d, 0xc2, 0x54, 0x66, 0x12, 0xdb, 0xb7, 0xb6, 0xec, 0x69, 0xf9, 0xaa, 0xd5, 0x7b,
+	0x58, 0x53, 0x6d, 0xcf, 0x5c, 0xf9, 0x27, 0x09, 0xb2, 0xfb, 0xd4, 0x68, 0xa0, 0xad, 0x1f, 0x90,
+	0x3d, 0xd6, 0x46, 0x07, 0x7b, 0x96, 0x7c, 0x0e, 0xa6, 0x28, 0xda, 0x3a, 0x3a, 0x79, 0x69, 0x4d,
+	0x5a, 0x9f, 0x56, 0xbc, 0x95, 0x5c, 0x01, 0x19, 0x3d, 0x4c, 0xd3, 0x41, 0xcd, 0xec, 0x9a, 0x68,
+	0xb3, 0x7c, 0x8a, 0x63, 0xb2, 0xbe, 0x46, 0xf1, 0x15, 0xf2, 0x07, 0x30, 0xa5, 0x5a, 0xa4, 0x67,
+	0xb3, 0x7c, 0x7a, 0x4d, 0x5a, 0x9f, 0xd9, 0x5e, 0xae, 0x7a, 0x41, 0xba, 0x19, 0xa9, 0x7a, 0x19,
+	0xa9, 0xee, 0x10, 0xd3, 0xae, 0x67, 0x5e, 0xbe, 0x29, 0x4d, 0x28, 0x1e, 0x5c, 0xbe, 0x09, 0xd0,
+	0x72, 0x4c, 0xdd, 0xc0, 0xe6, 0x43, 0xc4, 0x7c, 0x66, 0xbc, 0xcd, 0xd3, 0x62, 0xcb, 0x6d, 0xc4,
+	0xf2, 0x55, 0x58, 0x1e, 0x0a, 0x4a, 0x41, 0xda, 0x25, 0x36, 0x45, 0x79, 0x1e, 0x52, 0xa6, 0xce,
+	0x03, 0xcb, 0x28, 0x29, 0x53, 0x2f, 0xdf, 0x82, 0xf3, 0xfb, 0xd4, 0xd8, 0x51, 0x6d, 0x0d, 0x3b,
+	0xb1, 0x3c, 0xc4, 0xa0, 0xa1, 0xbc, 0xa4, 0xc2, 0x79, 0x29, 0x5f, 0x80, 0xd2, 0x31, 0x26, 0xfc,
+	0x53, 0xcb, 0xdf, 0x49, 0x1c, 0xd3, 0xe8, 0xb5, 0x2c, 0x93, 0xf9, 0xda, 0x83, 0xc3, 0x1d, 0x62,
+	0x3f, 0x34, 0x1d, 0x8b, 0x97, 0x4b, 0x3e, 0x80, 0x59, 0x2d, 0xb4, 0xe6, 0x07, 0xcf, 0x6c, 0xe7,
+	0xaa, 0xa2, 0x7c, 0x55, 0xbf, 0x7c, 0xd5, 0x5b, 0xf6, 0xa0, 0x5e, 0x78, 0xf5, 0xbc, 0x72, 0x2e,
+	0xd9, 0x8e, 0x12, 0xb1, 0xc2, 0x9d, 0x36, 0x0d, 0x3b, 0xe4, 0x34, 0x5f, 0xdd, 0xc8, 0x7c, 0xf5,
+	0xb4, 0x34, 0x51, 0x7e, 0x21, 0x41, 0x61, 0x87, 0xd8, 0xcc, 0x51, 0x35, 0xb6, 0xa3, 0x76, 0x3a,
+	0x31, 0x97, 0x2a, 0x20, 0x9b, 0x76, 0x5f, 0xed, 0x98, 0x3a, 0x5f, 0x37, 0xa9, 0x46, 0xba, 0xc8,
+	0x1d, 0x9b, 0x55, 0xb2, 0x61, 0x4d, 0xc3, 0x55, 0x0c, 0xc1, 0x6d, 0x62, 0x6b, 0xc8, 0xcf, 0xcd,
+	0x44, 0xe1, 0xf7, 0x5c, 0x85, 0x7c, 0x19, 0x16, 0x02, 0x3e, 0x79, 0x3e, 0xa6, 0xb9, 0x8f, 0xf3,
+	0xbe, 0xb8, 0xc1, 0xa5, 0xf2, 0x0a, 0x4c, 0xbb, 0x7a, 0x95, 0xf5, 0x1c, 0xc1, 0x87, 0x59, 0xe5,
+	0x48, 0x50, 0x7e, 0x26, 0xc1, 0x52, 0x5d, 0x65, 0x5a, 0x3b, 0xe6, 0xfc, 0x25, 0x98, 0x67, 0xe4,
+	0x31, 0xda, 0x4d, 0xcd, 0x0b, 0xd0, 0xa3, 0xf3, 0x1c, 0x97, 0xfa, 0x51, 0xcb, 0x25, 0x98, 0x69,
+	0xb9, 0xbb, 0x23, 0xde, 0x02, 0x17, 0xfd, 0xa7, 0x6e, 0x7e, 0x2d, 0xc1, 0x79, 0x01, 0x6c, 0x20,
+	0x8b, 0xb9, 0xba, 0x0e, 0x8b, 0xc2, 0x72, 0x93, 0x22, 0xf3, 0x1c, 0x11, 0xbc, 0x9b, 0xa7, 0xfe,
+	0x96, 0x63, 0x9d, 0x49, 0x9d, 0xec, 0x4c, 0x3a, 0xee, 0xcc, 0x06, 0x5c, 0x3e, 0x81, 0x8e, 0x01,
+	0x75, 0x7b, 0x70, 0x6e, 0x08, 0xba, 0xd7, 0x77, 0x5f, 0xf0, 0x8f, 0x61, 0x12, 0xdd, 0x87, 0x91,
+	0x4c, 0xcd, 0xbe, 0x7a, 0x5e, 0x99, 0x8b, 0xec, 0x53, 0xc4, 0xae, 0x13, 0x98, 0xb9, 0x06, 0xc5,
+	0xe4, 0x63, 0x03, 0xc7, 0x5e, 0x48, 0xb0, 0xb0, 0x4f, 0x8d, 0x5d, 0xec, 0xa0, 0xa1, 0x32, 0xbc,
+	0x8b, 0x03, 0x2a, 0x5f, 0x85, 0xac, 0xc7, 0x32, 0xe2, 0x34, 0x55, 0x5d, 0x77, 0x90, 0x52, 0xaf,
+	0xec, 0x8b, 0x81, 0xe2, 0x96, 0x90, 0xcb, 0x5b, 0x90, 0x23, 0x8e, 0xd6, 0x46, 0xca, 0x9c, 0x08,
+	0x5e, 0xb8, 0xb3, 0x14, 0xd6, 0xf9, 0x5b, 0x36, 0x60, 0x31, 0x48, 0xbf, 0x0f, 0x17, 0x64, 0x08,
+	0xca, 0xe2, 0x43, 0x2f, 0xc2, 0x1c, 0xb2, 0x76, 0x33, 0xce, 0x88, 0x59, 0x64, 0xed, 0x46, 0x50,
+	0x87, 0x65, 0x38, 0x1f, 0x0b, 0x21, 0x08, 0xef, 0x01, 0x2c, 0x85, 0xe5, 0xee, 0x9e, 0x7d, 0x6a,
+	0x9c, 0x2e, 0xc2, 0x1c, 0x4c, 0x86, 0x59, 0x2d, 0x16, 0xe5, 0x07, 0x70, 0x76, 0x9f, 0x1a, 0x7e,
+	0x52, 0xef, 0xa0, 0x69, 0xb4, 0xd9, 0x67, 0x84, 0x45, 0xc9, 0xd5, 0xe6, 0x62, 0x9f, 0x85, 0x18,
+	0x01, 0x1f, 0x57, 0xba, 0x72, 0x09, 0x56, 0x13, 0x2d, 0x07, 0x41, 0x7d, 0x2f, 0xc1, 0x6a, 0x50,
+	0xd6, 0xba, 0xaa, 0x07, 0x99, 0xd8, 0xeb, 0x9b, 0x3a, 0xba, 0x04, 0xbf, 0x09, 0x67, 0x68, 0xaf,
+	0xf5, 0x08, 0xb5, 0xd1, 0xb4, 0x9a, 0x7f, 0xf5, 0xbc, 0x02, 0x9f, 0xf6, 0x98, 0x41, 0x4c, 0xdb,
+	0x38, 0x38, 0x54, 0xfc, 0x4d, 0x51, 0xde, 0xa7, 0x62, 0xbc, 0x0f, 0x39, 0x9e, 0x4e, 0xe0, 0xdc,
+	0x65, 0xb8, 0x34, 0xd2, 0xb9, 0x20, 0x8c, 0x3f, 0x25, 0xc8, 0xed, 0x53, 0x43, 0x71, 0x3b, 0x2d,
+	0xfa, 0xe1, 0xde, 0xc5, 0xc1, 0xff, 0x89, 0x7f, 0x57, 0x20, 0x4b, 0x3a, 0x7a, 0x33, 0x89, 0x83,
+	0x0b, 0xa4, 0xa3, 0xef, 0x85, 0x68, 0xe8, 0x62, 0x6d, 0x7c, 0x12, 0xc3, 0x4e, 0x0a, 0xac, 0x8d,
+	0x4f, 0xc2, 0xd8, 0x72, 0x11, 0x56, 0x92, 0x42, 0x0f, 0x72, 0xf3, 0x87, 0x04, 0xf9, 0x21, 0xed,
+	0xbf, 0x62, 0xef, 0x26, 0xe4, 0xbc, 0x08, 0xa2, 0x01, 0x8b, 0xfc, 0xc8, 0x22, 0x88, 0x48, 0xcc,
+	0x9b, 0x90, 0xf3, 0xe2, 0x48, 0x4a, 0x91, 0x2c, 0x42, 0x89, 0xec, 0x38, 0xae, 0x06, 0x99, 0xe3,
+	0x6b, 0x10, 0xbc, 0x54, 0x93, 0xe1, 0x97, 0xea, 0x59, 0x0a, 0xb2, 0xa2, 0xf9, 0xef, 0xf0, 0x41,
+	0x45, 0x5c, 0x91, 0x25, 0x98, 0xe1, 0x97, 0x5d, 0xe4, 0x4e, 0x07, 0x2e, 0x12, 0xf7, 0xf9, 0x70,
+	0x93, 0x4a, 0x25, 0x35, 0xa9, 0xdb, 0x91, 0x59, 0x6a, 0xba, 0x5e, 0x75, 0x67, 0x9e, 0x5f, 0xde,
+	0x94, 0xde, 0x37, 0x4c, 0xd6, 0xee, 0xb5, 0xaa, 0x1a, 0xb1, 0xbc, 0x11, 0xd2, 0xfb, 0xa9, 0x50,
+	0xfd, 0x71, 0x8d, 0x0d, 0xba, 0x48, 0xab, 0x9f, 0xd8, 0x2c, 0x18, 0xad, 0x22, 0xed, 0x43, 0xcc,
+	0x32, 0x99, 0x58, 0xfb, 0xe0, 0x52, 0x17, 0xe8, 0xcd, 0xa7, 0x0e, 0x6a, 0x68, 0xf6, 0xd1, 0xe1,
+	0xe1, 0x4e, 0x2b, 0xf3, 0x42, 0xac, 0x78, 0xd2, 0xa4, 0x3b, 0x63, 0x2a, 0xe9, 0xce, 0xb8, 0x91,
+	0xf9, 0xfd, 0x69, 0x49, 0x2a, 0xff, 0x20, 0x81, 0xcc, 0x9b, 0xf5, 0xde, 0x21, 0x6a, 0x3d, 0x86,
+	0xba, 0xc8, 0xd3, 0xf8, 0xbd, 0x3a, 0x9c, 0xce, 0xd4, 0x50, 0x3a, 0x13, 0xbc, 0x49, 0x27, 0xde,
+	0x60, 0xb1, 0xae, 0x9f, 0x89, 0x77, 0xfd, 0xf2, 0xdf, 0x12, 0x2c, 0x87, 0x27, 0xa3, 0xa8, 0xbf,
+	0x27, 0xd6, 0xd5, 0x48, 0x9c, 0x9c, 0xf8, 0x7d, 0x54, 0xff, 0xf0, 0xaf, 0x37, 0xa5, 0xeb, 0xa1,
+	0xc2, 0x31, 0x9e, 0x72, 0xcb, 0xb4, 0x59, 0xf8, 0xb1, 0x63, 0xb6, 0x68, 0xad, 0x35, 0x60, 0x48,
+	0xab, 0x77, 0xf0, 0xb0, 0xee, 0x3e, 0x8c, 0x3f, 0x73, 0xa5, 0xc7, 0x99, 0xb9, 0xbc, 0x04, 0x65,
+	0x92, 0x12, 0x54, 0xfe, 0x36, 0x05, 0xf2, 0x9e, 0xb2, 0xb3, 0xbd, 0xb9, 0x8b, 0xdd, 0x0e, 0x19,
+	0x8c, 0x1d, 0xf8, 0x05, 0x98, 0x15, 0x0c, 0x69, 0xea, 0x68, 0x13, 0xcb, 0xa3, 0xf3, 0x8c, 0x90,
+	0xed, 0xba, 0xa2, 0x84, 0x62, 0xa7, 0x93, 0x8a, 0xbd, 0x0a, 0x80, 0x8e, 0xb6, 0xbd, 0xd9, 0xb4,
+	0x55, 0x0b, 0x3d, 0x9a, 0x4e, 0x73, 0xc9, 0x3d, 0xd5, 0xe2, 0x07, 0x09, 0x35, 0x1d, 0x58, 0x2d,
+	0xd2, 0xf1, 0xe8, 0x39, 0xc3, 0x65, 0x0d, 0x2e, 0x72, 0x0f, 0x12, 0x10, 0x1d, 0x35, 0xd3, 0x52,
+	0x3b, 0xd4, 0xa3, 0xe6, 0x1c, 0x97, 0xee, 0x7a, 0xc2, 0xa4, 0x9c, 0x9c, 0x49, 0xcc, 0xc9, 0x8f,
+	0x12, 0xe4, 0x43, 0x23, 0xdc, 0x29, 0x29, 0x51, 0x81, 0xa5, 0xd0, 0x90, 0xc7, 0x0e, 0x23, 0x24,
+	0x5e, 0xa4, 0x47, 0x76, 0x4f, 0x49, 0xe5, 0xeb, 0x70, 0xc6, 0x42, 0xab, 0x85, 0x8e, 0x7b, 0x6b,
+	0xa5, 0xd7, 0x67, 0xb6, 0x0b, 0xd5, 0xa3, 0xcf, 0xd0, 0xea, 0x5e, 0x64, 0x2c, 0x54, 0x7c, 0xe8,
+	0xf6, 0xaf, 0x53, 0x90, 0x76, 0x6f, 0xe4, 0x07, 0x30, 0x1f, 0xfb, 0xec, 0x59, 0x0d, 0x6f, 0x1f,
+	0xfa, 0x90, 0x2a, 0x5c, 0x1a, 0xa9, 0x0e, 0xda, 0xc0, 0x84, 0xfc, 0x08, 0x72, 0x89, 0x9f, 0x55,
+	0x17, 0x63, 0x06, 0x92, 0x40, 0x85, 0xab, 0x63, 0x80, 0x42, 0x67, 0x7d, 0x29, 0xc1, 0xca, 0xc8,
+	0x8f, 0xab, 0xb8, 0xbd, 0x51, 0xe0, 0xc2, 0xb5, 0x53, 0x80, 0x43, 0x4e, 0x18, 0xb0, 0x94, 0x34,
+	0x26, 0x97, 0x47, 0x5a, 0xe3, 0x98, 0xc2, 0x95, 0x93, 0x31, 0xa1, 0x83, 0xee, 0xc3, 0x42, 0x03,
+	0x59, 0x64, 0xf0, 0x7d, 0x2f, 0x66, 0x20, 0xac, 0x2c, 0x5c, 0x1c, 0xa1, 0x8c, 0x14, 0x2c, 0x1f,
+	0x3d, 0x37, 0x34, 0x1a, 0x5e, 0x88, 0x99, 0x18, 0x86, 0x14, 0x36, 0x4e, 0x84, 0x84, 0xce, 0xfa,
+	0x02, 0x0a, 0x23, 0x86, 0xc0, 0x8d, 0xc4, 0x74, 0x24, 0x41, 0x0b, 0x5b, 0x63, 0x43, 0x43, 0xa7,
+	0xab, 0x90, 0x1d, 0x9e, 0xdd, 0xd6, 0x62, 0x96, 0x86, 0x10, 0x85, 0xf5, 0x93, 0x10, 0x47, 0x47,
+	0xd4, 0xef, 0xbf, 0x7c, 0x5b, 0x94, 0x5e, 0xbf, 0x2d, 0x4a, 0xbf, 0xbd, 0x2d, 0x4a, 0xdf, 0xbc,
+	0x2b, 0x4e, 0xbc, 0x7e, 0x57, 0x9c, 0xf8, 0xf9, 0x5d, 0x71, 0xe2, 0xf3, 0x8f, 0x42, 0x57, 0x7f,
+	0x17, 0x0d, 0x63, 0xf0, 0xa8, 0xef, 0xff, 0xcb, 0x53, 0x11, 0x7f, 0x62, 0xd4, 0x2c, 0xa2, 0xf7,
+	0x3a, 0x58, 0xeb, 0x5f, 0xab, 0x1d, 0xfa, 0x2a, 0xd1, 0xcc, 0x5b, 0x53, 0x7c, 0x0a, 0xbe, 0xf6,
+	0xcf, 0x00, 0x94, 0xfd, 0xf4, 0x81, 0x81, 0x12, 0x00, 0x00,
 }
 
 func (this *SendToCosmosEvent) Equal(that interface{}) bool {
@@ -1403,6 +1696,8 @@ type MsgClient interface {
 	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error)
+	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	RotateEthereumKey(ctx context.Context, in *MsgRotateEthereumKey, opts ...grpc.CallOption) (*MsgRotateEthereumKeyResponse, error)
 }
 
 type msgClient struct {
@@ -1467,6 +1762,24 @@ func (c *msgClient) SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereu
 	return out, nil
 }
 
+func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	out := new(MsgSubmitBadSignatureEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateEthereumKey(ctx context.Context, in *MsgRotateEthereumKey, opts ...grpc.CallOption) (*MsgRotateEthereumKeyResponse, error) {
+	out := new(MsgRotateEthereumKeyResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RotateEthereumKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
@@ -1475,6 +1788,8 @@ type MsgServer interface {
 	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(context.Context, *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error)
+	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	RotateEthereumKey(context.Context, *MsgRotateEthereumKey) (*MsgRotateEthereumKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1499,6 +1814,12 @@ func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) SubmitEthereumHeightVote(ctx context.Context, req *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeightVote not implemented")
 }
+func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
+}
+func (*UnimplementedMsgServer) RotateEthereumKey(ctx context.Context, req *MsgRotateEthereumKey) (*MsgRotateEthereumKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEthereumKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1612,6 +1933,42 @@ func _Msg_SubmitEthereumHeightVote_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadSignatureEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitBadSignatureEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, req.(*MsgSubmitBadSignatureEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateEthereumKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateEthereumKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateEthereumKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RotateEthereumKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateEthereumKey(ctx, req.(*MsgRotateEthereumKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1640,6 +1997,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEthereumHeightVote",
 			Handler:    _Msg_SubmitEthereumHeightVote_Handler,
 		},
+		{
+			MethodName: "SubmitBadSignatureEvidence",
+			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
+		},
+		{
+			MethodName: "RotateEthereumKey",
+			Handler:    _Msg_RotateEthereumKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -2225,7 +2590,7 @@ func (m *MsgEthereumHeightVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2235,40 +2600,249 @@ func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1a
 	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateEthereumKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateEthereumKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateEthereumKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewEthSignature) > 0 {
+		i -= len(m.NewEthSignature)
+		copy(dAtA[i:], m.NewEthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewEthSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OldEthSignature) > 0 {
+		i -= len(m.OldEthSignature)
+		copy(dAtA[i:], m.OldEthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OldEthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateEthereumKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateEthereumKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateEthereumKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateEthereumKeySignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateEthereumKeySignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateEthereumKeySignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewEthereumAddress) > 0 {
+		i -= len(m.NewEthereumAddress)
+		copy(dAtA[i:], m.NewEthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewEthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldEthereumAddress) > 0 {
+		i -= len(m.OldEthereumAddress)
+		copy(dAtA[i:], m.OldEthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OldEthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
 		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
@@ -2761,114 +3335,103 @@ func (m *MsgEthereumHeightVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *SendToCosmosEvent) Size() (n int) {
+func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
+	if m.Subject != nil {
+		l = m.Subject.Size()
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.EthereumSender)
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.CosmosReceiver)
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
-	}
 	return n
 }
 
-func (m *BatchExecutedEvent) Size() (n int) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
-	}
 	return n
 }
 
-func (m *ContractCallExecutedEvent) Size() (n int) {
+func (m *MsgRotateEthereumKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.InvalidationScope)
+	l = len(m.OrchestratorAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	l = len(m.OldEthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NewEthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *ERC20DeployedEvent) Size() (n int) {
+func (m *MsgRotateEthereumKeyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	return n
+}
+
+func (m *RotateEthereumKeySignMsg) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.CosmosDenom)
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.TokenContract)
+	l = len(m.OldEthereumAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Erc20Name)
+	l = len(m.NewEthereumAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Erc20Symbol)
+	l = len(m.OrchestratorAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	if m.Erc20Decimals != 0 {
-		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
-	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *SignerSetTxExecutedEvent) Size() (n int) {
+func (m *SendToCosmosEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2877,10 +3440,117 @@ func (m *SignerSetTxExecutedEvent) Size() (n int) {
 	if m.EventNonce != 0 {
 		n += 1 + sovMsgs(uint64(m.EventNonce))
 	}
-	if m.SignerSetTxNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
-	}
-	if m.EthereumHeight != 0 {
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *BatchExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *ContractCallExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *ERC20DeployedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *SignerSetTxExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.SignerSetTxNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	}
+	if m.EthereumHeight != 0 {
 		n += 1 + sovMsgs(uint64(m.EthereumHeight))
 	}
 	if len(m.Members) > 0 {
@@ -3846,25 +4516,675 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3887,7 +5207,7 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3910,10 +5230,10 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3937,7 +5257,7 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3960,15 +5280,15 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3995,14 +5315,48 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types1.Any{}
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4055,7 +5409,7 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4078,10 +5432,10 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4105,7 +5459,7 @@ func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateEthereumKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4128,10 +5482,10 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateEthereumKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateEthereumKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4232,7 +5586,7 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEthSignature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4259,9 +5613,43 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EthSignature == nil {
-				m.EthSignature = []byte{}
+			m.OldEthSignature = append(m.OldEthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldEthSignature == nil {
+				m.OldEthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEthSignature = append(m.NewEthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewEthSignature == nil {
+				m.NewEthSignature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4285,7 +5673,7 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateEthereumKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,10 +5696,10 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateEthereumKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateEthereumKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4335,7 +5723,7 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+func (m *RotateEthereumKeySignMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,10 +5746,10 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateEthereumKeySignMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateEthereumKeySignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4397,10 +5785,10 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEthereumAddress", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4410,66 +5798,29 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumHeightVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			m.OldEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEthereumAddress", wireType)
 			}
-			m.EthereumHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4479,14 +5830,27 @@ func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4514,58 +5878,27 @@ func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
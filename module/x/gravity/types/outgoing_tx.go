@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -31,6 +33,66 @@ type ABIEncodedValsetArgs struct {
 	RewardToken  gethcommon.Address   `abi:"rewardToken"`
 }
 
+type abiEncodedValSignature struct {
+	V uint8    `abi:"v"`
+	R [32]byte `abi:"r"`
+	S [32]byte `abi:"s"`
+}
+
+type abiEncodedLogicCallArgs struct {
+	TransferAmounts        []*big.Int           `abi:"transferAmounts"`
+	TransferTokenContracts []gethcommon.Address `abi:"transferTokenContracts"`
+	FeeAmounts             []*big.Int           `abi:"feeAmounts"`
+	FeeTokenContracts      []gethcommon.Address `abi:"feeTokenContracts"`
+	LogicContractAddress   gethcommon.Address   `abi:"logicContractAddress"`
+	Payload                []byte               `abi:"payload"`
+	TimeOut                *big.Int             `abi:"timeOut"`
+	InvalidationID         [32]byte             `abi:"invalidationId"`
+	InvalidationNonce      *big.Int             `abi:"invalidationNonce"`
+}
+
+// OutgoingTxTypeAndNonce returns the store index prefix byte of the type of
+// the outgoing tx and the nonce the Ethereum contract orders it by
+func OutgoingTxTypeAndNonce(otx OutgoingTx) (byte, uint64) {
+	switch otx := otx.(type) {
+	case *SignerSetTx:
+		return SignerSetTxPrefixByte, otx.Nonce
+	case *BatchTx:
+		return BatchTxPrefixByte, otx.BatchNonce
+	case *ContractCallTx:
+		return ContractCallTxPrefixByte, otx.InvalidationNonce
+	}
+	panic(fmt.Sprintf("unknown outgoing tx type %T", otx))
+}
+
+// Floor returns the floor of the outgoing tx type
+func (f PastEthereumSignatureCheckpointFloors) Floor(txType byte) uint64 {
+	switch txType {
+	case SignerSetTxPrefixByte:
+		return f.SignerSetNonce
+	case BatchTxPrefixByte:
+		return f.BatchNonce
+	case ContractCallTxPrefixByte:
+		return f.ContractCallInvalidationNonce
+	}
+	return 0
+}
+
+// Raise raises the floor of the outgoing tx type to the nonce, floors are never lowered
+func (f *PastEthereumSignatureCheckpointFloors) Raise(txType byte, nonce uint64) {
+	if nonce <= f.Floor(txType) {
+		return
+	}
+	switch txType {
+	case SignerSetTxPrefixByte:
+		f.SignerSetNonce = nonce
+	case BatchTxPrefixByte:
+		f.BatchNonce = nonce
+	case ContractCallTxPrefixByte:
+		f.ContractCallInvalidationNonce = nonce
+	}
+}
+
 ///////////////////
 // GetStoreIndex //
 ///////////////////
@@ -70,6 +132,11 @@ func (cctx *ContractCallTx) GetCosmosHeight() uint64 {
 
 // GetCheckpoint returns the checkpoint
 func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
+	return packCall(u.checkpointCall(gravityID))
+}
+
+// checkpointCall returns the ABI and arguments the checkpoint is encoded with
+func (u SignerSetTx) checkpointCall(gravityID []byte) (abiString, method string, args []interface{}) {
 
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
@@ -85,32 +152,31 @@ func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
 	copy(checkpoint[:], checkpointBytes[:])
 
 	u.Signers.Sort()
-
-	memberAddresses := make([]gethcommon.Address, len(u.Signers))
-	convertedPowers := make([]*big.Int, len(u.Signers))
-	for i, m := range u.Signers {
-		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
-		convertedPowers[i] = big.NewInt(int64(m.Power))
-	}
+	valsetArgs := u.abiEncodedValsetArgs()
 
 	// the word 'checkpoint' needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
+	args = []interface{}{
 		gravityIDFixed,
 		checkpoint,
-		big.NewInt(int64(u.Nonce)),
-		memberAddresses,
-		convertedPowers,
-		big.NewInt(0),
-		gethcommon.HexToAddress("0x0000000000000000000000000000000000000000"),
+		valsetArgs.Nonce,
+		valsetArgs.Validators,
+		valsetArgs.Powers,
+		valsetArgs.RewardAmount,
+		valsetArgs.RewardToken,
 	}
 
-	return packCall(ValsetCheckpointABIJSON, "checkpoint", args)
+	return ValsetCheckpointABIJSON, "checkpoint", args
 }
 
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
+	return packCall(b.checkpointCall(gravityID))
+}
+
+// checkpointCall returns the ABI and arguments the checkpoint is encoded with
+func (b BatchTx) checkpointCall(gravityID []byte) (abiString, method string, args []interface{}) {
 
 	// the contract argument is not a arbitrary length array but a fixed length 32 byte
 	// array, therefore we have to utf8 encode the string (the default in this case) and
@@ -127,19 +193,12 @@ func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
 	copy(batchMethodName[:], methodNameBytes[:])
 
 	// Run through the elements of the batch and serialize them
-	txAmounts := make([]*big.Int, len(b.Transactions))
-	txDestinations := make([]gethcommon.Address, len(b.Transactions))
-	txFees := make([]*big.Int, len(b.Transactions))
-	for i, tx := range b.Transactions {
-		txAmounts[i] = tx.Erc20Token.Amount.BigInt()
-		txDestinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
-		txFees[i] = tx.Erc20Fee.Amount.BigInt()
-	}
+	txAmounts, txDestinations, txFees := b.abiEncodedTransactions()
 
 	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
+	args = []interface{}{
 		gravityIDFixed,
 		batchMethodName,
 		txAmounts,
@@ -150,11 +209,16 @@ func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
 		big.NewInt(int64(b.Timeout)),
 	}
 
-	return packCall(OutgoingBatchTxCheckpointABIJSON, "submitBatch", args)
+	return OutgoingBatchTxCheckpointABIJSON, "submitBatch", args
 }
 
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
+	return packCall(c.checkpointCall(gravityID))
+}
+
+// checkpointCall returns the ABI and arguments the checkpoint is encoded with
+func (c ContractCallTx) checkpointCall(gravityID []byte) (abiString, method string, args []interface{}) {
 	// Create the methodName argument which salts the signature
 	methodNameBytes := []uint8("logicCall")
 	var logicCallMethodName [32]uint8
@@ -170,44 +234,152 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 	}
 
 	// Run through the elements of the logic call and serialize them
-	transferAmounts := make([]*big.Int, len(c.Tokens))
-	transferTokenContracts := make([]gethcommon.Address, len(c.Tokens))
-	feeAmounts := make([]*big.Int, len(c.Fees))
-	feeTokenContracts := make([]gethcommon.Address, len(c.Fees))
-	for i, coin := range c.Tokens {
-		transferAmounts[i] = coin.Amount.BigInt()
-		transferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
-	}
-	for i, coin := range c.Fees {
-		feeAmounts[i] = coin.Amount.BigInt()
-		feeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
-	}
-	payload := make([]byte, len(c.Payload))
-	copy(payload, c.Payload)
-	var invalidationId [32]byte
-	copy(invalidationId[:], c.InvalidationScope[:])
+	callArgs := c.abiEncodedLogicCallArgs()
 
 	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
+	args = []interface{}{
 		gravityIDFixed,
 		logicCallMethodName,
-		transferAmounts,
-		transferTokenContracts,
-		feeAmounts,
-		feeTokenContracts,
-		gethcommon.HexToAddress(c.Address),
-		payload,
-		big.NewInt(int64(c.Timeout)),
-		invalidationId,
-		big.NewInt(int64(c.InvalidationNonce)),
+		callArgs.TransferAmounts,
+		callArgs.TransferTokenContracts,
+		callArgs.FeeAmounts,
+		callArgs.FeeTokenContracts,
+		callArgs.LogicContractAddress,
+		callArgs.Payload,
+		callArgs.TimeOut,
+		callArgs.InvalidationID,
+		callArgs.InvalidationNonce,
+	}
+
+	return OutgoingLogicCallABIJSON, "checkpoint", args
+}
+
+// abiEncodedValsetArgs returns the signer set as Gravity.sol ValsetArgs, with
+// the signers in their current order
+func (u SignerSetTx) abiEncodedValsetArgs() ABIEncodedValsetArgs {
+	memberAddresses := make([]gethcommon.Address, len(u.Signers))
+	convertedPowers := make([]*big.Int, len(u.Signers))
+	for i, m := range u.Signers {
+		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
+		convertedPowers[i] = big.NewInt(int64(m.Power))
+	}
+
+	return ABIEncodedValsetArgs{
+		Validators:   memberAddresses,
+		Powers:       convertedPowers,
+		Nonce:        big.NewInt(int64(u.Nonce)),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  gethcommon.HexToAddress("0x0000000000000000000000000000000000000000"),
+	}
+}
+
+// abiEncodedTransactions returns the amounts, destinations and fees of the batch
+func (b BatchTx) abiEncodedTransactions() (amounts []*big.Int, destinations []gethcommon.Address, fees []*big.Int) {
+	amounts = make([]*big.Int, len(b.Transactions))
+	destinations = make([]gethcommon.Address, len(b.Transactions))
+	fees = make([]*big.Int, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.BigInt()
+		destinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
+		fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+	return amounts, destinations, fees
+}
+
+// abiEncodedLogicCallArgs returns the contract call as Gravity.sol LogicCallArgs
+func (c ContractCallTx) abiEncodedLogicCallArgs() abiEncodedLogicCallArgs {
+	args := abiEncodedLogicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(c.Tokens)),
+		TransferTokenContracts: make([]gethcommon.Address, len(c.Tokens)),
+		FeeAmounts:             make([]*big.Int, len(c.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(c.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(c.Address),
+		Payload:                make([]byte, len(c.Payload)),
+		TimeOut:                big.NewInt(int64(c.Timeout)),
+		InvalidationNonce:      big.NewInt(int64(c.InvalidationNonce)),
+	}
+	for i, coin := range c.Tokens {
+		args.TransferAmounts[i] = coin.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	for i, coin := range c.Fees {
+		args.FeeAmounts[i] = coin.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	copy(args.Payload, c.Payload)
+	copy(args.InvalidationID[:], c.InvalidationScope[:])
+
+	return args
+}
+
+// CheckpointFields returns the ABI decoded arguments of the outgoing tx
+// checkpoint, with the values rendered as text
+func CheckpointFields(otx OutgoingTx, gravityID []byte) []CheckpointField {
+	caller, ok := otx.(interface {
+		checkpointCall([]byte) (string, string, []interface{})
+	})
+	if !ok {
+		panic(sdkerrors.Wrapf(ErrInvalid, "no checkpoint encoding for %T", otx))
+	}
+
+	abiString, method, args := caller.checkpointCall(gravityID)
+	encodedCall, err := abi.JSON(strings.NewReader(abiString))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+	}
+	inputs := encodedCall.Methods[method].Inputs
+	values, err := inputs.Unpack(packCheckpointArgs(abiString, method, args))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unpacking checkpoint"))
 	}
 
-	return packCall(OutgoingLogicCallABIJSON, "checkpoint", args)
+	fields := make([]CheckpointField, len(inputs))
+	for i, input := range inputs {
+		fields[i] = CheckpointField{
+			Name:  strings.TrimPrefix(input.Name, "_"),
+			Type:  input.Type.String(),
+			Value: formatABIValue(values[i]),
+		}
+	}
+	return fields
+}
+
+func formatABIValue(value interface{}) string {
+	switch v := value.(type) {
+	case [32]byte:
+		return "0x" + hex.EncodeToString(v[:])
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case gethcommon.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []gethcommon.Address:
+		out := make([]string, len(v))
+		for i, addr := range v {
+			out[i] = addr.Hex()
+		}
+		return "[" + strings.Join(out, ",") + "]"
+	case []*big.Int:
+		out := make([]string, len(v))
+		for i, n := range v {
+			out[i] = n.String()
+		}
+		return "[" + strings.Join(out, ",") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
 }
 
 func packCall(abiString, method string, args []interface{}) []byte {
+	return crypto.Keccak256Hash(packCheckpointArgs(abiString, method, args)).Bytes()
+}
+
+// packCheckpointArgs ABI encodes the checkpoint arguments without the
+// function selector, giving the bytes Gravity.sol hashes into a checkpoint
+func packCheckpointArgs(abiString, method string, args []interface{}) []byte {
 	encodedCall, err := abi.JSON(strings.NewReader(abiString))
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
@@ -216,5 +388,5 @@ func packCall(abiString, method string, args []interface{}) []byte {
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "packing checkpoint"))
 	}
-	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+	return abiEncodedCall[4:]
 }
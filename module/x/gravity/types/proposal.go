@@ -12,14 +12,21 @@ import (
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+	// ProposalTypeForceSignerSetTx defines the type for a ForceSignerSetTxProposal
+	ProposalTypeForceSignerSetTx = "ForceSignerSetTx"
 )
 
-// Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+// Assert CommunityPoolEthereumSpendProposal and ForceSignerSetTxProposal implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+	_ govtypes.Content = &ForceSignerSetTxProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolEthereumSpendProposal{}, "gravity/CommunityPoolEthereumSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeForceSignerSetTx)
+	govtypes.RegisterProposalTypeCodec(&ForceSignerSetTxProposal{}, "gravity/ForceSignerSetTxProposal")
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -80,3 +87,37 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewForceSignerSetTxProposal creates a new force signer set tx proposal.
+func NewForceSignerSetTxProposal(title, description string) *ForceSignerSetTxProposal {
+	return &ForceSignerSetTxProposal{title, description}
+}
+
+// GetTitle returns the title of a force signer set tx proposal.
+func (fsp *ForceSignerSetTxProposal) GetTitle() string { return fsp.Title }
+
+// GetDescription returns the description of a force signer set tx proposal.
+func (fsp *ForceSignerSetTxProposal) GetDescription() string { return fsp.Description }
+
+// ProposalRoute returns the routing key of a force signer set tx proposal.
+func (fsp *ForceSignerSetTxProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a force signer set tx proposal.
+func (fsp *ForceSignerSetTxProposal) ProposalType() string {
+	return ProposalTypeForceSignerSetTx
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (fsp *ForceSignerSetTxProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(fsp)
+}
+
+// String implements the Stringer interface.
+func (fsp ForceSignerSetTxProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Force Signer Set Tx Proposal:
+  Title:       %s
+  Description: %s
+`, fsp.Title, fsp.Description))
+	return b.String()
+}
@@ -6,12 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -32,6 +34,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HealthSeverity is how far a bridge health value is past its thresholds
+type HealthSeverity int32
+
+const (
+	HealthSeverity_HEALTH_SEVERITY_OK       HealthSeverity = 0
+	HealthSeverity_HEALTH_SEVERITY_WARNING  HealthSeverity = 1
+	HealthSeverity_HEALTH_SEVERITY_CRITICAL HealthSeverity = 2
+)
+
+var HealthSeverity_name = map[int32]string{
+	0: "HEALTH_SEVERITY_OK",
+	1: "HEALTH_SEVERITY_WARNING",
+	2: "HEALTH_SEVERITY_CRITICAL",
+}
+
+var HealthSeverity_value = map[string]int32{
+	"HEALTH_SEVERITY_OK":       0,
+	"HEALTH_SEVERITY_WARNING":  1,
+	"HEALTH_SEVERITY_CRITICAL": 2,
+}
+
+func (x HealthSeverity) String() string {
+	return proto.EnumName(HealthSeverity_name, int32(x))
+}
+
+func (HealthSeverity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{0}
+}
+
 // rpc Params
 type ParamsRequest struct {
 }
@@ -434,7 +465,8 @@ func (m *ContractCallTxResponse) GetLogicCall() *ContractCallTx {
 
 // rpc SignerSetTxConfirmations
 type SignerSetTxConfirmationsRequest struct {
-	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	SignerSetNonce uint64             `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SignerSetTxConfirmationsRequest) Reset()         { *m = SignerSetTxConfirmationsRequest{} }
@@ -477,8 +509,16 @@ func (m *SignerSetTxConfirmationsRequest) GetSignerSetNonce() uint64 {
 	return 0
 }
 
+func (m *SignerSetTxConfirmationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type SignerSetTxConfirmationsResponse struct {
 	Signatures []*SignerSetTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Pagination *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SignerSetTxConfirmationsResponse) Reset()         { *m = SignerSetTxConfirmationsResponse{} }
@@ -521,9 +561,20 @@ func (m *SignerSetTxConfirmationsResponse) GetSignatures() []*SignerSetTxConfirm
 	return nil
 }
 
+func (m *SignerSetTxConfirmationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // rpc SignerSetTxs
 type SignerSetTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return signer sets with a nonce greater than since_nonce
+	SinceNonce uint64 `protobuf:"varint,2,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	// only return signer sets with a nonce up to until_nonce, if set
+	UntilNonce uint64 `protobuf:"varint,3,opt,name=until_nonce,json=untilNonce,proto3" json:"until_nonce,omitempty"`
 }
 
 func (m *SignerSetTxsRequest) Reset()         { *m = SignerSetTxsRequest{} }
@@ -566,6 +617,20 @@ func (m *SignerSetTxsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *SignerSetTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *SignerSetTxsRequest) GetUntilNonce() uint64 {
+	if m != nil {
+		return m.UntilNonce
+	}
+	return 0
+}
+
 type SignerSetTxsResponse struct {
 	SignerSets []*SignerSetTx      `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -618,16 +683,203 @@ func (m *SignerSetTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type SignerSetDiffRequest struct {
+}
+
+func (m *SignerSetDiffRequest) Reset()         { *m = SignerSetDiffRequest{} }
+func (m *SignerSetDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetDiffRequest) ProtoMessage()    {}
+func (*SignerSetDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{13}
+}
+func (m *SignerSetDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetDiffRequest.Merge(m, src)
+}
+func (m *SignerSetDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetDiffRequest proto.InternalMessageInfo
+
+// SignerSetDiffResponse holds the normalized power diff between the current
+// signer set and the latest signer set tx, the threshold above which the end
+// blocker creates a new signer set tx, and the signers whose power changed in
+// Ethereum address order
+type SignerSetDiffResponse struct {
+	LatestSignerSetNonce uint64                                 `protobuf:"varint,1,opt,name=latest_signer_set_nonce,json=latestSignerSetNonce,proto3" json:"latest_signer_set_nonce,omitempty"`
+	PowerDiff            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=power_diff,json=powerDiff,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_diff"`
+	PowerDiffThreshold   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=power_diff_threshold,json=powerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_diff_threshold"`
+	Changes              []SignerPowerChange                    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *SignerSetDiffResponse) Reset()         { *m = SignerSetDiffResponse{} }
+func (m *SignerSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetDiffResponse) ProtoMessage()    {}
+func (*SignerSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{14}
+}
+func (m *SignerSetDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetDiffResponse.Merge(m, src)
+}
+func (m *SignerSetDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetDiffResponse proto.InternalMessageInfo
+
+func (m *SignerSetDiffResponse) GetLatestSignerSetNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetNonce
+	}
+	return 0
+}
+
+func (m *SignerSetDiffResponse) GetChanges() []SignerPowerChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// SignerPowerChange is the change in normalized power of an Ethereum address
+// from the latest signer set tx to the current signer set. An added signer is
+// only in the current set, a removed one only in the latest signer set tx.
+type SignerPowerChange struct {
+	EthereumAddress string `protobuf:"bytes,1,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	LatestPower     uint64 `protobuf:"varint,2,opt,name=latest_power,json=latestPower,proto3" json:"latest_power,omitempty"`
+	CurrentPower    uint64 `protobuf:"varint,3,opt,name=current_power,json=currentPower,proto3" json:"current_power,omitempty"`
+	PowerDelta      int64  `protobuf:"varint,4,opt,name=power_delta,json=powerDelta,proto3" json:"power_delta,omitempty"`
+	Added           bool   `protobuf:"varint,5,opt,name=added,proto3" json:"added,omitempty"`
+	Removed         bool   `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *SignerPowerChange) Reset()         { *m = SignerPowerChange{} }
+func (m *SignerPowerChange) String() string { return proto.CompactTextString(m) }
+func (*SignerPowerChange) ProtoMessage()    {}
+func (*SignerPowerChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{15}
+}
+func (m *SignerPowerChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerPowerChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerPowerChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerPowerChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerPowerChange.Merge(m, src)
+}
+func (m *SignerPowerChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerPowerChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerPowerChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerPowerChange proto.InternalMessageInfo
+
+func (m *SignerPowerChange) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+func (m *SignerPowerChange) GetLatestPower() uint64 {
+	if m != nil {
+		return m.LatestPower
+	}
+	return 0
+}
+
+func (m *SignerPowerChange) GetCurrentPower() uint64 {
+	if m != nil {
+		return m.CurrentPower
+	}
+	return 0
+}
+
+func (m *SignerPowerChange) GetPowerDelta() int64 {
+	if m != nil {
+		return m.PowerDelta
+	}
+	return 0
+}
+
+func (m *SignerPowerChange) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+func (m *SignerPowerChange) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
 // rpc BatchTxs
 type BatchTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return batches of this token contract, if set
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// only return batches with a nonce greater than since_nonce
+	SinceNonce uint64 `protobuf:"varint,3,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	// only return batches with a nonce up to until_nonce, if set
+	UntilNonce uint64 `protobuf:"varint,4,opt,name=until_nonce,json=untilNonce,proto3" json:"until_nonce,omitempty"`
 }
 
 func (m *BatchTxsRequest) Reset()         { *m = BatchTxsRequest{} }
 func (m *BatchTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxsRequest) ProtoMessage()    {}
 func (*BatchTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{13}
+	return fileDescriptor_29a9d4192703013c, []int{16}
 }
 func (m *BatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,6 +915,27 @@ func (m *BatchTxsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *BatchTxsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *BatchTxsRequest) GetUntilNonce() uint64 {
+	if m != nil {
+		return m.UntilNonce
+	}
+	return 0
+}
+
 type BatchTxsResponse struct {
 	Batches    []*BatchTx          `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -672,7 +945,7 @@ func (m *BatchTxsResponse) Reset()         { *m = BatchTxsResponse{} }
 func (m *BatchTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxsResponse) ProtoMessage()    {}
 func (*BatchTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{14}
+	return fileDescriptor_29a9d4192703013c, []int{17}
 }
 func (m *BatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,13 +991,19 @@ func (m *BatchTxsResponse) GetPagination() *query.PageResponse {
 // rpc ContractCallTxs
 type ContractCallTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return calls of this invalidation scope, if set
+	InvalidationScope []byte `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	// only return calls with an invalidation nonce greater than since_nonce
+	SinceNonce uint64 `protobuf:"varint,3,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	// only return calls with an invalidation nonce up to until_nonce, if set
+	UntilNonce uint64 `protobuf:"varint,4,opt,name=until_nonce,json=untilNonce,proto3" json:"until_nonce,omitempty"`
 }
 
 func (m *ContractCallTxsRequest) Reset()         { *m = ContractCallTxsRequest{} }
 func (m *ContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxsRequest) ProtoMessage()    {}
 func (*ContractCallTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{15}
+	return fileDescriptor_29a9d4192703013c, []int{18}
 }
 func (m *ContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,6 +1039,27 @@ func (m *ContractCallTxsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *ContractCallTxsRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
+	}
+	return nil
+}
+
+func (m *ContractCallTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *ContractCallTxsRequest) GetUntilNonce() uint64 {
+	if m != nil {
+		return m.UntilNonce
+	}
+	return 0
+}
+
 type ContractCallTxsResponse struct {
 	Calls      []*ContractCallTx   `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -769,7 +1069,7 @@ func (m *ContractCallTxsResponse) Reset()         { *m = ContractCallTxsResponse
 func (m *ContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxsResponse) ProtoMessage()    {}
 func (*ContractCallTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{16}
+	return fileDescriptor_29a9d4192703013c, []int{19}
 }
 func (m *ContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,13 +1117,16 @@ type UnsignedSignerSetTxsRequest struct {
 	// NOTE: this is an sdk.AccAddress and can represent either the
 	// orchestrator address or the corresponding validator address
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// only return signer sets with a nonce greater than since_nonce
+	SinceNonce uint64             `protobuf:"varint,2,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *UnsignedSignerSetTxsRequest) Reset()         { *m = UnsignedSignerSetTxsRequest{} }
 func (m *UnsignedSignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedSignerSetTxsRequest) ProtoMessage()    {}
 func (*UnsignedSignerSetTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{17}
+	return fileDescriptor_29a9d4192703013c, []int{20}
 }
 func (m *UnsignedSignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,15 +1162,30 @@ func (m *UnsignedSignerSetTxsRequest) GetAddress() string {
 	return ""
 }
 
+func (m *UnsignedSignerSetTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *UnsignedSignerSetTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type UnsignedSignerSetTxsResponse struct {
-	SignerSets []*SignerSetTx `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
+	SignerSets []*SignerSetTx      `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *UnsignedSignerSetTxsResponse) Reset()         { *m = UnsignedSignerSetTxsResponse{} }
 func (m *UnsignedSignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedSignerSetTxsResponse) ProtoMessage()    {}
 func (*UnsignedSignerSetTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{18}
+	return fileDescriptor_29a9d4192703013c, []int{21}
 }
 func (m *UnsignedSignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,17 +1221,29 @@ func (m *UnsignedSignerSetTxsResponse) GetSignerSets() []*SignerSetTx {
 	return nil
 }
 
+func (m *UnsignedSignerSetTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type UnsignedBatchTxsRequest struct {
 	// NOTE: this is an sdk.AccAddress and can represent either the
 	// orchestrator address or the corresponding validator address
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// only return batches with a nonce greater than since_nonce
+	SinceNonce uint64             `protobuf:"varint,2,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return batches of this token contract, if set
+	TokenContract string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 }
 
 func (m *UnsignedBatchTxsRequest) Reset()         { *m = UnsignedBatchTxsRequest{} }
 func (m *UnsignedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedBatchTxsRequest) ProtoMessage()    {}
 func (*UnsignedBatchTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{19}
+	return fileDescriptor_29a9d4192703013c, []int{22}
 }
 func (m *UnsignedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,16 +1279,38 @@ func (m *UnsignedBatchTxsRequest) GetAddress() string {
 	return ""
 }
 
+func (m *UnsignedBatchTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *UnsignedBatchTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *UnsignedBatchTxsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
 type UnsignedBatchTxsResponse struct {
 	// Note these are returned with the signature empty
-	Batches []*BatchTx `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	Batches    []*BatchTx          `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *UnsignedBatchTxsResponse) Reset()         { *m = UnsignedBatchTxsResponse{} }
 func (m *UnsignedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedBatchTxsResponse) ProtoMessage()    {}
 func (*UnsignedBatchTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{20}
+	return fileDescriptor_29a9d4192703013c, []int{23}
 }
 func (m *UnsignedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,16 +1346,26 @@ func (m *UnsignedBatchTxsResponse) GetBatches() []*BatchTx {
 	return nil
 }
 
+func (m *UnsignedBatchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // rpc UnsignedContractCallTxs
 type UnsignedContractCallTxsRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// only return calls with an invalidation nonce greater than since_nonce
+	SinceNonce uint64             `protobuf:"varint,2,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *UnsignedContractCallTxsRequest) Reset()         { *m = UnsignedContractCallTxsRequest{} }
 func (m *UnsignedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedContractCallTxsRequest) ProtoMessage()    {}
 func (*UnsignedContractCallTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{21}
+	return fileDescriptor_29a9d4192703013c, []int{24}
 }
 func (m *UnsignedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1039,15 +1401,30 @@ func (m *UnsignedContractCallTxsRequest) GetAddress() string {
 	return ""
 }
 
+func (m *UnsignedContractCallTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
+	}
+	return 0
+}
+
+func (m *UnsignedContractCallTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type UnsignedContractCallTxsResponse struct {
-	Calls []*ContractCallTx `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	Calls      []*ContractCallTx   `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *UnsignedContractCallTxsResponse) Reset()         { *m = UnsignedContractCallTxsResponse{} }
 func (m *UnsignedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedContractCallTxsResponse) ProtoMessage()    {}
 func (*UnsignedContractCallTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{22}
+	return fileDescriptor_29a9d4192703013c, []int{25}
 }
 func (m *UnsignedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,14 +1460,25 @@ func (m *UnsignedContractCallTxsResponse) GetCalls() []*ContractCallTx {
 	return nil
 }
 
-type BatchTxFeesRequest struct {
+func (m *UnsignedContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type BatchTxFeesRequest struct {
+	// only return the fees of batches of this token contract, if set
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// pages through batches, returning the fees of every send in each
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *BatchTxFeesRequest) Reset()         { *m = BatchTxFeesRequest{} }
 func (m *BatchTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxFeesRequest) ProtoMessage()    {}
 func (*BatchTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{23}
+	return fileDescriptor_29a9d4192703013c, []int{26}
 }
 func (m *BatchTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,15 +1507,30 @@ func (m *BatchTxFeesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchTxFeesRequest proto.InternalMessageInfo
 
+func (m *BatchTxFeesRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchTxFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type BatchTxFeesResponse struct {
-	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Fees       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	Pagination *query.PageResponse                      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *BatchTxFeesResponse) Reset()         { *m = BatchTxFeesResponse{} }
 func (m *BatchTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxFeesResponse) ProtoMessage()    {}
 func (*BatchTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{24}
+	return fileDescriptor_29a9d4192703013c, []int{27}
 }
 func (m *BatchTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,16 +1566,160 @@ func (m *BatchTxFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *BatchTxFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type NextBatchPreviewRequest struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the most sends the batch may contain, defaults to the size of the batches
+	// created by the end blocker
+	MaxElements uint64 `protobuf:"varint,2,opt,name=max_elements,json=maxElements,proto3" json:"max_elements,omitempty"`
+}
+
+func (m *NextBatchPreviewRequest) Reset()         { *m = NextBatchPreviewRequest{} }
+func (m *NextBatchPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*NextBatchPreviewRequest) ProtoMessage()    {}
+func (*NextBatchPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{28}
+}
+func (m *NextBatchPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextBatchPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextBatchPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextBatchPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextBatchPreviewRequest.Merge(m, src)
+}
+func (m *NextBatchPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextBatchPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextBatchPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextBatchPreviewRequest proto.InternalMessageInfo
+
+func (m *NextBatchPreviewRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *NextBatchPreviewRequest) GetMaxElements() uint64 {
+	if m != nil {
+		return m.MaxElements
+	}
+	return 0
+}
+
+// NextBatchPreviewResponse is the batch with the nonce and timeout it would
+// get in this block, and its checkpoint. would_create is false when the batch
+// would be empty or would not pay more fees than the last unexecuted batch of
+// the token.
+type NextBatchPreviewResponse struct {
+	Batch             BatchTx                                `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	Checkpoint        []byte                                 `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	SendToEthereumIds []uint64                               `protobuf:"varint,3,rep,packed,name=send_to_ethereum_ids,json=sendToEthereumIds,proto3" json:"send_to_ethereum_ids,omitempty"`
+	TotalFee          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_fee,json=totalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fee"`
+	TotalAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	LastBatchNonce    uint64                                 `protobuf:"varint,6,opt,name=last_batch_nonce,json=lastBatchNonce,proto3" json:"last_batch_nonce,omitempty"`
+	WouldCreate       bool                                   `protobuf:"varint,7,opt,name=would_create,json=wouldCreate,proto3" json:"would_create,omitempty"`
+}
+
+func (m *NextBatchPreviewResponse) Reset()         { *m = NextBatchPreviewResponse{} }
+func (m *NextBatchPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*NextBatchPreviewResponse) ProtoMessage()    {}
+func (*NextBatchPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{29}
+}
+func (m *NextBatchPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextBatchPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextBatchPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextBatchPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextBatchPreviewResponse.Merge(m, src)
+}
+func (m *NextBatchPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextBatchPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextBatchPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextBatchPreviewResponse proto.InternalMessageInfo
+
+func (m *NextBatchPreviewResponse) GetBatch() BatchTx {
+	if m != nil {
+		return m.Batch
+	}
+	return BatchTx{}
+}
+
+func (m *NextBatchPreviewResponse) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *NextBatchPreviewResponse) GetSendToEthereumIds() []uint64 {
+	if m != nil {
+		return m.SendToEthereumIds
+	}
+	return nil
+}
+
+func (m *NextBatchPreviewResponse) GetLastBatchNonce() uint64 {
+	if m != nil {
+		return m.LastBatchNonce
+	}
+	return 0
+}
+
+func (m *NextBatchPreviewResponse) GetWouldCreate() bool {
+	if m != nil {
+		return m.WouldCreate
+	}
+	return false
+}
+
 type ContractCallTxConfirmationsRequest struct {
-	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	InvalidationScope []byte             `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64             `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *ContractCallTxConfirmationsRequest) Reset()         { *m = ContractCallTxConfirmationsRequest{} }
 func (m *ContractCallTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmationsRequest) ProtoMessage()    {}
 func (*ContractCallTxConfirmationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{25}
+	return fileDescriptor_29a9d4192703013c, []int{30}
 }
 func (m *ContractCallTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,15 +1762,23 @@ func (m *ContractCallTxConfirmationsRequest) GetInvalidationNonce() uint64 {
 	return 0
 }
 
+func (m *ContractCallTxConfirmationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type ContractCallTxConfirmationsResponse struct {
 	Signatures []*ContractCallTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Pagination *query.PageResponse           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *ContractCallTxConfirmationsResponse) Reset()         { *m = ContractCallTxConfirmationsResponse{} }
 func (m *ContractCallTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmationsResponse) ProtoMessage()    {}
 func (*ContractCallTxConfirmationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{26}
+	return fileDescriptor_29a9d4192703013c, []int{31}
 }
 func (m *ContractCallTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1259,16 +1814,24 @@ func (m *ContractCallTxConfirmationsResponse) GetSignatures() []*ContractCallTxC
 	return nil
 }
 
+func (m *ContractCallTxConfirmationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type BatchTxConfirmationsRequest struct {
-	BatchNonce    uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
-	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64             `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract string             `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *BatchTxConfirmationsRequest) Reset()         { *m = BatchTxConfirmationsRequest{} }
 func (m *BatchTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmationsRequest) ProtoMessage()    {}
 func (*BatchTxConfirmationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{27}
+	return fileDescriptor_29a9d4192703013c, []int{32}
 }
 func (m *BatchTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,15 +1874,23 @@ func (m *BatchTxConfirmationsRequest) GetTokenContract() string {
 	return ""
 }
 
+func (m *BatchTxConfirmationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type BatchTxConfirmationsResponse struct {
 	Signatures []*BatchTxConfirmation `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *BatchTxConfirmationsResponse) Reset()         { *m = BatchTxConfirmationsResponse{} }
 func (m *BatchTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmationsResponse) ProtoMessage()    {}
 func (*BatchTxConfirmationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{28}
+	return fileDescriptor_29a9d4192703013c, []int{33}
 }
 func (m *BatchTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,6 +1926,13 @@ func (m *BatchTxConfirmationsResponse) GetSignatures() []*BatchTxConfirmation {
 	return nil
 }
 
+func (m *BatchTxConfirmationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type LastSubmittedEthereumEventRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -1363,7 +1941,7 @@ func (m *LastSubmittedEthereumEventRequest) Reset()         { *m = LastSubmitted
 func (m *LastSubmittedEthereumEventRequest) String() string { return proto.CompactTextString(m) }
 func (*LastSubmittedEthereumEventRequest) ProtoMessage()    {}
 func (*LastSubmittedEthereumEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{29}
+	return fileDescriptor_29a9d4192703013c, []int{34}
 }
 func (m *LastSubmittedEthereumEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1985,7 @@ func (m *LastSubmittedEthereumEventResponse) Reset()         { *m = LastSubmitte
 func (m *LastSubmittedEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*LastSubmittedEthereumEventResponse) ProtoMessage()    {}
 func (*LastSubmittedEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{30}
+	return fileDescriptor_29a9d4192703013c, []int{35}
 }
 func (m *LastSubmittedEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +2029,7 @@ func (m *ERC20ToDenomRequest) Reset()         { *m = ERC20ToDenomRequest{} }
 func (m *ERC20ToDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenomRequest) ProtoMessage()    {}
 func (*ERC20ToDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{31}
+	return fileDescriptor_29a9d4192703013c, []int{36}
 }
 func (m *ERC20ToDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +2074,7 @@ func (m *ERC20ToDenomResponse) Reset()         { *m = ERC20ToDenomResponse{} }
 func (m *ERC20ToDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenomResponse) ProtoMessage()    {}
 func (*ERC20ToDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{32}
+	return fileDescriptor_29a9d4192703013c, []int{37}
 }
 func (m *ERC20ToDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +2125,7 @@ func (m *DenomToERC20ParamsRequest) Reset()         { *m = DenomToERC20ParamsReq
 func (m *DenomToERC20ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20ParamsRequest) ProtoMessage()    {}
 func (*DenomToERC20ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{33}
+	return fileDescriptor_29a9d4192703013c, []int{38}
 }
 func (m *DenomToERC20ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +2172,7 @@ func (m *DenomToERC20ParamsResponse) Reset()         { *m = DenomToERC20ParamsRe
 func (m *DenomToERC20ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20ParamsResponse) ProtoMessage()    {}
 func (*DenomToERC20ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{34}
+	return fileDescriptor_29a9d4192703013c, []int{39}
 }
 func (m *DenomToERC20ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1659,7 +2237,7 @@ func (m *DenomToERC20Request) Reset()         { *m = DenomToERC20Request{} }
 func (m *DenomToERC20Request) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20Request) ProtoMessage()    {}
 func (*DenomToERC20Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{35}
+	return fileDescriptor_29a9d4192703013c, []int{40}
 }
 func (m *DenomToERC20Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1704,7 +2282,7 @@ func (m *DenomToERC20Response) Reset()         { *m = DenomToERC20Response{} }
 func (m *DenomToERC20Response) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20Response) ProtoMessage()    {}
 func (*DenomToERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{36}
+	return fileDescriptor_29a9d4192703013c, []int{41}
 }
 func (m *DenomToERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,7 +2333,7 @@ func (m *DelegateKeysByValidatorRequest) Reset()         { *m = DelegateKeysByVa
 func (m *DelegateKeysByValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByValidatorRequest) ProtoMessage()    {}
 func (*DelegateKeysByValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{37}
+	return fileDescriptor_29a9d4192703013c, []int{42}
 }
 func (m *DelegateKeysByValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,7 +2378,7 @@ func (m *DelegateKeysByValidatorResponse) Reset()         { *m = DelegateKeysByV
 func (m *DelegateKeysByValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByValidatorResponse) ProtoMessage()    {}
 func (*DelegateKeysByValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{38}
+	return fileDescriptor_29a9d4192703013c, []int{43}
 }
 func (m *DelegateKeysByValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2429,7 @@ func (m *DelegateKeysByEthereumSignerRequest) Reset()         { *m = DelegateKey
 func (m *DelegateKeysByEthereumSignerRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByEthereumSignerRequest) ProtoMessage()    {}
 func (*DelegateKeysByEthereumSignerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{39}
+	return fileDescriptor_29a9d4192703013c, []int{44}
 }
 func (m *DelegateKeysByEthereumSignerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1896,7 +2474,7 @@ func (m *DelegateKeysByEthereumSignerResponse) Reset()         { *m = DelegateKe
 func (m *DelegateKeysByEthereumSignerResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByEthereumSignerResponse) ProtoMessage()    {}
 func (*DelegateKeysByEthereumSignerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{40}
+	return fileDescriptor_29a9d4192703013c, []int{45}
 }
 func (m *DelegateKeysByEthereumSignerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2525,7 @@ func (m *DelegateKeysByOrchestratorRequest) Reset()         { *m = DelegateKeysB
 func (m *DelegateKeysByOrchestratorRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByOrchestratorRequest) ProtoMessage()    {}
 func (*DelegateKeysByOrchestratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{41}
+	return fileDescriptor_29a9d4192703013c, []int{46}
 }
 func (m *DelegateKeysByOrchestratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2570,7 @@ func (m *DelegateKeysByOrchestratorResponse) Reset()         { *m = DelegateKeys
 func (m *DelegateKeysByOrchestratorResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByOrchestratorResponse) ProtoMessage()    {}
 func (*DelegateKeysByOrchestratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{42}
+	return fileDescriptor_29a9d4192703013c, []int{47}
 }
 func (m *DelegateKeysByOrchestratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2035,14 +2613,16 @@ func (m *DelegateKeysByOrchestratorResponse) GetEthereumSigner() string {
 	return ""
 }
 
+// pages through the delegate keys in validator address order
 type DelegateKeysRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *DelegateKeysRequest) Reset()         { *m = DelegateKeysRequest{} }
 func (m *DelegateKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysRequest) ProtoMessage()    {}
 func (*DelegateKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{43}
+	return fileDescriptor_29a9d4192703013c, []int{48}
 }
 func (m *DelegateKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2071,15 +2651,23 @@ func (m *DelegateKeysRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegateKeysRequest proto.InternalMessageInfo
 
+func (m *DelegateKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type DelegateKeysResponse struct {
-	DelegateKeys []*MsgDelegateKeys `protobuf:"bytes,1,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	DelegateKeys []*MsgDelegateKeys  `protobuf:"bytes,1,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *DelegateKeysResponse) Reset()         { *m = DelegateKeysResponse{} }
 func (m *DelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysResponse) ProtoMessage()    {}
 func (*DelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{44}
+	return fileDescriptor_29a9d4192703013c, []int{49}
 }
 func (m *DelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2115,16 +2703,27 @@ func (m *DelegateKeysResponse) GetDelegateKeys() []*MsgDelegateKeys {
 	return nil
 }
 
+func (m *DelegateKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // NOTE: if there is no sender address, return all
 type BatchedSendToEthereumsRequest struct {
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// pages through batches, returning the matching sends of each
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return sends of this token contract, if set
+	TokenContract string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 }
 
 func (m *BatchedSendToEthereumsRequest) Reset()         { *m = BatchedSendToEthereumsRequest{} }
 func (m *BatchedSendToEthereumsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchedSendToEthereumsRequest) ProtoMessage()    {}
 func (*BatchedSendToEthereumsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{45}
+	return fileDescriptor_29a9d4192703013c, []int{50}
 }
 func (m *BatchedSendToEthereumsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2160,15 +2759,30 @@ func (m *BatchedSendToEthereumsRequest) GetSenderAddress() string {
 	return ""
 }
 
+func (m *BatchedSendToEthereumsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *BatchedSendToEthereumsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
 type BatchedSendToEthereumsResponse struct {
-	SendToEthereums []*SendToEthereum `protobuf:"bytes,1,rep,name=send_to_ethereums,json=sendToEthereums,proto3" json:"send_to_ethereums,omitempty"`
+	SendToEthereums []*SendToEthereum   `protobuf:"bytes,1,rep,name=send_to_ethereums,json=sendToEthereums,proto3" json:"send_to_ethereums,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *BatchedSendToEthereumsResponse) Reset()         { *m = BatchedSendToEthereumsResponse{} }
 func (m *BatchedSendToEthereumsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchedSendToEthereumsResponse) ProtoMessage()    {}
 func (*BatchedSendToEthereumsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{46}
+	return fileDescriptor_29a9d4192703013c, []int{51}
 }
 func (m *BatchedSendToEthereumsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2204,16 +2818,26 @@ func (m *BatchedSendToEthereumsResponse) GetSendToEthereums() []*SendToEthereum
 	return nil
 }
 
+func (m *BatchedSendToEthereumsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// NOTE: if there is no sender address, return all
 type UnbatchedSendToEthereumsRequest struct {
 	SenderAddress string             `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
 	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return sends of this token contract, if set
+	TokenContract string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 }
 
 func (m *UnbatchedSendToEthereumsRequest) Reset()         { *m = UnbatchedSendToEthereumsRequest{} }
 func (m *UnbatchedSendToEthereumsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnbatchedSendToEthereumsRequest) ProtoMessage()    {}
 func (*UnbatchedSendToEthereumsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{47}
+	return fileDescriptor_29a9d4192703013c, []int{52}
 }
 func (m *UnbatchedSendToEthereumsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,6 +2880,13 @@ func (m *UnbatchedSendToEthereumsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *UnbatchedSendToEthereumsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
 type UnbatchedSendToEthereumsResponse struct {
 	SendToEthereums []*SendToEthereum   `protobuf:"bytes,1,rep,name=send_to_ethereums,json=sendToEthereums,proto3" json:"send_to_ethereums,omitempty"`
 	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -2265,7 +2896,7 @@ func (m *UnbatchedSendToEthereumsResponse) Reset()         { *m = UnbatchedSendT
 func (m *UnbatchedSendToEthereumsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnbatchedSendToEthereumsResponse) ProtoMessage()    {}
 func (*UnbatchedSendToEthereumsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{48}
+	return fileDescriptor_29a9d4192703013c, []int{53}
 }
 func (m *UnbatchedSendToEthereumsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2308,21 +2939,22 @@ func (m *UnbatchedSendToEthereumsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type LastObservedEthereumHeightRequest struct {
+type SendToEthereumByIDRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *LastObservedEthereumHeightRequest) Reset()         { *m = LastObservedEthereumHeightRequest{} }
-func (m *LastObservedEthereumHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*LastObservedEthereumHeightRequest) ProtoMessage()    {}
-func (*LastObservedEthereumHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{49}
+func (m *SendToEthereumByIDRequest) Reset()         { *m = SendToEthereumByIDRequest{} }
+func (m *SendToEthereumByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumByIDRequest) ProtoMessage()    {}
+func (*SendToEthereumByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{54}
 }
-func (m *LastObservedEthereumHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *SendToEthereumByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastObservedEthereumHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SendToEthereumByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastObservedEthereumHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SendToEthereumByIDRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2332,34 +2964,43 @@ func (m *LastObservedEthereumHeightRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *LastObservedEthereumHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastObservedEthereumHeightRequest.Merge(m, src)
+func (m *SendToEthereumByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumByIDRequest.Merge(m, src)
 }
-func (m *LastObservedEthereumHeightRequest) XXX_Size() int {
+func (m *SendToEthereumByIDRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastObservedEthereumHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastObservedEthereumHeightRequest.DiscardUnknown(m)
+func (m *SendToEthereumByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumByIDRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastObservedEthereumHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_SendToEthereumByIDRequest proto.InternalMessageInfo
 
-type LastObservedEthereumHeightResponse struct {
-	LastObservedEthereumHeight *LatestEthereumBlockHeight `protobuf:"bytes,1,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height,omitempty"`
+func (m *SendToEthereumByIDRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *LastObservedEthereumHeightResponse) Reset()         { *m = LastObservedEthereumHeightResponse{} }
-func (m *LastObservedEthereumHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*LastObservedEthereumHeightResponse) ProtoMessage()    {}
-func (*LastObservedEthereumHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{50}
+type SendToEthereumByIDResponse struct {
+	SendToEthereum *SendToEthereum `protobuf:"bytes,1,opt,name=send_to_ethereum,json=sendToEthereum,proto3" json:"send_to_ethereum,omitempty"`
+	// the nonce of the batch holding the send, zero while it is unbatched
+	BatchNonce uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 }
-func (m *LastObservedEthereumHeightResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SendToEthereumByIDResponse) Reset()         { *m = SendToEthereumByIDResponse{} }
+func (m *SendToEthereumByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumByIDResponse) ProtoMessage()    {}
+func (*SendToEthereumByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{55}
+}
+func (m *SendToEthereumByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastObservedEthereumHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SendToEthereumByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastObservedEthereumHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SendToEthereumByIDResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2369,3714 +3010,17541 @@ func (m *LastObservedEthereumHeightResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *LastObservedEthereumHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastObservedEthereumHeightResponse.Merge(m, src)
+func (m *SendToEthereumByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumByIDResponse.Merge(m, src)
 }
-func (m *LastObservedEthereumHeightResponse) XXX_Size() int {
+func (m *SendToEthereumByIDResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LastObservedEthereumHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LastObservedEthereumHeightResponse.DiscardUnknown(m)
+func (m *SendToEthereumByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumByIDResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LastObservedEthereumHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_SendToEthereumByIDResponse proto.InternalMessageInfo
 
-func (m *LastObservedEthereumHeightResponse) GetLastObservedEthereumHeight() *LatestEthereumBlockHeight {
+func (m *SendToEthereumByIDResponse) GetSendToEthereum() *SendToEthereum {
 	if m != nil {
-		return m.LastObservedEthereumHeight
+		return m.SendToEthereum
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "gravity.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "gravity.v1.ParamsResponse")
-	proto.RegisterType((*SignerSetTxRequest)(nil), "gravity.v1.SignerSetTxRequest")
-	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "gravity.v1.LatestSignerSetTxRequest")
-	proto.RegisterType((*SignerSetTxResponse)(nil), "gravity.v1.SignerSetTxResponse")
-	proto.RegisterType((*BatchTxRequest)(nil), "gravity.v1.BatchTxRequest")
-	proto.RegisterType((*BatchTxResponse)(nil), "gravity.v1.BatchTxResponse")
-	proto.RegisterType((*ContractCallTxRequest)(nil), "gravity.v1.ContractCallTxRequest")
-	proto.RegisterType((*ContractCallTxResponse)(nil), "gravity.v1.ContractCallTxResponse")
-	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "gravity.v1.SignerSetTxConfirmationsRequest")
-	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "gravity.v1.SignerSetTxConfirmationsResponse")
-	proto.RegisterType((*SignerSetTxsRequest)(nil), "gravity.v1.SignerSetTxsRequest")
-	proto.RegisterType((*SignerSetTxsResponse)(nil), "gravity.v1.SignerSetTxsResponse")
-	proto.RegisterType((*BatchTxsRequest)(nil), "gravity.v1.BatchTxsRequest")
-	proto.RegisterType((*BatchTxsResponse)(nil), "gravity.v1.BatchTxsResponse")
-	proto.RegisterType((*ContractCallTxsRequest)(nil), "gravity.v1.ContractCallTxsRequest")
-	proto.RegisterType((*ContractCallTxsResponse)(nil), "gravity.v1.ContractCallTxsResponse")
-	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "gravity.v1.UnsignedSignerSetTxsRequest")
-	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "gravity.v1.UnsignedSignerSetTxsResponse")
-	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "gravity.v1.UnsignedBatchTxsRequest")
-	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "gravity.v1.UnsignedBatchTxsResponse")
-	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "gravity.v1.UnsignedContractCallTxsRequest")
-	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "gravity.v1.UnsignedContractCallTxsResponse")
-	proto.RegisterType((*BatchTxFeesRequest)(nil), "gravity.v1.BatchTxFeesRequest")
-	proto.RegisterType((*BatchTxFeesResponse)(nil), "gravity.v1.BatchTxFeesResponse")
-	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "gravity.v1.ContractCallTxConfirmationsRequest")
-	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "gravity.v1.ContractCallTxConfirmationsResponse")
-	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "gravity.v1.BatchTxConfirmationsRequest")
-	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "gravity.v1.BatchTxConfirmationsResponse")
-	proto.RegisterType((*LastSubmittedEthereumEventRequest)(nil), "gravity.v1.LastSubmittedEthereumEventRequest")
-	proto.RegisterType((*LastSubmittedEthereumEventResponse)(nil), "gravity.v1.LastSubmittedEthereumEventResponse")
-	proto.RegisterType((*ERC20ToDenomRequest)(nil), "gravity.v1.ERC20ToDenomRequest")
-	proto.RegisterType((*ERC20ToDenomResponse)(nil), "gravity.v1.ERC20ToDenomResponse")
-	proto.RegisterType((*DenomToERC20ParamsRequest)(nil), "gravity.v1.DenomToERC20ParamsRequest")
-	proto.RegisterType((*DenomToERC20ParamsResponse)(nil), "gravity.v1.DenomToERC20ParamsResponse")
-	proto.RegisterType((*DenomToERC20Request)(nil), "gravity.v1.DenomToERC20Request")
-	proto.RegisterType((*DenomToERC20Response)(nil), "gravity.v1.DenomToERC20Response")
-	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "gravity.v1.DelegateKeysByValidatorRequest")
-	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "gravity.v1.DelegateKeysByValidatorResponse")
-	proto.RegisterType((*DelegateKeysByEthereumSignerRequest)(nil), "gravity.v1.DelegateKeysByEthereumSignerRequest")
-	proto.RegisterType((*DelegateKeysByEthereumSignerResponse)(nil), "gravity.v1.DelegateKeysByEthereumSignerResponse")
-	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "gravity.v1.DelegateKeysByOrchestratorRequest")
-	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "gravity.v1.DelegateKeysByOrchestratorResponse")
-	proto.RegisterType((*DelegateKeysRequest)(nil), "gravity.v1.DelegateKeysRequest")
-	proto.RegisterType((*DelegateKeysResponse)(nil), "gravity.v1.DelegateKeysResponse")
-	proto.RegisterType((*BatchedSendToEthereumsRequest)(nil), "gravity.v1.BatchedSendToEthereumsRequest")
-	proto.RegisterType((*BatchedSendToEthereumsResponse)(nil), "gravity.v1.BatchedSendToEthereumsResponse")
-	proto.RegisterType((*UnbatchedSendToEthereumsRequest)(nil), "gravity.v1.UnbatchedSendToEthereumsRequest")
-	proto.RegisterType((*UnbatchedSendToEthereumsResponse)(nil), "gravity.v1.UnbatchedSendToEthereumsResponse")
-	proto.RegisterType((*LastObservedEthereumHeightRequest)(nil), "gravity.v1.LastObservedEthereumHeightRequest")
-	proto.RegisterType((*LastObservedEthereumHeightResponse)(nil), "gravity.v1.LastObservedEthereumHeightResponse")
+func (m *SendToEthereumByIDResponse) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
-
-var fileDescriptor_29a9d4192703013c = []byte{
-	// 1842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x49, 0x73, 0xdb, 0xc8,
-	0x15, 0x26, 0x34, 0x96, 0x3d, 0x7a, 0xb2, 0xb6, 0x16, 0x6d, 0xcb, 0x94, 0x4c, 0xda, 0x2d, 0x8f,
-	0xad, 0xb1, 0x22, 0x52, 0x92, 0xab, 0xb2, 0x6f, 0x23, 0xd9, 0x9e, 0xa4, 0x66, 0xbc, 0x84, 0xf4,
-	0x4c, 0xd9, 0xa9, 0xa4, 0x10, 0x90, 0xe8, 0x01, 0x11, 0x91, 0x68, 0x19, 0x00, 0x99, 0x61, 0xaa,
-	0x52, 0x95, 0x4a, 0xaa, 0x72, 0xc8, 0x21, 0x35, 0x87, 0x5c, 0x72, 0xcf, 0x29, 0xd7, 0xfc, 0x89,
-	0x39, 0xce, 0x31, 0xa7, 0x2c, 0xf6, 0x1f, 0x49, 0xa1, 0x17, 0xb0, 0x1b, 0x6c, 0x80, 0xb4, 0xa2,
-	0x9c, 0x6c, 0xbe, 0xe5, 0x7b, 0x0b, 0x5e, 0xbf, 0x7e, 0xaf, 0x05, 0x57, 0xbd, 0xd0, 0x19, 0xfa,
-	0xf1, 0xa8, 0x31, 0x3c, 0x68, 0xbc, 0x1a, 0x90, 0x70, 0x54, 0x3f, 0x0d, 0x69, 0x4c, 0x11, 0x08,
-	0x7a, 0x7d, 0x78, 0x50, 0xb9, 0xd7, 0xa1, 0x51, 0x9f, 0x46, 0x8d, 0xb6, 0x13, 0x11, 0x2e, 0xd4,
-	0x18, 0x1e, 0xb4, 0x49, 0xec, 0x1c, 0x34, 0x4e, 0x1d, 0xcf, 0x0f, 0x9c, 0xd8, 0xa7, 0x01, 0xd7,
-	0xab, 0x54, 0x55, 0x59, 0x29, 0xd5, 0xa1, 0xbe, 0xe4, 0x97, 0x3d, 0xea, 0x51, 0xf6, 0xdf, 0x46,
-	0xf2, 0x3f, 0x41, 0xdd, 0xf2, 0x28, 0xf5, 0x7a, 0xa4, 0xe1, 0x9c, 0xfa, 0x0d, 0x27, 0x08, 0x68,
-	0xcc, 0x20, 0x23, 0xc1, 0xdd, 0x50, 0x7c, 0xf4, 0x48, 0x40, 0x22, 0xdf, 0xc8, 0x11, 0x0e, 0x73,
-	0xce, 0x15, 0x85, 0xd3, 0x8f, 0x3c, 0xa1, 0x80, 0x57, 0x60, 0xe9, 0x99, 0x13, 0x3a, 0xfd, 0xa8,
-	0x49, 0x5e, 0x0d, 0x48, 0x14, 0xe3, 0x23, 0x58, 0x96, 0x84, 0xe8, 0x94, 0x06, 0x11, 0x41, 0xfb,
-	0x70, 0xf1, 0x94, 0x51, 0x36, 0xac, 0x9b, 0xd6, 0xce, 0xe2, 0x21, 0xaa, 0x8f, 0x53, 0x51, 0xe7,
-	0xb2, 0x47, 0x17, 0xbe, 0xfc, 0x67, 0xad, 0xd4, 0x14, 0x72, 0xf8, 0xfb, 0x80, 0x5a, 0xbe, 0x17,
-	0x90, 0xb0, 0x45, 0xe2, 0xe7, 0x9f, 0x0b, 0x64, 0xb4, 0x03, 0xab, 0x11, 0xa3, 0xda, 0x11, 0x89,
-	0xed, 0x80, 0x06, 0x1d, 0xc2, 0x10, 0x2f, 0x34, 0x97, 0x23, 0x29, 0xfd, 0x24, 0xa1, 0xe2, 0x0a,
-	0x6c, 0x7c, 0xec, 0xc4, 0x24, 0x8a, 0x27, 0x51, 0xf0, 0x63, 0x58, 0xd7, 0xa8, 0xc2, 0xc9, 0xaf,
-	0x03, 0x8c, 0xc1, 0x85, 0xa3, 0xd7, 0x54, 0x47, 0x55, 0xa5, 0x85, 0xd4, 0x1e, 0x7e, 0x01, 0xcb,
-	0x47, 0x4e, 0xdc, 0xe9, 0x8e, 0xdd, 0x7c, 0x0f, 0x96, 0x63, 0x7a, 0x42, 0x02, 0xbb, 0x43, 0x83,
-	0x38, 0x74, 0x3a, 0x1c, 0x6d, 0xa1, 0xb9, 0xc4, 0xa8, 0xc7, 0x82, 0x88, 0x6a, 0xb0, 0xd8, 0x4e,
-	0x14, 0x45, 0x20, 0x73, 0x2c, 0x10, 0x60, 0x24, 0x1e, 0xc4, 0x77, 0x61, 0x25, 0x45, 0x16, 0x4e,
-	0xbe, 0x0f, 0xf3, 0x4c, 0x40, 0xf8, 0xb7, 0xae, 0xfa, 0x27, 0x65, 0xb9, 0x04, 0x1e, 0xc0, 0x15,
-	0x69, 0xea, 0xd8, 0xe9, 0xf5, 0xc6, 0xee, 0xed, 0x01, 0xf2, 0x83, 0xa1, 0xd3, 0xf3, 0x5d, 0x56,
-	0x12, 0x76, 0xd4, 0xa1, 0xa7, 0x3c, 0x8f, 0x97, 0x9b, 0x6b, 0x2a, 0xa7, 0x95, 0x30, 0x26, 0xc4,
-	0x55, 0x6f, 0x35, 0x71, 0xee, 0x74, 0x0b, 0xae, 0x66, 0xcd, 0x0a, 0xdf, 0xbf, 0x05, 0xd0, 0xa3,
-	0x9e, 0xdf, 0xb1, 0x3b, 0x4e, 0xaf, 0x27, 0x02, 0xa8, 0xa8, 0x01, 0x64, 0xf4, 0x16, 0x98, 0x74,
-	0xf2, 0x03, 0x7f, 0x04, 0x35, 0x25, 0xfb, 0xc7, 0x34, 0xf8, 0xcc, 0x0f, 0xfb, 0xbc, 0xa0, 0xdf,
-	0xbe, 0x36, 0x3c, 0xb8, 0x99, 0x0f, 0x26, 0x7c, 0x3d, 0xe6, 0xc5, 0xe0, 0xc4, 0x83, 0x90, 0x24,
-	0x55, 0xfb, 0xce, 0xce, 0xe2, 0xe1, 0x76, 0x4e, 0x31, 0xa8, 0x08, 0x4d, 0x45, 0x0d, 0xff, 0x5c,
-	0x2b, 0xb4, 0xd4, 0xd3, 0x47, 0x00, 0xe3, 0x33, 0x2e, 0xf2, 0x70, 0xa7, 0xce, 0x0f, 0x79, 0x3d,
-	0x39, 0xe4, 0x75, 0xde, 0x35, 0xc4, 0x51, 0xaf, 0x3f, 0x73, 0x3c, 0x22, 0x74, 0x9b, 0x8a, 0x26,
-	0xfe, 0x8b, 0x05, 0x65, 0x1d, 0x5f, 0x38, 0xff, 0x4d, 0x58, 0x1c, 0xa7, 0x42, 0x7a, 0x9f, 0x5b,
-	0xca, 0x90, 0xa6, 0x27, 0x42, 0x1f, 0x6a, 0xae, 0xcd, 0x31, 0xd7, 0xee, 0x4e, 0x75, 0x8d, 0x9b,
-	0xd5, 0x7c, 0x7b, 0x99, 0x96, 0xee, 0xb9, 0x87, 0xfd, 0x47, 0x0b, 0x56, 0xc7, 0xd8, 0x22, 0xe4,
-	0x3d, 0xb8, 0xc4, 0xaa, 0x3e, 0xfd, 0x58, 0xc6, 0x93, 0x21, 0x65, 0xce, 0x2f, 0xce, 0x5f, 0x64,
-	0xab, 0xfd, 0xdc, 0xc3, 0xfd, 0xb3, 0x05, 0xd7, 0x26, 0x4c, 0xa4, 0x7d, 0x75, 0x3e, 0x39, 0x4b,
-	0x32, 0xe6, 0xa2, 0xc3, 0xc4, 0x05, 0xcf, 0x2f, 0xf0, 0x6f, 0xc0, 0xe6, 0x27, 0x01, 0xab, 0x1c,
-	0xd7, 0x54, 0xe3, 0x1b, 0x70, 0xc9, 0x71, 0xdd, 0x90, 0x44, 0x91, 0xe8, 0x7d, 0xf2, 0x27, 0x7e,
-	0x01, 0x5b, 0x66, 0xc5, 0xff, 0xb5, 0x78, 0xf1, 0x7d, 0xb8, 0x26, 0x91, 0xb3, 0xb5, 0x97, 0xef,
-	0xce, 0x8f, 0x61, 0x63, 0x52, 0xe9, 0x4c, 0x45, 0x85, 0xbf, 0x0d, 0x55, 0x09, 0x95, 0x53, 0x13,
-	0xf9, 0x6e, 0xb4, 0xa0, 0x96, 0xab, 0x7b, 0xd6, 0x8f, 0x8d, 0xcb, 0x80, 0x84, 0x93, 0x8f, 0x08,
-	0x49, 0xaf, 0xe7, 0x21, 0xac, 0x6b, 0x54, 0x01, 0x6f, 0xc3, 0x85, 0xcf, 0x48, 0x1a, 0xe9, 0x75,
-	0xad, 0x26, 0x64, 0x35, 0x1c, 0x53, 0x3f, 0x38, 0xda, 0x4f, 0x2e, 0xea, 0xbf, 0xfd, 0xab, 0xb6,
-	0xe3, 0xf9, 0x71, 0x77, 0xd0, 0xae, 0x77, 0x68, 0xbf, 0x21, 0x26, 0x14, 0xfe, 0xcf, 0x5e, 0xe4,
-	0x9e, 0x34, 0xe2, 0xd1, 0x29, 0x89, 0x98, 0x42, 0xd4, 0x64, 0xc0, 0xf8, 0x77, 0x16, 0x60, 0xdd,
-	0x4f, 0x63, 0x1f, 0xff, 0xff, 0xde, 0x4e, 0x7d, 0xd8, 0x2e, 0xf4, 0x41, 0x24, 0xe3, 0x91, 0xa1,
-	0xfd, 0xdf, 0xc9, 0x4f, 0x78, 0xee, 0x0d, 0x40, 0x60, 0x53, 0xe4, 0xda, 0x18, 0x6b, 0x66, 0x02,
-	0xb0, 0xb2, 0x13, 0x80, 0x61, 0x92, 0x98, 0x33, 0x4c, 0x12, 0xd8, 0x86, 0x2d, 0xb3, 0x19, 0x11,
-	0xce, 0x0f, 0x0c, 0xe1, 0xd4, 0x0c, 0xb5, 0x9c, 0x1b, 0xc7, 0xf7, 0xe0, 0xd6, 0xc7, 0x4e, 0x14,
-	0xb7, 0x06, 0xed, 0xbe, 0x1f, 0xc7, 0xc4, 0x7d, 0x18, 0x77, 0x49, 0x48, 0x06, 0xfd, 0x87, 0x43,
-	0x12, 0xc4, 0xd3, 0xab, 0xfb, 0x21, 0xe0, 0x22, 0x75, 0xe1, 0x65, 0x0d, 0x16, 0x49, 0x42, 0xd0,
-	0xb3, 0xc1, 0x48, 0xfc, 0xe3, 0xed, 0xc2, 0xfa, 0xc3, 0xe6, 0xf1, 0xe1, 0xfe, 0x73, 0xfa, 0x80,
-	0x04, 0xb4, 0x2f, 0xed, 0x96, 0x61, 0x9e, 0x84, 0x9d, 0xc3, 0x7d, 0x61, 0x95, 0xff, 0xc0, 0x2f,
-	0xa1, 0xac, 0x0b, 0x0b, 0x2b, 0x65, 0x98, 0x77, 0x13, 0x82, 0x94, 0x66, 0x3f, 0xd0, 0x2e, 0xac,
-	0xf1, 0xe2, 0xb5, 0x69, 0xe8, 0xb3, 0x26, 0x47, 0x5c, 0x96, 0xeb, 0x77, 0x9b, 0xab, 0x9c, 0xf1,
-	0x34, 0xa5, 0xe3, 0x03, 0xb8, 0xce, 0x30, 0x9f, 0x53, 0x66, 0x41, 0x9b, 0x7e, 0xcd, 0xf8, 0xf8,
-	0xaf, 0x16, 0x54, 0x4c, 0x3a, 0xc2, 0xa9, 0x1b, 0x00, 0xc9, 0x41, 0xb3, 0x55, 0xcd, 0x85, 0x84,
-	0xc2, 0x74, 0x12, 0x36, 0x0b, 0xca, 0x0e, 0x9c, 0x3e, 0x11, 0x25, 0xb0, 0xc0, 0x28, 0x4f, 0x9c,
-	0x3e, 0x41, 0xb7, 0xe0, 0x32, 0x67, 0x47, 0xa3, 0x7e, 0x9b, 0xf6, 0x36, 0xde, 0x61, 0x02, 0x8b,
-	0x8c, 0xd6, 0x62, 0xa4, 0xa4, 0x90, 0xb8, 0x88, 0x4b, 0x3a, 0x7e, 0xdf, 0xe9, 0x45, 0x1b, 0x17,
-	0x58, 0x7a, 0x97, 0x18, 0xf5, 0x81, 0x20, 0x26, 0x19, 0x56, 0xbd, 0x2c, 0x8e, 0xe9, 0x25, 0x94,
-	0x75, 0xe1, 0x71, 0x86, 0x27, 0xbf, 0xc7, 0xdb, 0x65, 0xf8, 0x31, 0x54, 0x1f, 0x90, 0x1e, 0xf1,
-	0x9c, 0x98, 0x7c, 0x44, 0x46, 0xd1, 0xd1, 0xe8, 0x53, 0x7e, 0x8e, 0x69, 0x28, 0x5d, 0xda, 0x85,
-	0xb5, 0xa1, 0xa4, 0xd9, 0x7a, 0xd9, 0xad, 0xa6, 0x8c, 0x0f, 0x44, 0xfd, 0x0d, 0xa0, 0x96, 0x0b,
-	0xa7, 0x14, 0x5f, 0xdc, 0xcd, 0x20, 0x01, 0x89, 0xbb, 0x02, 0x03, 0x1d, 0x40, 0x99, 0x86, 0x49,
-	0x9f, 0x8f, 0x43, 0xcd, 0x26, 0xff, 0x1a, 0xeb, 0x2a, 0x4f, 0x9a, 0x7d, 0x02, 0xdb, 0xba, 0x59,
-	0x59, 0xf7, 0xfc, 0x06, 0x93, 0xa1, 0xdc, 0x85, 0x15, 0x22, 0x18, 0x36, 0xbf, 0xce, 0x84, 0xf9,
-	0x65, 0xa2, 0xc9, 0xe3, 0x3f, 0x58, 0x70, 0xbb, 0x18, 0x50, 0x04, 0xf3, 0x36, 0xc9, 0x39, 0x4b,
-	0x60, 0x9f, 0xc2, 0x2d, 0xdd, 0x8f, 0xa7, 0x8a, 0x90, 0x0c, 0x2b, 0x0f, 0xd7, 0xca, 0xc7, 0xfd,
-	0x35, 0xe0, 0x22, 0xdc, 0xb3, 0x44, 0x67, 0x48, 0xee, 0x9c, 0x31, 0xb9, 0x57, 0x92, 0xd2, 0x1f,
-	0xdb, 0x96, 0xb7, 0xe5, 0x8b, 0xa4, 0xc8, 0x55, 0xb2, 0x70, 0xe2, 0x87, 0xb0, 0xe4, 0x0a, 0xba,
-	0x7d, 0x42, 0x46, 0xb2, 0xab, 0x6e, 0xaa, 0x5d, 0xf5, 0x71, 0xe4, 0x69, 0xba, 0x97, 0x5d, 0xe5,
-	0x17, 0x7e, 0x04, 0x37, 0x58, 0xdb, 0x25, 0x6e, 0x8b, 0x04, 0xee, 0x73, 0x2a, 0xbf, 0x65, 0xa4,
-	0xac, 0x91, 0x11, 0x09, 0x5c, 0x92, 0x0d, 0x72, 0x89, 0x53, 0x65, 0xd2, 0xba, 0x50, 0xcd, 0xc3,
-	0x49, 0x6f, 0xb3, 0xb5, 0x44, 0xc5, 0x8e, 0xa9, 0x2d, 0x83, 0x36, 0x4e, 0x11, 0xba, 0x7e, 0x73,
-	0x25, 0xd2, 0xf1, 0xf0, 0x17, 0x56, 0x32, 0xa5, 0xb4, 0xcf, 0xc1, 0xe9, 0xcc, 0x74, 0x3c, 0x77,
-	0xe6, 0xe9, 0xf8, 0xef, 0x16, 0xdc, 0xcc, 0x77, 0xe9, 0x7c, 0xe3, 0x3f, 0xbf, 0xe1, 0x79, 0x9b,
-	0x5f, 0xa7, 0x4f, 0xdb, 0x11, 0x09, 0x87, 0xe3, 0xeb, 0xf0, 0x47, 0xc4, 0xf7, 0xba, 0xf2, 0x3a,
-	0xc5, 0x7f, 0xb2, 0xf8, 0xad, 0x99, 0x27, 0x25, 0x82, 0xeb, 0xc2, 0x8d, 0x9e, 0x13, 0xc5, 0x36,
-	0x15, 0x62, 0x69, 0x88, 0x76, 0x97, 0x09, 0x8a, 0xd5, 0xe3, 0x3d, 0x35, 0x50, 0xfe, 0x34, 0x22,
-	0x01, 0x8f, 0x7a, 0xb4, 0x73, 0x22, 0x50, 0x2b, 0xbd, 0x5c, 0x8b, 0x87, 0xff, 0x29, 0xc3, 0xfc,
-	0x4f, 0x92, 0x00, 0xd1, 0x07, 0x70, 0x91, 0x5f, 0x60, 0xe8, 0xfa, 0xe4, 0x4b, 0x8e, 0xf0, 0xbf,
-	0x52, 0x31, 0xb1, 0xb8, 0xd3, 0xb8, 0x84, 0x9e, 0xc1, 0xa2, 0x32, 0xc7, 0xa3, 0x6a, 0xde, 0x80,
-	0x2f, 0xc0, 0x6a, 0xb9, 0xfc, 0x14, 0xf1, 0x67, 0xb0, 0x36, 0xf1, 0xe4, 0x83, 0x6e, 0x4f, 0x86,
-	0x7d, 0x36, 0xf4, 0x07, 0x70, 0x49, 0x0c, 0x49, 0xa8, 0x62, 0xda, 0x02, 0x04, 0xd2, 0xa6, 0x91,
-	0x97, 0xa2, 0xbc, 0x84, 0x65, 0x7d, 0x72, 0x44, 0xb7, 0x0a, 0xc6, 0x78, 0x81, 0x89, 0x8b, 0x44,
-	0x52, 0xe8, 0x16, 0x5c, 0x56, 0xf7, 0x29, 0x94, 0x17, 0x53, 0xfa, 0x7d, 0x6e, 0xe6, 0x0b, 0xa4,
-	0xa0, 0x1f, 0xc2, 0xbb, 0x72, 0x2b, 0x42, 0xa6, 0xd0, 0x52, 0xb0, 0x2d, 0x33, 0x53, 0xf9, 0x38,
-	0x2b, 0x99, 0xbd, 0x06, 0x15, 0x84, 0x95, 0xc2, 0x6e, 0x17, 0xca, 0xa4, 0xe8, 0xbf, 0x82, 0x8d,
-	0xbc, 0x17, 0x1d, 0xb4, 0x3b, 0xc3, 0xab, 0x4d, 0x6a, 0xef, 0x6b, 0xb3, 0x09, 0xa7, 0x86, 0x4f,
-	0xa0, 0x6c, 0x1a, 0xbc, 0xd1, 0xdd, 0x29, 0xc3, 0x75, 0x6a, 0x70, 0x67, 0xba, 0x60, 0x6a, 0xec,
-	0xb7, 0x16, 0x6c, 0x16, 0x2c, 0x2f, 0xa8, 0x3e, 0xdb, 0x82, 0x92, 0xda, 0x6e, 0xcc, 0x2c, 0xaf,
-	0xc6, 0x6b, 0x5a, 0xde, 0xf5, 0x78, 0x0b, 0xde, 0x05, 0xf4, 0x78, 0x8b, 0xde, 0x01, 0x70, 0x09,
-	0xd9, 0xb0, 0x9a, 0x5d, 0xcd, 0xd1, 0xb6, 0x49, 0x3f, 0x5b, 0x8c, 0xb7, 0x8b, 0x85, 0x52, 0x03,
-	0xf1, 0xf8, 0xc1, 0x20, 0x5b, 0x9c, 0xf7, 0x4c, 0x10, 0x39, 0x45, 0xba, 0x3b, 0x93, 0x6c, 0x6a,
-	0xf5, 0x37, 0x50, 0xc9, 0x5f, 0x86, 0xd0, 0x9e, 0xde, 0xb0, 0xa6, 0xec, 0x5c, 0x95, 0xfa, 0xac,
-	0xe2, 0x6a, 0xe3, 0x55, 0xd6, 0x7f, 0xbd, 0xf1, 0x4e, 0xbe, 0x16, 0x54, 0x6a, 0xb9, 0x7c, 0xb5,
-	0xf3, 0xa8, 0x9b, 0x96, 0xde, 0x79, 0x0c, 0x0b, 0x9b, 0xde, 0x79, 0x4c, 0x4b, 0x1a, 0x2e, 0x21,
-	0x02, 0x68, 0x72, 0x5f, 0x42, 0xda, 0x2d, 0x96, 0xbb, 0x83, 0x55, 0xee, 0x4c, 0x13, 0x53, 0x7d,
-	0x57, 0xf9, 0xba, 0xef, 0x86, 0x55, 0x48, 0xf7, 0xdd, 0xb4, 0xfe, 0xe0, 0x12, 0x7a, 0x05, 0x57,
-	0xcd, 0x13, 0x19, 0x7a, 0x7f, 0x22, 0x9b, 0x79, 0x83, 0x54, 0xe5, 0xde, 0x2c, 0xa2, 0x6a, 0x07,
-	0xcc, 0x1b, 0x83, 0x50, 0xa6, 0x3e, 0x0b, 0xe7, 0x37, 0xbd, 0x03, 0x4e, 0x9b, 0xac, 0xf8, 0x19,
-	0xca, 0x59, 0xad, 0xf4, 0x33, 0x54, 0xbc, 0xce, 0xe9, 0x67, 0x68, 0xca, 0xae, 0x86, 0x4b, 0xe8,
-	0xf7, 0x16, 0x6c, 0x15, 0x6d, 0x42, 0xa8, 0x91, 0x8f, 0x67, 0x5c, 0xc2, 0x2a, 0xfb, 0xb3, 0x2b,
-	0xa8, 0x27, 0x39, 0x7f, 0x5d, 0xd1, 0x4f, 0xf2, 0xd4, 0x75, 0x49, 0x3f, 0xc9, 0xd3, 0xb7, 0x20,
-	0x59, 0xbb, 0x63, 0xb9, 0x6c, 0xed, 0x4e, 0xec, 0x32, 0xd9, 0xda, 0x9d, 0xdc, 0x6a, 0xc6, 0xdd,
-	0xc9, 0x3c, 0x02, 0x4e, 0x76, 0xa7, 0xc2, 0x11, 0x76, 0xb2, 0x3b, 0x15, 0xcf, 0xb2, 0xb8, 0x74,
-	0xf4, 0xc9, 0x97, 0xaf, 0xab, 0xd6, 0x57, 0xaf, 0xab, 0xd6, 0xbf, 0x5f, 0x57, 0xad, 0x2f, 0xde,
-	0x54, 0x4b, 0x5f, 0xbd, 0xa9, 0x96, 0xfe, 0xf1, 0xa6, 0x5a, 0xfa, 0xe9, 0x77, 0x94, 0xe7, 0xc6,
-	0x53, 0xe2, 0x79, 0xa3, 0x5f, 0x0e, 0xe5, 0xdf, 0x27, 0xf7, 0xda, 0xa1, 0xef, 0x7a, 0xa4, 0xd1,
-	0xa7, 0xee, 0xa0, 0x47, 0x1a, 0xc3, 0xfb, 0x8d, 0xcf, 0x25, 0x8b, 0xbf, 0x43, 0xb6, 0x2f, 0xb2,
-	0x3f, 0x55, 0xde, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x3f, 0x6e, 0x95, 0x9b, 0x1d,
-	0x00, 0x00,
+type LastObservedEthereumHeightRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *LastObservedEthereumHeightRequest) Reset()         { *m = LastObservedEthereumHeightRequest{} }
+func (m *LastObservedEthereumHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*LastObservedEthereumHeightRequest) ProtoMessage()    {}
+func (*LastObservedEthereumHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{56}
+}
+func (m *LastObservedEthereumHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastObservedEthereumHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastObservedEthereumHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastObservedEthereumHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastObservedEthereumHeightRequest.Merge(m, src)
+}
+func (m *LastObservedEthereumHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastObservedEthereumHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastObservedEthereumHeightRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_LastObservedEthereumHeightRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Module parameters query
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
-	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
-	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
-	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
-	// pending ethereum signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by gravity
-	ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error)
-	// DenomToERC20Params implements a query that allows ERC-20 parameter
-	// information to be retrieved by a Cosmos base denomination.
-	DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error)
-	// Query for info about denoms tracked by gravity
-	DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error)
-	// Query for batch send to ethereums
-	BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error)
-	// Query for unbatched send to ethereums
-	UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error)
-	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
-	LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error)
+type LastObservedEthereumHeightResponse struct {
+	LastObservedEthereumHeight *LatestEthereumBlockHeight `protobuf:"bytes,1,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *LastObservedEthereumHeightResponse) Reset()         { *m = LastObservedEthereumHeightResponse{} }
+func (m *LastObservedEthereumHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*LastObservedEthereumHeightResponse) ProtoMessage()    {}
+func (*LastObservedEthereumHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{57}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *LastObservedEthereumHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LastObservedEthereumHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastObservedEthereumHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LastObservedEthereumHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastObservedEthereumHeightResponse.Merge(m, src)
 }
-
-func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LatestSignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LastObservedEthereumHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastObservedEthereumHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastObservedEthereumHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
-	out := new(BatchTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LastObservedEthereumHeightResponse proto.InternalMessageInfo
+
+func (m *LastObservedEthereumHeightResponse) GetLastObservedEthereumHeight() *LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
-	out := new(ContractCallTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventVoteDisagreementsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
-	out := new(SignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementsRequest) Reset()         { *m = EventVoteDisagreementsRequest{} }
+func (m *EventVoteDisagreementsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDisagreementsRequest) ProtoMessage()    {}
+func (*EventVoteDisagreementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{58}
+}
+func (m *EventVoteDisagreementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDisagreementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDisagreementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventVoteDisagreementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDisagreementsRequest.Merge(m, src)
+}
+func (m *EventVoteDisagreementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDisagreementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDisagreementsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
-	out := new(BatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoteDisagreementsRequest proto.InternalMessageInfo
+
+func (m *EventVoteDisagreementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
-	out := new(ContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventVoteDisagreementsResponse struct {
+	Disagreements []EventVoteDisagreement `protobuf:"bytes,1,rep,name=disagreements,proto3" json:"disagreements"`
+	Pagination    *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
-	out := new(SignerSetTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EventVoteDisagreementsResponse) Reset()         { *m = EventVoteDisagreementsResponse{} }
+func (m *EventVoteDisagreementsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDisagreementsResponse) ProtoMessage()    {}
+func (*EventVoteDisagreementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{59}
 }
-
-func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
-	out := new(BatchTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDisagreementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDisagreementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventVoteDisagreementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDisagreementsResponse.Merge(m, src)
+}
+func (m *EventVoteDisagreementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDisagreementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDisagreementsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
-	out := new(ContractCallTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoteDisagreementsResponse proto.InternalMessageInfo
+
+func (m *EventVoteDisagreementsResponse) GetDisagreements() []EventVoteDisagreement {
+	if m != nil {
+		return m.Disagreements
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
-	out := new(UnsignedSignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
-	out := new(UnsignedBatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedBatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// EventVoteDisagreement holds every vote record stored at an event nonce
+// that has more than one
+type EventVoteDisagreement struct {
+	EventNonce uint64                        `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Records    []EventVoteDisagreementRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
 }
 
-func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
-	out := new(UnsignedContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreement) Reset()         { *m = EventVoteDisagreement{} }
+func (m *EventVoteDisagreement) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDisagreement) ProtoMessage()    {}
+func (*EventVoteDisagreement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{60}
+}
+func (m *EventVoteDisagreement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDisagreement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDisagreement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventVoteDisagreement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDisagreement.Merge(m, src)
+}
+func (m *EventVoteDisagreement) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDisagreement) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDisagreement.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error) {
-	out := new(LastSubmittedEthereumEventResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastSubmittedEthereumEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoteDisagreement proto.InternalMessageInfo
+
+func (m *EventVoteDisagreement) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
-	out := new(BatchTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreement) GetRecords() []EventVoteDisagreementRecord {
+	if m != nil {
+		return m.Records
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error) {
-	out := new(ERC20ToDenomResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// EventVoteDisagreementRecord is one of the competing events at a nonce, with
+// the current power of each of its voters and their sum
+type EventVoteDisagreementRecord struct {
+	EventHash []byte       `protobuf:"bytes,1,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
+	Event     *types1.Any  `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Accepted  bool         `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Voters    []EventVoter `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters"`
+	Power     int64        `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (c *queryClient) DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error) {
-	out := new(DenomToERC20ParamsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementRecord) Reset()         { *m = EventVoteDisagreementRecord{} }
+func (m *EventVoteDisagreementRecord) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDisagreementRecord) ProtoMessage()    {}
+func (*EventVoteDisagreementRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{61}
+}
+func (m *EventVoteDisagreementRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDisagreementRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDisagreementRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventVoteDisagreementRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDisagreementRecord.Merge(m, src)
+}
+func (m *EventVoteDisagreementRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDisagreementRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDisagreementRecord.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error) {
-	out := new(DenomToERC20Response)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoteDisagreementRecord proto.InternalMessageInfo
+
+func (m *EventVoteDisagreementRecord) GetEventHash() []byte {
+	if m != nil {
+		return m.EventHash
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error) {
-	out := new(BatchedSendToEthereumsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchedSendToEthereums", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementRecord) GetEvent() *types1.Any {
+	if m != nil {
+		return m.Event
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error) {
-	out := new(UnbatchedSendToEthereumsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnbatchedSendToEthereums", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementRecord) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
-	out := new(DelegateKeysByValidatorResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementRecord) GetVoters() []EventVoter {
+	if m != nil {
+		return m.Voters
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error) {
-	out := new(DelegateKeysByEthereumSignerResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByEthereumSigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoteDisagreementRecord) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
-	out := new(DelegateKeysByOrchestratorResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventVoter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
-	out := new(DelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventVoter) Reset()         { *m = EventVoter{} }
+func (m *EventVoter) String() string { return proto.CompactTextString(m) }
+func (*EventVoter) ProtoMessage()    {}
+func (*EventVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{62}
+}
+func (m *EventVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoter.Merge(m, src)
+}
+func (m *EventVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoter.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error) {
-	out := new(LastObservedEthereumHeightResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastObservedEthereumHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVoter proto.InternalMessageInfo
+
+func (m *EventVoter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Module parameters query
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
-	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
-	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
-	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
-	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
-	// pending ethereum signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedEthereumEvent(context.Context, *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by gravity
-	ERC20ToDenom(context.Context, *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error)
-	// DenomToERC20Params implements a query that allows ERC-20 parameter
-	// information to be retrieved by a Cosmos base denomination.
-	DenomToERC20Params(context.Context, *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error)
-	// Query for info about denoms tracked by gravity
-	DenomToERC20(context.Context, *DenomToERC20Request) (*DenomToERC20Response, error)
-	// Query for batch send to ethereums
-	BatchedSendToEthereums(context.Context, *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error)
-	// Query for unbatched send to ethereums
-	UnbatchedSendToEthereums(context.Context, *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
-	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
-	LastObservedEthereumHeight(context.Context, *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error)
+func (m *EventVoter) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type SignerSetAtEthereumHeightRequest struct {
+	EthereumHeight uint64 `protobuf:"varint,1,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
-}
-func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
+func (m *SignerSetAtEthereumHeightRequest) Reset()         { *m = SignerSetAtEthereumHeightRequest{} }
+func (m *SignerSetAtEthereumHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetAtEthereumHeightRequest) ProtoMessage()    {}
+func (*SignerSetAtEthereumHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{63}
 }
-func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+func (m *SignerSetAtEthereumHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
-}
-func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
+func (m *SignerSetAtEthereumHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetAtEthereumHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
+func (m *SignerSetAtEthereumHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetAtEthereumHeightRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
+func (m *SignerSetAtEthereumHeightRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+func (m *SignerSetAtEthereumHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetAtEthereumHeightRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+
+var xxx_messageInfo_SignerSetAtEthereumHeightRequest proto.InternalMessageInfo
+
+func (m *SignerSetAtEthereumHeightRequest) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+
+type SignerSetAtEthereumHeightResponse struct {
+	ObservedSignerSet *ObservedSignerSet `protobuf:"bytes,1,opt,name=observed_signer_set,json=observedSignerSet,proto3" json:"observed_signer_set,omitempty"`
 }
-func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+
+func (m *SignerSetAtEthereumHeightResponse) Reset()         { *m = SignerSetAtEthereumHeightResponse{} }
+func (m *SignerSetAtEthereumHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetAtEthereumHeightResponse) ProtoMessage()    {}
+func (*SignerSetAtEthereumHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{64}
 }
-func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+func (m *SignerSetAtEthereumHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+func (m *SignerSetAtEthereumHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetAtEthereumHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LastSubmittedEthereumEvent(ctx context.Context, req *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedEthereumEvent not implemented")
+func (m *SignerSetAtEthereumHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetAtEthereumHeightResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+func (m *SignerSetAtEthereumHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
+func (m *SignerSetAtEthereumHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetAtEthereumHeightResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DenomToERC20Params(ctx context.Context, req *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20Params not implemented")
+
+var xxx_messageInfo_SignerSetAtEthereumHeightResponse proto.InternalMessageInfo
+
+func (m *SignerSetAtEthereumHeightResponse) GetObservedSignerSet() *ObservedSignerSet {
+	if m != nil {
+		return m.ObservedSignerSet
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *DenomToERC20Request) (*DenomToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
+
+type ObservedSignerSetHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) BatchedSendToEthereums(ctx context.Context, req *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToEthereums not implemented")
+
+func (m *ObservedSignerSetHistoryRequest) Reset()         { *m = ObservedSignerSetHistoryRequest{} }
+func (m *ObservedSignerSetHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ObservedSignerSetHistoryRequest) ProtoMessage()    {}
+func (*ObservedSignerSetHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{65}
 }
-func (*UnimplementedQueryServer) UnbatchedSendToEthereums(ctx context.Context, req *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToEthereums not implemented")
+func (m *ObservedSignerSetHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+func (m *ObservedSignerSetHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedSignerSetHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DelegateKeysByEthereumSigner(ctx context.Context, req *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByEthereumSigner not implemented")
+func (m *ObservedSignerSetHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedSignerSetHistoryRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+func (m *ObservedSignerSetHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+func (m *ObservedSignerSetHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedSignerSetHistoryRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LastObservedEthereumHeight(ctx context.Context, req *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastObservedEthereumHeight not implemented")
+
+var xxx_messageInfo_ObservedSignerSetHistoryRequest proto.InternalMessageInfo
+
+func (m *ObservedSignerSetHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type ObservedSignerSetHistoryResponse struct {
+	ObservedSignerSets []ObservedSignerSet `protobuf:"bytes,1,rep,name=observed_signer_sets,json=observedSignerSets,proto3" json:"observed_signer_sets"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *ObservedSignerSetHistoryResponse) Reset()         { *m = ObservedSignerSetHistoryResponse{} }
+func (m *ObservedSignerSetHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ObservedSignerSetHistoryResponse) ProtoMessage()    {}
+func (*ObservedSignerSetHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{66}
+}
+func (m *ObservedSignerSetHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedSignerSetHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedSignerSetHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ObservedSignerSetHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedSignerSetHistoryResponse.Merge(m, src)
+}
+func (m *ObservedSignerSetHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedSignerSetHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedSignerSetHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
+var xxx_messageInfo_ObservedSignerSetHistoryResponse proto.InternalMessageInfo
+
+func (m *ObservedSignerSetHistoryResponse) GetObservedSignerSets() []ObservedSignerSet {
+	if m != nil {
+		return m.ObservedSignerSets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestSignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LatestSignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
+func (m *ObservedSignerSetHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExecutedBatchTxsRequest struct {
+	Pagination    *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TokenContract string             `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	SinceNonce    uint64             `protobuf:"varint,3,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	UntilNonce    uint64             `protobuf:"varint,4,opt,name=until_nonce,json=untilNonce,proto3" json:"until_nonce,omitempty"`
 }
 
-func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+func (m *ExecutedBatchTxsRequest) Reset()         { *m = ExecutedBatchTxsRequest{} }
+func (m *ExecutedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchTxsRequest) ProtoMessage()    {}
+func (*ExecutedBatchTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{67}
+}
+func (m *ExecutedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ExecutedBatchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchTxsRequest.Merge(m, src)
+}
+func (m *ExecutedBatchTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchTxsRequest.DiscardUnknown(m)
 }
 
-func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+var xxx_messageInfo_ExecutedBatchTxsRequest proto.InternalMessageInfo
+
+func (m *ExecutedBatchTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxs(ctx, in)
+func (m *ExecutedBatchTxsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxs",
+	return ""
+}
+
+func (m *ExecutedBatchTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
+	return 0
+}
+
+func (m *ExecutedBatchTxsRequest) GetUntilNonce() uint64 {
+	if m != nil {
+		return m.UntilNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExecutedBatchTxsResponse struct {
+	Batches    []ExecutedBatchTx   `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+func (m *ExecutedBatchTxsResponse) Reset()         { *m = ExecutedBatchTxsResponse{} }
+func (m *ExecutedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchTxsResponse) ProtoMessage()    {}
+func (*ExecutedBatchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{68}
+}
+func (m *ExecutedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ExecutedBatchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchTxsResponse.Merge(m, src)
+}
+func (m *ExecutedBatchTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchTxsResponse.DiscardUnknown(m)
 }
 
-func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
+var xxx_messageInfo_ExecutedBatchTxsResponse proto.InternalMessageInfo
+
+func (m *ExecutedBatchTxsResponse) GetBatches() []ExecutedBatchTx {
+	if m != nil {
+		return m.Batches
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
+func (m *ExecutedBatchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedSignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedSignerSetTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExecutedContractCallTxsRequest struct {
+	Pagination        *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	InvalidationScope []byte             `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	SinceNonce        uint64             `protobuf:"varint,3,opt,name=since_nonce,json=sinceNonce,proto3" json:"since_nonce,omitempty"`
+	UntilNonce        uint64             `protobuf:"varint,4,opt,name=until_nonce,json=untilNonce,proto3" json:"until_nonce,omitempty"`
 }
 
-func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedBatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedBatchTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
+func (m *ExecutedContractCallTxsRequest) Reset()         { *m = ExecutedContractCallTxsRequest{} }
+func (m *ExecutedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutedContractCallTxsRequest) ProtoMessage()    {}
+func (*ExecutedContractCallTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{69}
+}
+func (m *ExecutedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedContractCallTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ExecutedContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedContractCallTxsRequest.Merge(m, src)
+}
+func (m *ExecutedContractCallTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedContractCallTxsRequest.DiscardUnknown(m)
 }
 
-func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
+var xxx_messageInfo_ExecutedContractCallTxsRequest proto.InternalMessageInfo
+
+func (m *ExecutedContractCallTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LastSubmittedEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastSubmittedEthereumEventRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastSubmittedEthereumEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, req.(*LastSubmittedEthereumEventRequest))
+func (m *ExecutedContractCallTxsRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
+func (m *ExecutedContractCallTxsRequest) GetSinceNonce() uint64 {
+	if m != nil {
+		return m.SinceNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ERC20ToDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ERC20ToDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*ERC20ToDenomRequest))
+func (m *ExecutedContractCallTxsRequest) GetUntilNonce() uint64 {
+	if m != nil {
+		return m.UntilNonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DenomToERC20Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomToERC20ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToERC20Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DenomToERC20Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToERC20Params(ctx, req.(*DenomToERC20ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExecutedContractCallTxsResponse struct {
+	Calls      []ExecutedContractCallTx `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomToERC20Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToERC20(ctx, in)
+func (m *ExecutedContractCallTxsResponse) Reset()         { *m = ExecutedContractCallTxsResponse{} }
+func (m *ExecutedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutedContractCallTxsResponse) ProtoMessage()    {}
+func (*ExecutedContractCallTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{70}
+}
+func (m *ExecutedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedContractCallTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DenomToERC20",
+}
+func (m *ExecutedContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedContractCallTxsResponse.Merge(m, src)
+}
+func (m *ExecutedContractCallTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedContractCallTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedContractCallTxsResponse proto.InternalMessageInfo
+
+func (m *ExecutedContractCallTxsResponse) GetCalls() []ExecutedContractCallTx {
+	if m != nil {
+		return m.Calls
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToERC20(ctx, req.(*DenomToERC20Request))
+	return nil
+}
+
+func (m *ExecutedContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchedSendToEthereumsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchedSendToEthereums(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchedSendToEthereums",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchedSendToEthereums(ctx, req.(*BatchedSendToEthereumsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RelaySignatures holds the signatures over an outgoing tx as the v, r and s
+// arrays Gravity.sol takes, aligned to the signers of the current signer set.
+// Signers that did not sign have a zero v and empty r and s.
+type RelaySignatures struct {
+	V []uint32 `protobuf:"varint,1,rep,packed,name=v,proto3" json:"v,omitempty"`
+	R [][]byte `protobuf:"bytes,2,rep,name=r,proto3" json:"r,omitempty"`
+	S [][]byte `protobuf:"bytes,3,rep,name=s,proto3" json:"s,omitempty"`
 }
 
-func _Query_UnbatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbatchedSendToEthereumsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnbatchedSendToEthereums",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, req.(*UnbatchedSendToEthereumsRequest))
+func (m *RelaySignatures) Reset()         { *m = RelaySignatures{} }
+func (m *RelaySignatures) String() string { return proto.CompactTextString(m) }
+func (*RelaySignatures) ProtoMessage()    {}
+func (*RelaySignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{71}
+}
+func (m *RelaySignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelaySignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelaySignatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RelaySignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySignatures.Merge(m, src)
+}
+func (m *RelaySignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelaySignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySignatures.DiscardUnknown(m)
 }
 
-func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+var xxx_messageInfo_RelaySignatures proto.InternalMessageInfo
+
+func (m *RelaySignatures) GetV() []uint32 {
+	if m != nil {
+		return m.V
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegateKeysByEthereumSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByEthereumSignerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RelaySignatures) GetR() [][]byte {
+	if m != nil {
+		return m.R
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, in)
+	return nil
+}
+
+func (m *RelaySignatures) GetS() [][]byte {
+	if m != nil {
+		return m.S
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByEthereumSigner",
+	return nil
+}
+
+type RelayableSignerSetTx struct {
+	SignerSet  *SignerSetTx    `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty"`
+	Signatures RelaySignatures `protobuf:"bytes,2,opt,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *RelayableSignerSetTx) Reset()         { *m = RelayableSignerSetTx{} }
+func (m *RelayableSignerSetTx) String() string { return proto.CompactTextString(m) }
+func (*RelayableSignerSetTx) ProtoMessage()    {}
+func (*RelayableSignerSetTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{72}
+}
+func (m *RelayableSignerSetTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableSignerSetTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableSignerSetTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, req.(*DelegateKeysByEthereumSignerRequest))
+}
+func (m *RelayableSignerSetTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableSignerSetTx.Merge(m, src)
+}
+func (m *RelayableSignerSetTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableSignerSetTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableSignerSetTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayableSignerSetTx proto.InternalMessageInfo
+
+func (m *RelayableSignerSetTx) GetSignerSet() *SignerSetTx {
+	if m != nil {
+		return m.SignerSet
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByOrchestratorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RelayableSignerSetTx) GetSignatures() RelaySignatures {
+	if m != nil {
+		return m.Signatures
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
+	return RelaySignatures{}
+}
+
+type RelayableBatchTx struct {
+	Batch      *BatchTx        `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Signatures RelaySignatures `protobuf:"bytes,2,opt,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *RelayableBatchTx) Reset()         { *m = RelayableBatchTx{} }
+func (m *RelayableBatchTx) String() string { return proto.CompactTextString(m) }
+func (*RelayableBatchTx) ProtoMessage()    {}
+func (*RelayableBatchTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{73}
+}
+func (m *RelayableBatchTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableBatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableBatchTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByOrchestrator",
+}
+func (m *RelayableBatchTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableBatchTx.Merge(m, src)
+}
+func (m *RelayableBatchTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableBatchTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableBatchTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayableBatchTx proto.InternalMessageInfo
+
+func (m *RelayableBatchTx) GetBatch() *BatchTx {
+	if m != nil {
+		return m.Batch
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
+	return nil
+}
+
+func (m *RelayableBatchTx) GetSignatures() RelaySignatures {
+	if m != nil {
+		return m.Signatures
 	}
-	return interceptor(ctx, in, info, handler)
+	return RelaySignatures{}
 }
 
-func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type RelayableContractCallTx struct {
+	LogicCall  *ContractCallTx `protobuf:"bytes,1,opt,name=logic_call,json=logicCall,proto3" json:"logic_call,omitempty"`
+	Signatures RelaySignatures `protobuf:"bytes,2,opt,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *RelayableContractCallTx) Reset()         { *m = RelayableContractCallTx{} }
+func (m *RelayableContractCallTx) String() string { return proto.CompactTextString(m) }
+func (*RelayableContractCallTx) ProtoMessage()    {}
+func (*RelayableContractCallTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{74}
+}
+func (m *RelayableContractCallTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableContractCallTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableContractCallTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeys(ctx, in)
+}
+func (m *RelayableContractCallTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableContractCallTx.Merge(m, src)
+}
+func (m *RelayableContractCallTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableContractCallTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableContractCallTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayableContractCallTx proto.InternalMessageInfo
+
+func (m *RelayableContractCallTx) GetLogicCall() *ContractCallTx {
+	if m != nil {
+		return m.LogicCall
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeys",
+	return nil
+}
+
+func (m *RelayableContractCallTx) GetSignatures() RelaySignatures {
+	if m != nil {
+		return m.Signatures
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
+	return RelaySignatures{}
+}
+
+type RelayableSignerSetTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RelayableSignerSetTxsRequest) Reset()         { *m = RelayableSignerSetTxsRequest{} }
+func (m *RelayableSignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*RelayableSignerSetTxsRequest) ProtoMessage()    {}
+func (*RelayableSignerSetTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{75}
+}
+func (m *RelayableSignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableSignerSetTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableSignerSetTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RelayableSignerSetTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableSignerSetTxsRequest.Merge(m, src)
+}
+func (m *RelayableSignerSetTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableSignerSetTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableSignerSetTxsRequest.DiscardUnknown(m)
 }
 
-func _Query_LastObservedEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastObservedEthereumHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RelayableSignerSetTxsRequest proto.InternalMessageInfo
+
+func (m *RelayableSignerSetTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastObservedEthereumHeight(ctx, in)
+	return nil
+}
+
+type RelayableSignerSetTxsResponse struct {
+	// the last observed signer set, with its signers in the order the
+	// signatures are aligned to
+	CurrentSignerSet *SignerSetTx           `protobuf:"bytes,1,opt,name=current_signer_set,json=currentSignerSet,proto3" json:"current_signer_set,omitempty"`
+	SignerSets       []RelayableSignerSetTx `protobuf:"bytes,2,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets"`
+	Pagination       *query.PageResponse    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RelayableSignerSetTxsResponse) Reset()         { *m = RelayableSignerSetTxsResponse{} }
+func (m *RelayableSignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelayableSignerSetTxsResponse) ProtoMessage()    {}
+func (*RelayableSignerSetTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{76}
+}
+func (m *RelayableSignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableSignerSetTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableSignerSetTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastObservedEthereumHeight",
+}
+func (m *RelayableSignerSetTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableSignerSetTxsResponse.Merge(m, src)
+}
+func (m *RelayableSignerSetTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableSignerSetTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableSignerSetTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayableSignerSetTxsResponse proto.InternalMessageInfo
+
+func (m *RelayableSignerSetTxsResponse) GetCurrentSignerSet() *SignerSetTx {
+	if m != nil {
+		return m.CurrentSignerSet
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastObservedEthereumHeight(ctx, req.(*LastObservedEthereumHeightRequest))
+	return nil
+}
+
+func (m *RelayableSignerSetTxsResponse) GetSignerSets() []RelayableSignerSetTx {
+	if m != nil {
+		return m.SignerSets
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SignerSetTx",
-			Handler:    _Query_SignerSetTx_Handler,
-		},
-		{
-			MethodName: "LatestSignerSetTx",
-			Handler:    _Query_LatestSignerSetTx_Handler,
-		},
-		{
-			MethodName: "BatchTx",
-			Handler:    _Query_BatchTx_Handler,
-		},
-		{
-			MethodName: "ContractCallTx",
-			Handler:    _Query_ContractCallTx_Handler,
-		},
-		{
-			MethodName: "SignerSetTxs",
-			Handler:    _Query_SignerSetTxs_Handler,
-		},
-		{
-			MethodName: "BatchTxs",
-			Handler:    _Query_BatchTxs_Handler,
-		},
-		{
-			MethodName: "ContractCallTxs",
-			Handler:    _Query_ContractCallTxs_Handler,
-		},
-		{
-			MethodName: "SignerSetTxConfirmations",
-			Handler:    _Query_SignerSetTxConfirmations_Handler,
-		},
-		{
-			MethodName: "BatchTxConfirmations",
-			Handler:    _Query_BatchTxConfirmations_Handler,
-		},
-		{
-			MethodName: "ContractCallTxConfirmations",
-			Handler:    _Query_ContractCallTxConfirmations_Handler,
-		},
-		{
-			MethodName: "UnsignedSignerSetTxs",
-			Handler:    _Query_UnsignedSignerSetTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedBatchTxs",
-			Handler:    _Query_UnsignedBatchTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedContractCallTxs",
-			Handler:    _Query_UnsignedContractCallTxs_Handler,
-		},
-		{
-			MethodName: "LastSubmittedEthereumEvent",
-			Handler:    _Query_LastSubmittedEthereumEvent_Handler,
-		},
-		{
-			MethodName: "BatchTxFees",
-			Handler:    _Query_BatchTxFees_Handler,
-		},
-		{
-			MethodName: "ERC20ToDenom",
-			Handler:    _Query_ERC20ToDenom_Handler,
-		},
-		{
-			MethodName: "DenomToERC20Params",
-			Handler:    _Query_DenomToERC20Params_Handler,
-		},
-		{
-			MethodName: "DenomToERC20",
-			Handler:    _Query_DenomToERC20_Handler,
-		},
-		{
-			MethodName: "BatchedSendToEthereums",
-			Handler:    _Query_BatchedSendToEthereums_Handler,
-		},
-		{
-			MethodName: "UnbatchedSendToEthereums",
-			Handler:    _Query_UnbatchedSendToEthereums_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByValidator",
-			Handler:    _Query_DelegateKeysByValidator_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByEthereumSigner",
-			Handler:    _Query_DelegateKeysByEthereumSigner_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByOrchestrator",
-			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
-		},
-		{
-			MethodName: "DelegateKeys",
-			Handler:    _Query_DelegateKeys_Handler,
-		},
-		{
-			MethodName: "LastObservedEthereumHeight",
-			Handler:    _Query_LastObservedEthereumHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gravity/v1/query.proto",
-}
-
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayableSignerSetTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RelayableBatchTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RelayableBatchTxsRequest) Reset()         { *m = RelayableBatchTxsRequest{} }
+func (m *RelayableBatchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*RelayableBatchTxsRequest) ProtoMessage()    {}
+func (*RelayableBatchTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{77}
+}
+func (m *RelayableBatchTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableBatchTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayableBatchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableBatchTxsRequest.Merge(m, src)
+}
+func (m *RelayableBatchTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableBatchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableBatchTxsRequest.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RelayableBatchTxsRequest proto.InternalMessageInfo
+
+func (m *RelayableBatchTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RelayableBatchTxsResponse struct {
+	// the last observed signer set, with its signers in the order the
+	// signatures are aligned to
+	CurrentSignerSet *SignerSetTx        `protobuf:"bytes,1,opt,name=current_signer_set,json=currentSignerSet,proto3" json:"current_signer_set,omitempty"`
+	Batches          []RelayableBatchTx  `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches"`
+	Pagination       *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *RelayableBatchTxsResponse) Reset()         { *m = RelayableBatchTxsResponse{} }
+func (m *RelayableBatchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelayableBatchTxsResponse) ProtoMessage()    {}
+func (*RelayableBatchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{78}
+}
+func (m *RelayableBatchTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableBatchTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RelayableBatchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableBatchTxsResponse.Merge(m, src)
 }
-
-func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelayableBatchTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableBatchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableBatchTxsResponse.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_RelayableBatchTxsResponse proto.InternalMessageInfo
+
+func (m *RelayableBatchTxsResponse) GetCurrentSignerSet() *SignerSetTx {
+	if m != nil {
+		return m.CurrentSignerSet
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayableBatchTxsResponse) GetBatches() []RelayableBatchTx {
+	if m != nil {
+		return m.Batches
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelayableBatchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type RelayableContractCallTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayableContractCallTxsRequest) Reset()         { *m = RelayableContractCallTxsRequest{} }
+func (m *RelayableContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*RelayableContractCallTxsRequest) ProtoMessage()    {}
+func (*RelayableContractCallTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{79}
+}
+func (m *RelayableContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableContractCallTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelayableContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableContractCallTxsRequest.Merge(m, src)
+}
+func (m *RelayableContractCallTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableContractCallTxsRequest.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		{
-			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_RelayableContractCallTxsRequest proto.InternalMessageInfo
+
+func (m *RelayableContractCallTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type RelayableContractCallTxsResponse struct {
+	// the last observed signer set, with its signers in the order the
+	// signatures are aligned to
+	CurrentSignerSet *SignerSetTx              `protobuf:"bytes,1,opt,name=current_signer_set,json=currentSignerSet,proto3" json:"current_signer_set,omitempty"`
+	Calls            []RelayableContractCallTx `protobuf:"bytes,2,rep,name=calls,proto3" json:"calls"`
+	Pagination       *query.PageResponse       `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelayableContractCallTxsResponse) Reset()         { *m = RelayableContractCallTxsResponse{} }
+func (m *RelayableContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelayableContractCallTxsResponse) ProtoMessage()    {}
+func (*RelayableContractCallTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{80}
+}
+func (m *RelayableContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayableContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayableContractCallTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayableContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayableContractCallTxsResponse.Merge(m, src)
+}
+func (m *RelayableContractCallTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayableContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayableContractCallTxsResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_RelayableContractCallTxsResponse proto.InternalMessageInfo
+
+func (m *RelayableContractCallTxsResponse) GetCurrentSignerSet() *SignerSetTx {
+	if m != nil {
+		return m.CurrentSignerSet
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *RelayableContractCallTxsResponse) GetCalls() []RelayableContractCallTx {
+	if m != nil {
+		return m.Calls
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RelayableContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignerSetTxRelayCalldataRequest struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
 }
 
-func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Batch != nil {
-		{
-			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *SignerSetTxRelayCalldataRequest) Reset()         { *m = SignerSetTxRelayCalldataRequest{} }
+func (m *SignerSetTxRelayCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxRelayCalldataRequest) ProtoMessage()    {}
+func (*SignerSetTxRelayCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{81}
+}
+func (m *SignerSetTxRelayCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxRelayCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxRelayCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignerSetTxRelayCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxRelayCalldataRequest.Merge(m, src)
+}
+func (m *SignerSetTxRelayCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxRelayCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxRelayCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerSetTxRelayCalldataRequest proto.InternalMessageInfo
+
+func (m *SignerSetTxRelayCalldataRequest) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignerSetTxRelayCalldataResponse struct {
+	// the address of the Gravity.sol contract to send the calldata to
+	BridgeEthereumAddress string `protobuf:"bytes,1,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	Calldata              []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
 }
 
-func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+func (m *SignerSetTxRelayCalldataResponse) Reset()         { *m = SignerSetTxRelayCalldataResponse{} }
+func (m *SignerSetTxRelayCalldataResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxRelayCalldataResponse) ProtoMessage()    {}
+func (*SignerSetTxRelayCalldataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{82}
+}
+func (m *SignerSetTxRelayCalldataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxRelayCalldataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxRelayCalldataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SignerSetTxRelayCalldataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxRelayCalldataResponse.Merge(m, src)
+}
+func (m *SignerSetTxRelayCalldataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxRelayCalldataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxRelayCalldataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxRelayCalldataResponse proto.InternalMessageInfo
+
+func (m *SignerSetTxRelayCalldataResponse) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetTxRelayCalldataResponse) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchTxRelayCalldataRequest struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 }
 
-func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		{
-			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BatchTxRelayCalldataRequest) Reset()         { *m = BatchTxRelayCalldataRequest{} }
+func (m *BatchTxRelayCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxRelayCalldataRequest) ProtoMessage()    {}
+func (*BatchTxRelayCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{83}
+}
+func (m *BatchTxRelayCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxRelayCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxRelayCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BatchTxRelayCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxRelayCalldataRequest.Merge(m, src)
 }
-
-func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchTxRelayCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxRelayCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxRelayCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_BatchTxRelayCalldataRequest proto.InternalMessageInfo
+
+func (m *BatchTxRelayCalldataRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchTxRelayCalldataRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchTxRelayCalldataResponse struct {
+	// the address of the Gravity.sol contract to send the calldata to
+	BridgeEthereumAddress string `protobuf:"bytes,1,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	Calldata              []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
 }
 
-func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *BatchTxRelayCalldataResponse) Reset()         { *m = BatchTxRelayCalldataResponse{} }
+func (m *BatchTxRelayCalldataResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxRelayCalldataResponse) ProtoMessage()    {}
+func (*BatchTxRelayCalldataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{84}
+}
+func (m *BatchTxRelayCalldataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxRelayCalldataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxRelayCalldataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchTxRelayCalldataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxRelayCalldataResponse.Merge(m, src)
+}
+func (m *BatchTxRelayCalldataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxRelayCalldataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxRelayCalldataResponse.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchTxRelayCalldataResponse proto.InternalMessageInfo
+
+func (m *BatchTxRelayCalldataResponse) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchTxRelayCalldataResponse) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
 }
 
-func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type ContractCallTxRelayCalldataRequest struct {
+	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+}
+
+func (m *ContractCallTxRelayCalldataRequest) Reset()         { *m = ContractCallTxRelayCalldataRequest{} }
+func (m *ContractCallTxRelayCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxRelayCalldataRequest) ProtoMessage()    {}
+func (*ContractCallTxRelayCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{85}
+}
+func (m *ContractCallTxRelayCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallTxRelayCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallTxRelayCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ContractCallTxRelayCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxRelayCalldataRequest.Merge(m, src)
+}
+func (m *ContractCallTxRelayCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallTxRelayCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxRelayCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContractCallTxRelayCalldataRequest proto.InternalMessageInfo
+
+func (m *ContractCallTxRelayCalldataRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallTxRelayCalldataRequest) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
 }
 
-func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type ContractCallTxRelayCalldataResponse struct {
+	// the address of the Gravity.sol contract to send the calldata to
+	BridgeEthereumAddress string `protobuf:"bytes,1,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	Calldata              []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
+}
+
+func (m *ContractCallTxRelayCalldataResponse) Reset()         { *m = ContractCallTxRelayCalldataResponse{} }
+func (m *ContractCallTxRelayCalldataResponse) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxRelayCalldataResponse) ProtoMessage()    {}
+func (*ContractCallTxRelayCalldataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{86}
+}
+func (m *ContractCallTxRelayCalldataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallTxRelayCalldataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallTxRelayCalldataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *ContractCallTxRelayCalldataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxRelayCalldataResponse.Merge(m, src)
+}
+func (m *ContractCallTxRelayCalldataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallTxRelayCalldataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxRelayCalldataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallTxRelayCalldataResponse proto.InternalMessageInfo
+
+func (m *ContractCallTxRelayCalldataResponse) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallTxRelayCalldataResponse) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointRequest selects one outgoing tx, either a signer set by nonce, a
+// batch by token contract and nonce, or a contract call by invalidation scope
+// and nonce
+type CheckpointRequest struct {
+	SignerSetNonce    uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	TokenContract     string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce        uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	InvalidationScope []byte `protobuf:"bytes,4,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,5,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 }
 
-func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CheckpointRequest) Reset()         { *m = CheckpointRequest{} }
+func (m *CheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointRequest) ProtoMessage()    {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{87}
+}
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
+}
+func (m *CheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
 }
 
-func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *CheckpointRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *CheckpointRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CheckpointResponse struct {
+	GravityId string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	// the ABI encoded checkpoint hash, GetCheckpoint(gravity_id)
+	Checkpoint []byte `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// the personal sign digest of the checkpoint, the hash signatures over the
+	// checkpoint are verified against
+	SignatureDigest []byte `protobuf:"bytes,3,opt,name=signature_digest,json=signatureDigest,proto3" json:"signature_digest,omitempty"`
+	// the ABI decoded arguments of the checkpoint, in encoding order
+	Fields []CheckpointField `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields"`
 }
 
-func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{88}
+}
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
 }
-
-func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+func (m *CheckpointResponse) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointResponse) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointResponse) GetSignatureDigest() []byte {
+	if m != nil {
+		return m.SignatureDigest
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointResponse) GetFields() []CheckpointField {
+	if m != nil {
+		return m.Fields
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointField is one ABI argument of a checkpoint, with its value
+// rendered as text
+type CheckpointField struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CheckpointField) Reset()         { *m = CheckpointField{} }
+func (m *CheckpointField) String() string { return proto.CompactTextString(m) }
+func (*CheckpointField) ProtoMessage()    {}
+func (*CheckpointField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{89}
+}
+func (m *CheckpointField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CheckpointField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointField.Merge(m, src)
+}
+func (m *CheckpointField) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointField) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointField.DiscardUnknown(m)
 }
 
-func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CheckpointField proto.InternalMessageInfo
+
+func (m *CheckpointField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointField) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointField) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorBridgeStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorBridgeStatsRequest) Reset()         { *m = ValidatorBridgeStatsRequest{} }
+func (m *ValidatorBridgeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgeStatsRequest) ProtoMessage()    {}
+func (*ValidatorBridgeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{90}
+}
+func (m *ValidatorBridgeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorBridgeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgeStatsRequest.Merge(m, src)
 }
-
-func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorBridgeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgeStatsRequest.DiscardUnknown(m)
 }
 
-func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ValidatorBridgeStatsRequest proto.InternalMessageInfo
+
+func (m *ValidatorBridgeStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ValidatorBridgeStatsResponse struct {
+	Stats      []ValidatorBridgeStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Epoch      BridgeStatsEpoch       `protobuf:"bytes,3,opt,name=epoch,proto3" json:"epoch"`
 }
 
-func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorBridgeStatsResponse) Reset()         { *m = ValidatorBridgeStatsResponse{} }
+func (m *ValidatorBridgeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgeStatsResponse) ProtoMessage()    {}
+func (*ValidatorBridgeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{91}
 }
-
-func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorBridgeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorBridgeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgeStatsResponse.Merge(m, src)
+}
+func (m *ValidatorBridgeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgeStatsResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorBridgeStatsResponse proto.InternalMessageInfo
+
+func (m *ValidatorBridgeStatsResponse) GetStats() []ValidatorBridgeStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
 
-func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ValidatorBridgeStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorBridgeStatsResponse) GetEpoch() BridgeStatsEpoch {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return BridgeStatsEpoch{}
 }
 
-func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RewardPoolRequest struct {
 }
 
-func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RewardPoolRequest) Reset()         { *m = RewardPoolRequest{} }
+func (m *RewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*RewardPoolRequest) ProtoMessage()    {}
+func (*RewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{92}
+}
+func (m *RewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPoolRequest.Merge(m, src)
+}
+func (m *RewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPoolRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_RewardPoolRequest proto.InternalMessageInfo
+
+type RewardPoolResponse struct {
+	Balance                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	TotalParticipation     uint64                                   `protobuf:"varint,2,opt,name=total_participation,json=totalParticipation,proto3" json:"total_participation,omitempty"`
+	NextDistributionHeight uint64                                   `protobuf:"varint,3,opt,name=next_distribution_height,json=nextDistributionHeight,proto3" json:"next_distribution_height,omitempty"`
 }
 
-func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardPoolResponse) Reset()         { *m = RewardPoolResponse{} }
+func (m *RewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*RewardPoolResponse) ProtoMessage()    {}
+func (*RewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{93}
+}
+func (m *RewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPoolResponse.Merge(m, src)
+}
+func (m *RewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPoolResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_RewardPoolResponse proto.InternalMessageInfo
+
+func (m *RewardPoolResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *RewardPoolResponse) GetTotalParticipation() uint64 {
+	if m != nil {
+		return m.TotalParticipation
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardPoolResponse) GetNextDistributionHeight() uint64 {
+	if m != nil {
+		return m.NextDistributionHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorRewardsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorRewardsRequest) Reset()         { *m = ValidatorRewardsRequest{} }
+func (m *ValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsRequest) ProtoMessage()    {}
+func (*ValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{94}
+}
+func (m *ValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ValidatorRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorRewardsResponse struct {
+	Rewards ValidatorRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
+	// the validator's share of the current balance if it were distributed now
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
 }
 
-func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *ValidatorRewardsResponse) Reset()         { *m = ValidatorRewardsResponse{} }
+func (m *ValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsResponse) ProtoMessage()    {}
+func (*ValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{95}
+}
+func (m *ValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsResponse.Merge(m, src)
+}
+func (m *ValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *ValidatorRewardsResponse) GetRewards() ValidatorRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return ValidatorRewards{}
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorRewardsResponse) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type BridgeHealthRequest struct {
+}
+
+func (m *BridgeHealthRequest) Reset()         { *m = BridgeHealthRequest{} }
+func (m *BridgeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeHealthRequest) ProtoMessage()    {}
+func (*BridgeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{96}
+}
+func (m *BridgeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHealthRequest.Merge(m, src)
+}
+func (m *BridgeHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHealthRequest.DiscardUnknown(m)
 }
 
-func (m *LastSubmittedEthereumEventRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeHealthRequest proto.InternalMessageInfo
+
+type BridgeHealthResponse struct {
+	// the highest severity of all the values below
+	Severity                   HealthSeverity             `protobuf:"varint,1,opt,name=severity,proto3,enum=gravity.v1.HealthSeverity" json:"severity,omitempty"`
+	EventStall                 HeightHealth               `protobuf:"bytes,2,opt,name=event_stall,json=eventStall,proto3" json:"event_stall"`
+	EthereumHeightLag          HeightHealth               `protobuf:"bytes,3,opt,name=ethereum_height_lag,json=ethereumHeightLag,proto3" json:"ethereum_height_lag"`
+	OldestOutgoingTxs          []OutgoingTxAgeHealth      `protobuf:"bytes,4,rep,name=oldest_outgoing_txs,json=oldestOutgoingTxs,proto3" json:"oldest_outgoing_txs"`
+	LatestSignerSetSignedPower SignerSetSignedPowerHealth `protobuf:"bytes,5,opt,name=latest_signer_set_signed_power,json=latestSignerSetSignedPower,proto3" json:"latest_signer_set_signed_power"`
+	PoolDepths                 []PoolDepthHealth          `protobuf:"bytes,6,rep,name=pool_depths,json=poolDepths,proto3" json:"pool_depths"`
+}
+
+func (m *BridgeHealthResponse) Reset()         { *m = BridgeHealthResponse{} }
+func (m *BridgeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeHealthResponse) ProtoMessage()    {}
+func (*BridgeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{97}
+}
+func (m *BridgeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHealthResponse.Merge(m, src)
+}
+func (m *BridgeHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHealthResponse.DiscardUnknown(m)
 }
 
-func (m *LastSubmittedEthereumEventRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeHealthResponse proto.InternalMessageInfo
+
+func (m *BridgeHealthResponse) GetSeverity() HealthSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return HealthSeverity_HEALTH_SEVERITY_OK
 }
 
-func (m *LastSubmittedEthereumEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeHealthResponse) GetEventStall() HeightHealth {
+	if m != nil {
+		return m.EventStall
 	}
-	return len(dAtA) - i, nil
+	return HeightHealth{}
 }
 
-func (m *LastSubmittedEthereumEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeHealthResponse) GetEthereumHeightLag() HeightHealth {
+	if m != nil {
+		return m.EthereumHeightLag
 	}
-	return dAtA[:n], nil
+	return HeightHealth{}
 }
 
-func (m *LastSubmittedEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeHealthResponse) GetOldestOutgoingTxs() []OutgoingTxAgeHealth {
+	if m != nil {
+		return m.OldestOutgoingTxs
+	}
+	return nil
 }
 
-func (m *LastSubmittedEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *BridgeHealthResponse) GetLatestSignerSetSignedPower() SignerSetSignedPowerHealth {
+	if m != nil {
+		return m.LatestSignerSetSignedPower
 	}
-	return len(dAtA) - i, nil
+	return SignerSetSignedPowerHealth{}
 }
 
-func (m *ERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeHealthResponse) GetPoolDepths() []PoolDepthHealth {
+	if m != nil {
+		return m.PoolDepths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HeightHealth is a number of blocks and its severity
+type HeightHealth struct {
+	Blocks   uint64         `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Severity HealthSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=gravity.v1.HealthSeverity" json:"severity,omitempty"`
 }
 
-func (m *ERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+func (m *HeightHealth) Reset()         { *m = HeightHealth{} }
+func (m *HeightHealth) String() string { return proto.CompactTextString(m) }
+func (*HeightHealth) ProtoMessage()    {}
+func (*HeightHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{98}
+}
+func (m *HeightHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HeightHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightHealth.Merge(m, src)
+}
+func (m *HeightHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightHealth.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HeightHealth proto.InternalMessageInfo
+
+func (m *HeightHealth) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HeightHealth) GetSeverity() HealthSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return HealthSeverity_HEALTH_SEVERITY_OK
 }
 
-func (m *ERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// OutgoingTxAgeHealth is the age in blocks of the oldest outgoing tx of a type
+// that has not been relayed yet, "signer_set", "batch" or "contract_call"
+type OutgoingTxAgeHealth struct {
+	TxType     string         `protobuf:"bytes,1,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	StoreIndex []byte         `protobuf:"bytes,2,opt,name=store_index,json=storeIndex,proto3" json:"store_index,omitempty"`
+	AgeBlocks  uint64         `protobuf:"varint,3,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+	Severity   HealthSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=gravity.v1.HealthSeverity" json:"severity,omitempty"`
+}
+
+func (m *OutgoingTxAgeHealth) Reset()         { *m = OutgoingTxAgeHealth{} }
+func (m *OutgoingTxAgeHealth) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxAgeHealth) ProtoMessage()    {}
+func (*OutgoingTxAgeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{99}
+}
+func (m *OutgoingTxAgeHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxAgeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxAgeHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OutgoingTxAgeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxAgeHealth.Merge(m, src)
+}
+func (m *OutgoingTxAgeHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxAgeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxAgeHealth.DiscardUnknown(m)
 }
 
-func (m *DenomToERC20ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OutgoingTxAgeHealth proto.InternalMessageInfo
+
+func (m *OutgoingTxAgeHealth) GetTxType() string {
+	if m != nil {
+		return m.TxType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DenomToERC20ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OutgoingTxAgeHealth) GetStoreIndex() []byte {
+	if m != nil {
+		return m.StoreIndex
+	}
+	return nil
 }
 
-func (m *DenomToERC20ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *OutgoingTxAgeHealth) GetAgeBlocks() uint64 {
+	if m != nil {
+		return m.AgeBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DenomToERC20ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutgoingTxAgeHealth) GetSeverity() HealthSeverity {
+	if m != nil {
+		return m.Severity
 	}
-	return dAtA[:n], nil
+	return HealthSeverity_HEALTH_SEVERITY_OK
 }
 
-func (m *DenomToERC20ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SignerSetSignedPowerHealth is the share of the power of the latest signer
+// set tx that has signed it
+type SignerSetSignedPowerHealth struct {
+	SignerSetNonce uint64                                 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	SignedPower    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=signed_power,json=signedPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signed_power"`
+	Severity       HealthSeverity                         `protobuf:"varint,3,opt,name=severity,proto3,enum=gravity.v1.HealthSeverity" json:"severity,omitempty"`
 }
 
-func (m *DenomToERC20ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Erc20Decimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Erc20Decimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Erc20Symbol) > 0 {
-		i -= len(m.Erc20Symbol)
-		copy(dAtA[i:], m.Erc20Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Erc20Name) > 0 {
-		i -= len(m.Erc20Name)
-		copy(dAtA[i:], m.Erc20Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DenomToERC20Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetSignedPowerHealth) Reset()         { *m = SignerSetSignedPowerHealth{} }
+func (m *SignerSetSignedPowerHealth) String() string { return proto.CompactTextString(m) }
+func (*SignerSetSignedPowerHealth) ProtoMessage()    {}
+func (*SignerSetSignedPowerHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{100}
 }
-
-func (m *DenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *SignerSetSignedPowerHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *DenomToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetSignedPowerHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetSignedPowerHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetSignedPowerHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetSignedPowerHealth.Merge(m, src)
 }
-
-func (m *DenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *SignerSetSignedPowerHealth) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SignerSetSignedPowerHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetSignedPowerHealth.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_SignerSetSignedPowerHealth proto.InternalMessageInfo
 
-func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *SignerSetSignedPowerHealth) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetSignedPowerHealth) GetSeverity() HealthSeverity {
+	if m != nil {
+		return m.Severity
 	}
-	return dAtA[:n], nil
+	return HealthSeverity_HEALTH_SEVERITY_OK
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PoolDepthHealth is the number and total amount of the unbatched sends to
+// Ethereum of a token
+type PoolDepthHealth struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Count         uint64                                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Severity      HealthSeverity                         `protobuf:"varint,4,opt,name=severity,proto3,enum=gravity.v1.HealthSeverity" json:"severity,omitempty"`
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PoolDepthHealth) Reset()         { *m = PoolDepthHealth{} }
+func (m *PoolDepthHealth) String() string { return proto.CompactTextString(m) }
+func (*PoolDepthHealth) ProtoMessage()    {}
+func (*PoolDepthHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{101}
 }
-
-func (m *DelegateKeysByEthereumSignerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolDepthHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDepthHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDepthHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegateKeysByEthereumSignerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolDepthHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDepthHealth.Merge(m, src)
 }
-
-func (m *DelegateKeysByEthereumSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PoolDepthHealth) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DelegateKeysByEthereumSignerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolDepthHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDepthHealth.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_PoolDepthHealth proto.InternalMessageInfo
 
-func (m *DelegateKeysByEthereumSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *PoolDepthHealth) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolDepthHealth) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *PoolDepthHealth) GetSeverity() HealthSeverity {
+	if m != nil {
+		return m.Severity
 	}
-	return len(dAtA) - i, nil
+	return HealthSeverity_HEALTH_SEVERITY_OK
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("gravity.v1.HealthSeverity", HealthSeverity_name, HealthSeverity_value)
+	proto.RegisterType((*ParamsRequest)(nil), "gravity.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "gravity.v1.ParamsResponse")
+	proto.RegisterType((*SignerSetTxRequest)(nil), "gravity.v1.SignerSetTxRequest")
+	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "gravity.v1.LatestSignerSetTxRequest")
+	proto.RegisterType((*SignerSetTxResponse)(nil), "gravity.v1.SignerSetTxResponse")
+	proto.RegisterType((*BatchTxRequest)(nil), "gravity.v1.BatchTxRequest")
+	proto.RegisterType((*BatchTxResponse)(nil), "gravity.v1.BatchTxResponse")
+	proto.RegisterType((*ContractCallTxRequest)(nil), "gravity.v1.ContractCallTxRequest")
+	proto.RegisterType((*ContractCallTxResponse)(nil), "gravity.v1.ContractCallTxResponse")
+	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "gravity.v1.SignerSetTxConfirmationsRequest")
+	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "gravity.v1.SignerSetTxConfirmationsResponse")
+	proto.RegisterType((*SignerSetTxsRequest)(nil), "gravity.v1.SignerSetTxsRequest")
+	proto.RegisterType((*SignerSetTxsResponse)(nil), "gravity.v1.SignerSetTxsResponse")
+	proto.RegisterType((*SignerSetDiffRequest)(nil), "gravity.v1.SignerSetDiffRequest")
+	proto.RegisterType((*SignerSetDiffResponse)(nil), "gravity.v1.SignerSetDiffResponse")
+	proto.RegisterType((*SignerPowerChange)(nil), "gravity.v1.SignerPowerChange")
+	proto.RegisterType((*BatchTxsRequest)(nil), "gravity.v1.BatchTxsRequest")
+	proto.RegisterType((*BatchTxsResponse)(nil), "gravity.v1.BatchTxsResponse")
+	proto.RegisterType((*ContractCallTxsRequest)(nil), "gravity.v1.ContractCallTxsRequest")
+	proto.RegisterType((*ContractCallTxsResponse)(nil), "gravity.v1.ContractCallTxsResponse")
+	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "gravity.v1.UnsignedSignerSetTxsRequest")
+	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "gravity.v1.UnsignedSignerSetTxsResponse")
+	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "gravity.v1.UnsignedBatchTxsRequest")
+	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "gravity.v1.UnsignedBatchTxsResponse")
+	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "gravity.v1.UnsignedContractCallTxsRequest")
+	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "gravity.v1.UnsignedContractCallTxsResponse")
+	proto.RegisterType((*BatchTxFeesRequest)(nil), "gravity.v1.BatchTxFeesRequest")
+	proto.RegisterType((*BatchTxFeesResponse)(nil), "gravity.v1.BatchTxFeesResponse")
+	proto.RegisterType((*NextBatchPreviewRequest)(nil), "gravity.v1.NextBatchPreviewRequest")
+	proto.RegisterType((*NextBatchPreviewResponse)(nil), "gravity.v1.NextBatchPreviewResponse")
+	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "gravity.v1.ContractCallTxConfirmationsRequest")
+	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "gravity.v1.ContractCallTxConfirmationsResponse")
+	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "gravity.v1.BatchTxConfirmationsRequest")
+	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "gravity.v1.BatchTxConfirmationsResponse")
+	proto.RegisterType((*LastSubmittedEthereumEventRequest)(nil), "gravity.v1.LastSubmittedEthereumEventRequest")
+	proto.RegisterType((*LastSubmittedEthereumEventResponse)(nil), "gravity.v1.LastSubmittedEthereumEventResponse")
+	proto.RegisterType((*ERC20ToDenomRequest)(nil), "gravity.v1.ERC20ToDenomRequest")
+	proto.RegisterType((*ERC20ToDenomResponse)(nil), "gravity.v1.ERC20ToDenomResponse")
+	proto.RegisterType((*DenomToERC20ParamsRequest)(nil), "gravity.v1.DenomToERC20ParamsRequest")
+	proto.RegisterType((*DenomToERC20ParamsResponse)(nil), "gravity.v1.DenomToERC20ParamsResponse")
+	proto.RegisterType((*DenomToERC20Request)(nil), "gravity.v1.DenomToERC20Request")
+	proto.RegisterType((*DenomToERC20Response)(nil), "gravity.v1.DenomToERC20Response")
+	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "gravity.v1.DelegateKeysByValidatorRequest")
+	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "gravity.v1.DelegateKeysByValidatorResponse")
+	proto.RegisterType((*DelegateKeysByEthereumSignerRequest)(nil), "gravity.v1.DelegateKeysByEthereumSignerRequest")
+	proto.RegisterType((*DelegateKeysByEthereumSignerResponse)(nil), "gravity.v1.DelegateKeysByEthereumSignerResponse")
+	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "gravity.v1.DelegateKeysByOrchestratorRequest")
+	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "gravity.v1.DelegateKeysByOrchestratorResponse")
+	proto.RegisterType((*DelegateKeysRequest)(nil), "gravity.v1.DelegateKeysRequest")
+	proto.RegisterType((*DelegateKeysResponse)(nil), "gravity.v1.DelegateKeysResponse")
+	proto.RegisterType((*BatchedSendToEthereumsRequest)(nil), "gravity.v1.BatchedSendToEthereumsRequest")
+	proto.RegisterType((*BatchedSendToEthereumsResponse)(nil), "gravity.v1.BatchedSendToEthereumsResponse")
+	proto.RegisterType((*UnbatchedSendToEthereumsRequest)(nil), "gravity.v1.UnbatchedSendToEthereumsRequest")
+	proto.RegisterType((*UnbatchedSendToEthereumsResponse)(nil), "gravity.v1.UnbatchedSendToEthereumsResponse")
+	proto.RegisterType((*SendToEthereumByIDRequest)(nil), "gravity.v1.SendToEthereumByIDRequest")
+	proto.RegisterType((*SendToEthereumByIDResponse)(nil), "gravity.v1.SendToEthereumByIDResponse")
+	proto.RegisterType((*LastObservedEthereumHeightRequest)(nil), "gravity.v1.LastObservedEthereumHeightRequest")
+	proto.RegisterType((*LastObservedEthereumHeightResponse)(nil), "gravity.v1.LastObservedEthereumHeightResponse")
+	proto.RegisterType((*EventVoteDisagreementsRequest)(nil), "gravity.v1.EventVoteDisagreementsRequest")
+	proto.RegisterType((*EventVoteDisagreementsResponse)(nil), "gravity.v1.EventVoteDisagreementsResponse")
+	proto.RegisterType((*EventVoteDisagreement)(nil), "gravity.v1.EventVoteDisagreement")
+	proto.RegisterType((*EventVoteDisagreementRecord)(nil), "gravity.v1.EventVoteDisagreementRecord")
+	proto.RegisterType((*EventVoter)(nil), "gravity.v1.EventVoter")
+	proto.RegisterType((*SignerSetAtEthereumHeightRequest)(nil), "gravity.v1.SignerSetAtEthereumHeightRequest")
+	proto.RegisterType((*SignerSetAtEthereumHeightResponse)(nil), "gravity.v1.SignerSetAtEthereumHeightResponse")
+	proto.RegisterType((*ObservedSignerSetHistoryRequest)(nil), "gravity.v1.ObservedSignerSetHistoryRequest")
+	proto.RegisterType((*ObservedSignerSetHistoryResponse)(nil), "gravity.v1.ObservedSignerSetHistoryResponse")
+	proto.RegisterType((*ExecutedBatchTxsRequest)(nil), "gravity.v1.ExecutedBatchTxsRequest")
+	proto.RegisterType((*ExecutedBatchTxsResponse)(nil), "gravity.v1.ExecutedBatchTxsResponse")
+	proto.RegisterType((*ExecutedContractCallTxsRequest)(nil), "gravity.v1.ExecutedContractCallTxsRequest")
+	proto.RegisterType((*ExecutedContractCallTxsResponse)(nil), "gravity.v1.ExecutedContractCallTxsResponse")
+	proto.RegisterType((*RelaySignatures)(nil), "gravity.v1.RelaySignatures")
+	proto.RegisterType((*RelayableSignerSetTx)(nil), "gravity.v1.RelayableSignerSetTx")
+	proto.RegisterType((*RelayableBatchTx)(nil), "gravity.v1.RelayableBatchTx")
+	proto.RegisterType((*RelayableContractCallTx)(nil), "gravity.v1.RelayableContractCallTx")
+	proto.RegisterType((*RelayableSignerSetTxsRequest)(nil), "gravity.v1.RelayableSignerSetTxsRequest")
+	proto.RegisterType((*RelayableSignerSetTxsResponse)(nil), "gravity.v1.RelayableSignerSetTxsResponse")
+	proto.RegisterType((*RelayableBatchTxsRequest)(nil), "gravity.v1.RelayableBatchTxsRequest")
+	proto.RegisterType((*RelayableBatchTxsResponse)(nil), "gravity.v1.RelayableBatchTxsResponse")
+	proto.RegisterType((*RelayableContractCallTxsRequest)(nil), "gravity.v1.RelayableContractCallTxsRequest")
+	proto.RegisterType((*RelayableContractCallTxsResponse)(nil), "gravity.v1.RelayableContractCallTxsResponse")
+	proto.RegisterType((*SignerSetTxRelayCalldataRequest)(nil), "gravity.v1.SignerSetTxRelayCalldataRequest")
+	proto.RegisterType((*SignerSetTxRelayCalldataResponse)(nil), "gravity.v1.SignerSetTxRelayCalldataResponse")
+	proto.RegisterType((*BatchTxRelayCalldataRequest)(nil), "gravity.v1.BatchTxRelayCalldataRequest")
+	proto.RegisterType((*BatchTxRelayCalldataResponse)(nil), "gravity.v1.BatchTxRelayCalldataResponse")
+	proto.RegisterType((*ContractCallTxRelayCalldataRequest)(nil), "gravity.v1.ContractCallTxRelayCalldataRequest")
+	proto.RegisterType((*ContractCallTxRelayCalldataResponse)(nil), "gravity.v1.ContractCallTxRelayCalldataResponse")
+	proto.RegisterType((*CheckpointRequest)(nil), "gravity.v1.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "gravity.v1.CheckpointResponse")
+	proto.RegisterType((*CheckpointField)(nil), "gravity.v1.CheckpointField")
+	proto.RegisterType((*ValidatorBridgeStatsRequest)(nil), "gravity.v1.ValidatorBridgeStatsRequest")
+	proto.RegisterType((*ValidatorBridgeStatsResponse)(nil), "gravity.v1.ValidatorBridgeStatsResponse")
+	proto.RegisterType((*RewardPoolRequest)(nil), "gravity.v1.RewardPoolRequest")
+	proto.RegisterType((*RewardPoolResponse)(nil), "gravity.v1.RewardPoolResponse")
+	proto.RegisterType((*ValidatorRewardsRequest)(nil), "gravity.v1.ValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewardsResponse)(nil), "gravity.v1.ValidatorRewardsResponse")
+	proto.RegisterType((*BridgeHealthRequest)(nil), "gravity.v1.BridgeHealthRequest")
+	proto.RegisterType((*BridgeHealthResponse)(nil), "gravity.v1.BridgeHealthResponse")
+	proto.RegisterType((*HeightHealth)(nil), "gravity.v1.HeightHealth")
+	proto.RegisterType((*OutgoingTxAgeHealth)(nil), "gravity.v1.OutgoingTxAgeHealth")
+	proto.RegisterType((*SignerSetSignedPowerHealth)(nil), "gravity.v1.SignerSetSignedPowerHealth")
+	proto.RegisterType((*PoolDepthHealth)(nil), "gravity.v1.PoolDepthHealth")
 }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_29a9d4192703013c = []byte{
+	// 4666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x6b, 0x6c, 0x24, 0xd9,
+	0x55, 0x9e, 0xeb, 0xd7, 0x8c, 0x8f, 0x1f, 0x63, 0x5f, 0xf7, 0xd8, 0xed, 0xb2, 0xc7, 0x8f, 0xf2,
+	0x3c, 0x3c, 0xe3, 0x71, 0xf7, 0xcc, 0x6c, 0x86, 0xdd, 0x65, 0x76, 0x32, 0xf8, 0x35, 0x3b, 0xd6,
+	0x3c, 0xb7, 0xed, 0xd9, 0xb0, 0x44, 0x50, 0x94, 0xbb, 0xae, 0xbb, 0x8b, 0x69, 0x77, 0x39, 0x55,
+	0xd5, 0xbd, 0x36, 0x96, 0x23, 0xb2, 0x91, 0x40, 0x20, 0x11, 0x20, 0x01, 0x22, 0x24, 0x60, 0x83,
+	0x02, 0x11, 0x44, 0x02, 0x05, 0x65, 0x81, 0xe5, 0x0f, 0x91, 0x16, 0x09, 0x45, 0xfb, 0x03, 0x25,
+	0xca, 0x8f, 0xf0, 0x90, 0x12, 0xb4, 0x83, 0x90, 0x90, 0xf8, 0xc7, 0x1f, 0x7e, 0xa2, 0xba, 0xf7,
+	0x56, 0x75, 0xdd, 0xaa, 0x5b, 0xd5, 0x6d, 0x6f, 0x8f, 0x76, 0x37, 0xbf, 0xba, 0xeb, 0xdc, 0x73,
+	0xef, 0xf9, 0xce, 0xb9, 0xe7, 0xbe, 0xce, 0x3d, 0x17, 0x46, 0x4b, 0xb6, 0x5e, 0x37, 0xdd, 0xfd,
+	0x7c, 0xfd, 0x5a, 0xfe, 0x73, 0x35, 0x62, 0xef, 0xe7, 0x76, 0x6d, 0xcb, 0xb5, 0x30, 0x70, 0x7a,
+	0xae, 0x7e, 0x4d, 0xb9, 0x5c, 0xb4, 0x9c, 0x1d, 0xcb, 0xc9, 0x6f, 0xe9, 0x0e, 0x61, 0x4c, 0xf9,
+	0xfa, 0xb5, 0x2d, 0xe2, 0xea, 0xd7, 0xf2, 0xbb, 0x7a, 0xc9, 0xac, 0xea, 0xae, 0x69, 0x55, 0x59,
+	0x3d, 0x65, 0x2a, 0xcc, 0xeb, 0x73, 0x15, 0x2d, 0xd3, 0x2f, 0x1f, 0x67, 0xe5, 0x1a, 0xfd, 0xca,
+	0xb3, 0x0f, 0x5e, 0x94, 0x29, 0x59, 0x25, 0x8b, 0xd1, 0xbd, 0x7f, 0x9c, 0x3a, 0x59, 0xb2, 0xac,
+	0x52, 0x85, 0xe4, 0xf5, 0x5d, 0x33, 0xaf, 0x57, 0xab, 0x96, 0x4b, 0xa5, 0xf9, 0x75, 0xc6, 0x79,
+	0x29, 0xfd, 0xda, 0xaa, 0x6d, 0xe7, 0xf5, 0x2a, 0xd7, 0x40, 0xc9, 0x86, 0x34, 0x2b, 0x91, 0x2a,
+	0x71, 0x4c, 0x47, 0x56, 0xc2, 0xd5, 0x64, 0x25, 0x67, 0x42, 0x25, 0x3b, 0x4e, 0x89, 0x57, 0x50,
+	0x4f, 0xc3, 0xc0, 0x63, 0xdd, 0xd6, 0x77, 0x9c, 0x02, 0xf9, 0x5c, 0x8d, 0x38, 0xae, 0xba, 0x0c,
+	0x83, 0x3e, 0xc1, 0xd9, 0xb5, 0xaa, 0x0e, 0xc1, 0x57, 0xa1, 0x67, 0x97, 0x52, 0xb2, 0x68, 0x06,
+	0xcd, 0xf7, 0x5d, 0xc7, 0xb9, 0x86, 0x01, 0x73, 0x8c, 0x77, 0xb9, 0xeb, 0xbb, 0x3f, 0x9a, 0x3e,
+	0x51, 0xe0, 0x7c, 0xea, 0xa7, 0x01, 0x6f, 0x98, 0xa5, 0x2a, 0xb1, 0x37, 0x88, 0xbb, 0xb9, 0xc7,
+	0x5b, 0xc6, 0xf3, 0x30, 0xe4, 0x50, 0xaa, 0xe6, 0x10, 0x57, 0xab, 0x5a, 0xd5, 0x22, 0xa1, 0x2d,
+	0x76, 0x15, 0x06, 0x1d, 0x9f, 0xfb, 0xa1, 0x47, 0x55, 0x15, 0xc8, 0xde, 0xd7, 0x5d, 0xe2, 0xb8,
+	0xf1, 0x56, 0xd4, 0x07, 0x30, 0x22, 0x50, 0x39, 0xc8, 0x9f, 0x02, 0x68, 0x34, 0xce, 0x81, 0x8e,
+	0x85, 0x81, 0x86, 0x2b, 0xf5, 0x06, 0xf2, 0xd4, 0x9f, 0x85, 0xc1, 0x65, 0xdd, 0x2d, 0x96, 0x1b,
+	0x30, 0xcf, 0xc3, 0xa0, 0x6b, 0x3d, 0x25, 0x55, 0xad, 0x68, 0x55, 0x5d, 0x5b, 0x2f, 0xb2, 0xd6,
+	0x7a, 0x0b, 0x03, 0x94, 0xba, 0xc2, 0x89, 0x78, 0x1a, 0xfa, 0xb6, 0xbc, 0x8a, 0x5c, 0x91, 0x0e,
+	0xaa, 0x08, 0x50, 0x12, 0x53, 0xe2, 0x15, 0x38, 0x1d, 0xb4, 0xcc, 0x41, 0x5e, 0x82, 0x6e, 0xca,
+	0xc0, 0xf1, 0x8d, 0x84, 0xf1, 0xf9, 0xbc, 0x8c, 0x43, 0xad, 0xc1, 0x19, 0x5f, 0xd4, 0x8a, 0x5e,
+	0xa9, 0x34, 0xe0, 0x2d, 0x02, 0x36, 0xab, 0x75, 0xbd, 0x62, 0x1a, 0xd4, 0x5b, 0x34, 0xa7, 0x68,
+	0xed, 0x32, 0x3b, 0xf6, 0x17, 0x86, 0xc3, 0x25, 0x1b, 0x5e, 0x41, 0x8c, 0x3d, 0x8c, 0x56, 0x60,
+	0x67, 0xa0, 0x37, 0x60, 0x34, 0x2a, 0x96, 0x63, 0x7f, 0x19, 0xa0, 0x62, 0x95, 0xcc, 0xa2, 0x56,
+	0xd4, 0x2b, 0x15, 0xae, 0x80, 0x12, 0x56, 0x20, 0x52, 0xaf, 0x97, 0x72, 0x7b, 0x1f, 0xea, 0x57,
+	0x10, 0x4c, 0x87, 0xcc, 0xbf, 0x62, 0x55, 0xb7, 0x4d, 0x7b, 0x87, 0x39, 0xfb, 0x91, 0x9d, 0x03,
+	0xdf, 0x01, 0x68, 0x0c, 0x4d, 0xaa, 0x49, 0xdf, 0xf5, 0x0b, 0x39, 0x3e, 0xdc, 0xbc, 0xb1, 0x99,
+	0x63, 0x83, 0x9d, 0x8f, 0xd0, 0xdc, 0x63, 0xbd, 0x44, 0xb8, 0x94, 0x42, 0xa8, 0xa6, 0xfa, 0x2d,
+	0x04, 0x33, 0xc9, 0xa8, 0xb8, 0xd6, 0x2b, 0xcc, 0xad, 0x74, 0xb7, 0x66, 0x13, 0xcf, 0xff, 0x3b,
+	0xe7, 0xfb, 0xae, 0xcf, 0x25, 0xb8, 0x55, 0xb8, 0x85, 0x42, 0xa8, 0x1a, 0x7e, 0x55, 0x82, 0xf8,
+	0x62, 0x53, 0xc4, 0x0c, 0x81, 0x00, 0xf9, 0x6d, 0x24, 0x38, 0x7f, 0x60, 0x3c, 0xd1, 0x24, 0xe8,
+	0xb8, 0x26, 0xf1, 0x7c, 0xda, 0x31, 0xab, 0x45, 0x22, 0xfa, 0x34, 0x25, 0x31, 0xdb, 0x4f, 0x43,
+	0x5f, 0xad, 0xea, 0x9a, 0x15, 0xce, 0xd0, 0xc9, 0x18, 0x28, 0x89, 0xf9, 0xcf, 0x1f, 0x20, 0xc8,
+	0x88, 0x08, 0xb9, 0x21, 0x5f, 0xf2, 0x9a, 0xf6, 0xfb, 0xd7, 0xb7, 0x64, 0xe2, 0x00, 0x85, 0xa0,
+	0xcf, 0xdb, 0x68, 0xbd, 0xd1, 0x10, 0xb4, 0x55, 0x73, 0x7b, 0xdb, 0x9f, 0x51, 0xfe, 0xa1, 0x03,
+	0xce, 0x44, 0x0a, 0x38, 0xe8, 0x1b, 0x30, 0x56, 0xa1, 0xf3, 0x90, 0x96, 0xe0, 0x9b, 0x99, 0x8a,
+	0x38, 0x4d, 0x31, 0x2b, 0x3d, 0x00, 0xd8, 0xb5, 0xde, 0x24, 0xb6, 0x66, 0x98, 0xdb, 0xdb, 0x14,
+	0x71, 0xef, 0x72, 0xce, 0x9b, 0x20, 0xff, 0xed, 0x47, 0xd3, 0x17, 0x4a, 0xa6, 0x5b, 0xae, 0x6d,
+	0xe5, 0x8a, 0xd6, 0x0e, 0x5f, 0x22, 0xf8, 0xcf, 0xa2, 0x63, 0x3c, 0xcd, 0xbb, 0xfb, 0xbb, 0xc4,
+	0xc9, 0xad, 0x92, 0x62, 0xa1, 0x97, 0xb6, 0xe0, 0xa1, 0xc1, 0xbf, 0x08, 0x99, 0x46, 0x73, 0x9a,
+	0x5b, 0xb6, 0x89, 0x53, 0xb6, 0x2a, 0x46, 0xb6, 0xf3, 0x58, 0x0d, 0xe3, 0xa0, 0xe1, 0x4d, 0xbf,
+	0x25, 0x7c, 0x0b, 0x4e, 0x16, 0xcb, 0x7a, 0xb5, 0x44, 0x9c, 0x6c, 0x17, 0xed, 0x98, 0xb3, 0xf1,
+	0x8e, 0x79, 0xec, 0x55, 0x5b, 0xa1, 0x5c, 0x7c, 0xb6, 0xf7, 0xeb, 0xa8, 0x3f, 0x44, 0x30, 0x1c,
+	0x63, 0xc2, 0x97, 0x60, 0x88, 0xb8, 0x65, 0x62, 0x93, 0xda, 0x8e, 0xa6, 0x1b, 0x86, 0x4d, 0x1c,
+	0x87, 0xcf, 0xa4, 0xa7, 0x7d, 0xfa, 0x12, 0x23, 0xe3, 0x59, 0xe8, 0xe7, 0x76, 0xa6, 0xe0, 0xb8,
+	0xe3, 0xf5, 0x31, 0x1a, 0x6d, 0x13, 0xcf, 0xc1, 0x40, 0xb1, 0x66, 0xdb, 0xa4, 0xea, 0xf3, 0x30,
+	0xdf, 0xeb, 0xe7, 0x44, 0xc6, 0x34, 0x0d, 0x7d, 0xdc, 0x52, 0xa4, 0xe2, 0xea, 0xd9, 0xae, 0x19,
+	0x34, 0xdf, 0x59, 0x60, 0x7d, 0xb1, 0xea, 0x51, 0x70, 0x06, 0xba, 0x75, 0xc3, 0x20, 0x46, 0xb6,
+	0x7b, 0x06, 0xcd, 0x9f, 0x2a, 0xb0, 0x0f, 0x9c, 0x85, 0x93, 0x36, 0xd9, 0xb1, 0xea, 0xc4, 0xc8,
+	0xf6, 0x50, 0xba, 0xff, 0xa9, 0xbe, 0x87, 0x82, 0x49, 0xbc, 0xed, 0x83, 0x2d, 0xbe, 0xce, 0x74,
+	0x24, 0xac, 0x33, 0xe1, 0x31, 0xd9, 0xd9, 0x6c, 0x4c, 0x76, 0xc5, 0xc6, 0xe4, 0x6f, 0x20, 0x18,
+	0x6a, 0x28, 0xc1, 0x5d, 0x7b, 0x11, 0x4e, 0xd2, 0x85, 0x26, 0x98, 0xd5, 0xa4, 0x8b, 0x91, 0xcf,
+	0xd3, 0xbe, 0x41, 0xf8, 0x7d, 0x14, 0x5d, 0x61, 0xda, 0x6e, 0x58, 0xf9, 0x0a, 0xd9, 0x91, 0xb4,
+	0x42, 0x7e, 0x78, 0x03, 0xff, 0x2e, 0x82, 0xb1, 0x98, 0x4e, 0xc1, 0xe6, 0xa9, 0xdb, 0x5b, 0x30,
+	0x7d, 0x2b, 0xa7, 0xad, 0x98, 0x8c, 0xb1, 0x7d, 0xa6, 0xfe, 0x1a, 0x82, 0x89, 0x27, 0x55, 0x3a,
+	0x73, 0x19, 0xb2, 0x55, 0x23, 0x0b, 0x27, 0xc5, 0x71, 0xe9, 0x7f, 0x36, 0x5f, 0x07, 0xc4, 0xae,
+	0xea, 0x3c, 0xf6, 0x1a, 0xfc, 0x27, 0x08, 0x26, 0xe5, 0x10, 0x3f, 0x3e, 0xcb, 0xc6, 0x3f, 0x22,
+	0x18, 0xf3, 0x31, 0x46, 0xe7, 0x82, 0x8f, 0xde, 0x84, 0x92, 0x69, 0xa4, 0x4b, 0x32, 0x8d, 0xa8,
+	0x5f, 0x46, 0x90, 0x8d, 0x6b, 0xf1, 0x11, 0x4f, 0x06, 0x5f, 0x47, 0x30, 0xe5, 0x83, 0x4a, 0x98,
+	0x14, 0x3e, 0x06, 0x4e, 0xfa, 0x87, 0x08, 0xa6, 0x13, 0x51, 0x7e, 0xf4, 0xc3, 0xfc, 0x8b, 0x08,
+	0x30, 0xef, 0xa2, 0x3b, 0x84, 0x38, 0x47, 0x3c, 0xc6, 0xb4, 0x6b, 0x37, 0xfd, 0x1d, 0x04, 0x23,
+	0x02, 0x0a, 0x6e, 0x18, 0x0d, 0xba, 0xb6, 0x49, 0xe0, 0x57, 0xe3, 0x42, 0xcb, 0x7e, 0x9b, 0x2b,
+	0x96, 0x59, 0x5d, 0xbe, 0xea, 0xed, 0x29, 0xbe, 0xf9, 0xe3, 0xe9, 0xf9, 0x16, 0xf6, 0x31, 0x5e,
+	0x05, 0xa7, 0x40, 0x1b, 0x6e, 0x9f, 0x1d, 0x8b, 0x30, 0xf6, 0x90, 0xec, 0xb9, 0x54, 0x89, 0xc7,
+	0x36, 0xa9, 0x9b, 0xe4, 0xcd, 0x23, 0xda, 0x72, 0x16, 0xfa, 0x77, 0xf4, 0x3d, 0x8d, 0x54, 0xc8,
+	0x0e, 0xa9, 0xba, 0x8e, 0xbf, 0x8d, 0xd9, 0xd1, 0xf7, 0xd6, 0x38, 0x49, 0xfd, 0xf5, 0x4e, 0xc8,
+	0xc6, 0xa5, 0x70, 0x5b, 0xe5, 0x9b, 0x1f, 0x0f, 0xf9, 0xd6, 0x8b, 0xf1, 0xe1, 0x29, 0x80, 0x62,
+	0x99, 0x14, 0x9f, 0xee, 0x5a, 0x66, 0xd5, 0xe5, 0x2b, 0x5c, 0x88, 0x82, 0xf3, 0x90, 0x71, 0x48,
+	0xd5, 0xd0, 0x5c, 0x4b, 0x0b, 0xb6, 0x62, 0xa6, 0xe1, 0x64, 0x3b, 0x67, 0x3a, 0xbd, 0xe3, 0x9f,
+	0x57, 0xb6, 0x69, 0xad, 0xf1, 0x92, 0x75, 0xc3, 0xc1, 0xf7, 0xa0, 0xd7, 0xb5, 0x5c, 0xbd, 0xa2,
+	0x6d, 0x13, 0xb6, 0xd0, 0x1d, 0x6d, 0x7f, 0xb9, 0x5e, 0x75, 0x0b, 0xa7, 0x68, 0x03, 0x77, 0x08,
+	0xc1, 0xaf, 0x41, 0x3f, 0x6b, 0x4c, 0xdf, 0xb1, 0x6a, 0x55, 0x37, 0xdb, 0x7d, 0xac, 0xf6, 0xfa,
+	0x68, 0x1b, 0x4b, 0xb4, 0x09, 0xef, 0x94, 0x58, 0xd1, 0x1d, 0x57, 0x0b, 0x9f, 0xbc, 0x7b, 0xd8,
+	0x29, 0xd1, 0xa3, 0x2f, 0x07, 0xa7, 0x6f, 0xaf, 0x2f, 0xde, 0xb4, 0x6a, 0x15, 0x43, 0x2b, 0xda,
+	0x44, 0x77, 0x49, 0xf6, 0x24, 0xdd, 0xd8, 0xf5, 0x51, 0xda, 0x0a, 0x25, 0xa9, 0xef, 0x23, 0x50,
+	0xc5, 0xb1, 0x29, 0x3d, 0x99, 0x3e, 0xd7, 0x03, 0x77, 0xdb, 0x26, 0xa9, 0xbf, 0x45, 0x30, 0x97,
+	0xaa, 0x0c, 0xf7, 0xb1, 0x3b, 0x92, 0x03, 0xed, 0x85, 0xe4, 0xd9, 0xea, 0xf9, 0x9f, 0x69, 0xff,
+	0x12, 0xc1, 0x04, 0x77, 0x6e, 0xa9, 0xf9, 0x23, 0x71, 0x16, 0x14, 0x8d, 0xb3, 0xb4, 0xba, 0x8f,
+	0x6e, 0x97, 0xa1, 0xff, 0x1c, 0xc1, 0xa4, 0x1c, 0x2f, 0xb7, 0xf0, 0x6d, 0x89, 0x85, 0xa7, 0x25,
+	0x43, 0xf9, 0xf9, 0x9b, 0xf6, 0x16, 0xcc, 0xde, 0xd7, 0x1d, 0x77, 0xa3, 0xb6, 0xb5, 0x63, 0xba,
+	0x2e, 0x31, 0xfc, 0x91, 0xbe, 0x56, 0x27, 0x55, 0xb7, 0xe9, 0x02, 0xab, 0xae, 0x81, 0x9a, 0x56,
+	0x9d, 0xab, 0x3b, 0x0d, 0x7d, 0xc4, 0x23, 0x88, 0xfd, 0x43, 0x49, 0x6c, 0x77, 0xbc, 0x00, 0x23,
+	0x6b, 0x85, 0x95, 0xeb, 0x57, 0x37, 0xad, 0x55, 0x52, 0xb5, 0x76, 0x7c, 0xb9, 0x19, 0xe8, 0x26,
+	0x76, 0xf1, 0xfa, 0x55, 0x2e, 0x95, 0x7d, 0xa8, 0x6f, 0x40, 0x46, 0x64, 0xe6, 0x52, 0x32, 0xd0,
+	0x6d, 0x78, 0x04, 0x9f, 0x9b, 0x7e, 0xe0, 0x05, 0x18, 0xe6, 0x31, 0x57, 0xcb, 0x36, 0xa9, 0xda,
+	0xc4, 0xa0, 0x06, 0x3b, 0x55, 0x18, 0x62, 0x05, 0x8f, 0x02, 0xba, 0x7a, 0x0d, 0xc6, 0x69, 0x9b,
+	0x9b, 0x16, 0x95, 0x20, 0x44, 0x3d, 0xe5, 0xed, 0xab, 0x7f, 0x8a, 0x40, 0x91, 0xd5, 0xe1, 0xa0,
+	0xce, 0x02, 0x78, 0xdd, 0xa1, 0x85, 0x6b, 0xf6, 0x7a, 0x14, 0x5a, 0xc7, 0x2b, 0xa6, 0x4a, 0x69,
+	0x55, 0x7d, 0x87, 0x70, 0xa7, 0xec, 0xa5, 0x94, 0x87, 0xfa, 0x0e, 0x9d, 0xa1, 0x58, 0xb1, 0xb3,
+	0xbf, 0xb3, 0x65, 0x55, 0xd8, 0x71, 0xbe, 0xd0, 0x47, 0x69, 0x1b, 0x94, 0xe4, 0xb9, 0x36, 0x63,
+	0x31, 0x48, 0xd1, 0xdc, 0xd1, 0x2b, 0x0e, 0x3f, 0x7c, 0x0c, 0x50, 0xea, 0x2a, 0x27, 0x7a, 0x16,
+	0x0e, 0xa3, 0x4c, 0xd7, 0xe9, 0x0d, 0xc8, 0x88, 0xcc, 0x0d, 0x0b, 0xc7, 0xfb, 0xe3, 0x68, 0x16,
+	0x7e, 0x00, 0x53, 0xab, 0xa4, 0x42, 0x4a, 0xba, 0x4b, 0xee, 0x91, 0x7d, 0x67, 0x79, 0xff, 0x75,
+	0x36, 0xd9, 0x59, 0xb6, 0x0f, 0x69, 0x01, 0x86, 0xeb, 0x3e, 0x2d, 0x12, 0x14, 0x18, 0x0a, 0x0a,
+	0x78, 0x54, 0x40, 0xad, 0xc1, 0x74, 0x62, 0x73, 0x21, 0xe7, 0x73, 0xcb, 0x91, 0x96, 0x80, 0xb8,
+	0x65, 0xde, 0x06, 0xbe, 0x06, 0x19, 0xcb, 0xf6, 0x36, 0xad, 0xae, 0x2d, 0xc8, 0x64, 0xbd, 0x31,
+	0x12, 0x2e, 0xf3, 0xc5, 0x3e, 0x84, 0x39, 0x51, 0xac, 0xef, 0xf7, 0xec, 0xa4, 0xe1, 0xab, 0x72,
+	0x11, 0x82, 0x30, 0x06, 0x8f, 0x0e, 0x71, 0xf1, 0x83, 0x44, 0xe0, 0x57, 0x7f, 0x15, 0xc1, 0xb9,
+	0xf4, 0x06, 0xb9, 0x32, 0x47, 0x31, 0xce, 0x71, 0x14, 0x7b, 0x1d, 0x66, 0x45, 0x1c, 0x8f, 0x42,
+	0x4c, 0xbe, 0x5a, 0x49, 0xed, 0xa2, 0xe4, 0x76, 0x7f, 0x19, 0xd4, 0xb4, 0x76, 0x8f, 0xa3, 0x9d,
+	0xc4, 0xb8, 0x1d, 0x52, 0xe3, 0xfe, 0x3c, 0x8c, 0x84, 0x65, 0xb7, 0x39, 0x94, 0xe0, 0x9d, 0x4f,
+	0x33, 0x62, 0xfb, 0x5c, 0x9b, 0x9f, 0x81, 0x01, 0x83, 0xd3, 0xb5, 0xa7, 0x64, 0xdf, 0x9f, 0xe7,
+	0x27, 0xc2, 0xf3, 0xfc, 0x03, 0xa7, 0x24, 0xd4, 0xed, 0x37, 0x42, 0x5f, 0xed, 0x9b, 0xe5, 0xff,
+	0x06, 0xc1, 0x59, 0xba, 0xa4, 0x10, 0x63, 0x43, 0xd8, 0xd0, 0x85, 0x8f, 0x02, 0xde, 0x56, 0x8f,
+	0x44, 0xed, 0x3e, 0xc0, 0xa8, 0xbe, 0xd1, 0xdb, 0x74, 0x14, 0x90, 0x2c, 0xc8, 0x9d, 0xb2, 0x13,
+	0xe9, 0x5f, 0x23, 0x98, 0x4a, 0xc2, 0x1d, 0x6c, 0x56, 0x86, 0xa3, 0xfb, 0x57, 0xe9, 0x09, 0x4b,
+	0xac, 0x5f, 0x38, 0x2d, 0x6e, 0x6c, 0xdb, 0x68, 0xeb, 0xbf, 0xa3, 0x47, 0xc1, 0xad, 0x4f, 0xa0,
+	0xb5, 0xbf, 0x8d, 0x60, 0x26, 0x19, 0xf9, 0xc7, 0xd5, 0xde, 0x0b, 0x30, 0x2e, 0xca, 0x5a, 0xde,
+	0x5f, 0x5f, 0xf5, 0x0d, 0x3d, 0x08, 0x1d, 0xa6, 0xc1, 0x37, 0x1c, 0x1d, 0xa6, 0xe1, 0x1d, 0x84,
+	0x15, 0x19, 0x37, 0x57, 0x6e, 0x15, 0x86, 0xa2, 0xca, 0xc9, 0xae, 0xb1, 0x22, 0xba, 0x0d, 0x8a,
+	0xba, 0x35, 0xbf, 0xf6, 0x9b, 0x63, 0x9b, 0xae, 0x47, 0x5b, 0x0e, 0xb1, 0xeb, 0x8d, 0x4d, 0xd3,
+	0x5d, 0x62, 0x96, 0xca, 0xfe, 0xa6, 0x4b, 0xfd, 0x12, 0x02, 0x35, 0x8d, 0x8b, 0x43, 0x2e, 0xc3,
+	0x59, 0x7a, 0xdc, 0xb1, 0x38, 0x5b, 0xe3, 0x14, 0x57, 0xa6, 0x8c, 0x1c, 0xff, 0xf9, 0x30, 0x7e,
+	0x76, 0x71, 0x1a, 0x58, 0xa0, 0x62, 0x15, 0x9f, 0xf2, 0x56, 0x95, 0x4a, 0xa2, 0x44, 0xb5, 0x04,
+	0x67, 0xe9, 0xb6, 0xee, 0x75, 0xcb, 0x25, 0xab, 0xa6, 0xa3, 0x97, 0x6c, 0xc2, 0x4e, 0xac, 0xed,
+	0x9e, 0x51, 0xdf, 0x45, 0x30, 0x95, 0x24, 0x89, 0x6b, 0xfd, 0x00, 0x06, 0x8c, 0x70, 0x01, 0xf7,
+	0xc0, 0xd9, 0xb0, 0x96, 0xd2, 0x26, 0xf8, 0xe1, 0x58, 0xac, 0xdd, 0x3e, 0x67, 0xfc, 0x02, 0x82,
+	0x33, 0x52, 0xb9, 0x4d, 0xf7, 0xc0, 0xf8, 0x55, 0xef, 0x86, 0xa1, 0x68, 0xd9, 0x86, 0xb7, 0x40,
+	0x77, 0x52, 0x00, 0xcd, 0x94, 0x29, 0x50, 0x7e, 0xff, 0xaa, 0x85, 0xd7, 0x56, 0x9f, 0x21, 0x98,
+	0x48, 0x61, 0xa7, 0x7b, 0x4e, 0x8a, 0xa4, 0xac, 0x3b, 0x65, 0x7e, 0x48, 0xed, 0xa5, 0x94, 0xbb,
+	0xba, 0x53, 0xc6, 0xb7, 0xa0, 0x9b, 0x7e, 0x70, 0x33, 0x64, 0x72, 0x2c, 0xc7, 0x20, 0xe7, 0xe7,
+	0x18, 0xe4, 0x96, 0xaa, 0xfb, 0xcb, 0xc3, 0xef, 0xbf, 0xb3, 0x38, 0x20, 0x6e, 0xf6, 0x59, 0x2d,
+	0xac, 0xc0, 0x29, 0xbd, 0x58, 0x24, 0xbb, 0xde, 0x26, 0xb0, 0x93, 0x6e, 0x02, 0x83, 0x6f, 0xfc,
+	0x29, 0xe8, 0xa9, 0x5b, 0x2e, 0xb1, 0xfd, 0x2b, 0xa4, 0x51, 0xa9, 0x86, 0xb6, 0x9f, 0x29, 0xc0,
+	0x78, 0xbd, 0x5d, 0x27, 0xbb, 0xce, 0xe9, 0xa6, 0x77, 0x35, 0xec, 0x43, 0x7d, 0x04, 0xd0, 0xa8,
+	0x71, 0xb4, 0x9d, 0x43, 0xd0, 0x60, 0x47, 0xb8, 0xc1, 0x7b, 0xa1, 0xab, 0xde, 0x25, 0x57, 0x3a,
+	0x26, 0x85, 0x3d, 0x47, 0x68, 0x78, 0x75, 0x35, 0xf6, 0x1c, 0x7c, 0xac, 0xd8, 0x30, 0x9b, 0xd2,
+	0x58, 0xe0, 0xc4, 0x23, 0xc1, 0xa8, 0x8d, 0x25, 0x26, 0x08, 0xd7, 0x6b, 0xfe, 0x88, 0x0c, 0xda,
+	0x2c, 0x0c, 0x5b, 0x51, 0x92, 0x6a, 0xc2, 0x74, 0x8c, 0xef, 0xae, 0xe9, 0xb8, 0x96, 0xbd, 0xdf,
+	0xee, 0x11, 0xfa, 0x1e, 0x82, 0x99, 0x64, 0x59, 0x5c, 0xbd, 0x27, 0x90, 0x91, 0xa8, 0xe7, 0x0f,
+	0xd5, 0x74, 0xfd, 0xb8, 0x0b, 0xe0, 0x98, 0x96, 0x6d, 0x1c, 0xab, 0xef, 0x23, 0x18, 0x5b, 0xdb,
+	0x23, 0xc5, 0x9a, 0x1b, 0x0f, 0xda, 0x7f, 0xe2, 0x2e, 0xf0, 0xbe, 0x86, 0x20, 0x1b, 0x57, 0x86,
+	0xf7, 0xc4, 0xcd, 0x68, 0xec, 0x5e, 0xd8, 0x83, 0x46, 0xaa, 0xf9, 0xd3, 0x49, 0xdb, 0x23, 0xf9,
+	0xff, 0xea, 0x4d, 0xeb, 0x5c, 0xd6, 0x4f, 0xda, 0xf5, 0xde, 0x37, 0x11, 0x4c, 0x27, 0xea, 0xc6,
+	0x7b, 0xe1, 0xd3, 0x62, 0xfc, 0x5f, 0x95, 0xf5, 0x81, 0x58, 0xd7, 0x8f, 0xe4, 0xb6, 0xf9, 0x36,
+	0xe0, 0x26, 0x9c, 0x2e, 0x90, 0x8a, 0xbe, 0xbf, 0xd1, 0x88, 0x27, 0xf5, 0x03, 0xaa, 0x53, 0x5c,
+	0x03, 0x05, 0x54, 0xf7, 0xbe, 0x6c, 0xba, 0x08, 0xf5, 0x17, 0x90, 0xed, 0x7d, 0xb1, 0x70, 0x70,
+	0x7f, 0x01, 0x39, 0xea, 0xef, 0x20, 0xc8, 0xd0, 0xda, 0xfa, 0x56, 0x85, 0x84, 0x2e, 0xd6, 0x8e,
+	0x9b, 0x5d, 0x85, 0x97, 0x84, 0x58, 0x18, 0x53, 0x4b, 0xf0, 0xcf, 0x08, 0x56, 0x6e, 0x94, 0x50,
+	0x25, 0xf5, 0x57, 0x10, 0x0c, 0x05, 0x98, 0xb8, 0x1b, 0x1f, 0x21, 0x91, 0xaa, 0x1d, 0x10, 0xbe,
+	0x8a, 0x60, 0x2c, 0x80, 0x20, 0xf6, 0xe2, 0x87, 0x48, 0x8b, 0x6a, 0x07, 0xb2, 0x6d, 0x98, 0x94,
+	0xf5, 0x57, 0xdb, 0x77, 0x6d, 0xff, 0x87, 0xe0, 0x6c, 0x82, 0x20, 0x3e, 0x00, 0xd6, 0x00, 0xfb,
+	0xf9, 0x19, 0xad, 0x7b, 0xca, 0x10, 0xaf, 0x12, 0xd0, 0xf0, 0xab, 0xe2, 0x7d, 0x2f, 0xdb, 0x2c,
+	0xcd, 0xc4, 0x8c, 0x12, 0x81, 0x11, 0xb6, 0x8c, 0x74, 0x25, 0xe9, 0x3c, 0xfe, 0x80, 0xda, 0x82,
+	0x6c, 0xd4, 0xfd, 0xda, 0x6e, 0xde, 0xff, 0x46, 0x30, 0x2e, 0x11, 0xd2, 0x5e, 0xd3, 0xbe, 0xd2,
+	0x58, 0x28, 0x98, 0x59, 0x27, 0xa5, 0x66, 0x6d, 0x69, 0xa5, 0xf8, 0x10, 0xf6, 0x34, 0x61, 0x3a,
+	0x61, 0x2c, 0xb5, 0xdd, 0xac, 0xff, 0x8b, 0x60, 0x26, 0x59, 0x56, 0x7b, 0xad, 0x7b, 0xdb, 0x5f,
+	0x00, 0x3a, 0xe2, 0x39, 0x82, 0x09, 0x18, 0xd2, 0x56, 0x80, 0x0f, 0x61, 0xe0, 0x7b, 0x42, 0xb2,
+	0x25, 0x95, 0xed, 0xc9, 0x33, 0x74, 0x57, 0x3f, 0x7a, 0x26, 0x6e, 0x1d, 0x66, 0x92, 0x1b, 0x0b,
+	0x52, 0x6f, 0xc7, 0xb6, 0x6c, 0xd3, 0x28, 0x11, 0x2d, 0x21, 0xdf, 0xeb, 0x0c, 0x2b, 0x5e, 0x8b,
+	0x64, 0x7d, 0x29, 0x70, 0xaa, 0xc8, 0xdb, 0xe2, 0xcb, 0x77, 0xf0, 0xad, 0x92, 0xe0, 0x52, 0x48,
+	0xaa, 0x40, 0xbb, 0x72, 0x74, 0x6d, 0x98, 0x94, 0x8b, 0x79, 0x8e, 0xaa, 0xbd, 0x15, 0xbb, 0x76,
+	0x94, 0xaa, 0xf8, 0x7c, 0xf3, 0x7c, 0xf7, 0x61, 0x2e, 0x15, 0xc3, 0x73, 0xd4, 0xff, 0x19, 0x82,
+	0xe1, 0x95, 0xe0, 0x8e, 0xfa, 0xe8, 0xf9, 0xbf, 0xad, 0x6f, 0xbb, 0xc3, 0x7d, 0xdf, 0x19, 0xbb,
+	0x37, 0x94, 0x1b, 0xb8, 0xeb, 0x68, 0x06, 0xee, 0x4e, 0x32, 0xf0, 0xdf, 0x23, 0xc0, 0x61, 0x2d,
+	0x1b, 0x57, 0x46, 0x7c, 0x62, 0xd0, 0x78, 0xec, 0xaa, 0xb7, 0xd0, 0xcb, 0x29, 0xeb, 0x46, 0xd3,
+	0x0b, 0xfd, 0x4b, 0x30, 0x14, 0xac, 0xfe, 0x9a, 0x61, 0x96, 0x88, 0xc3, 0xc2, 0x7d, 0xfd, 0x85,
+	0xd3, 0x01, 0x7d, 0x95, 0x92, 0xf1, 0xcb, 0xd0, 0xb3, 0x6d, 0x92, 0x8a, 0xe1, 0x9f, 0xc7, 0x85,
+	0x9d, 0x45, 0x03, 0xd9, 0x1d, 0x8f, 0xc7, 0x3f, 0x94, 0xb3, 0x0a, 0xea, 0x23, 0x38, 0x1d, 0x61,
+	0xc0, 0x18, 0xba, 0xe8, 0x2d, 0x16, 0x43, 0x4c, 0xff, 0x7b, 0x34, 0xef, 0x9a, 0x9e, 0x9b, 0x9f,
+	0xfe, 0xf7, 0x8e, 0xdf, 0x75, 0xbd, 0x52, 0x23, 0x3c, 0x08, 0xc9, 0x3e, 0xbc, 0xd1, 0x1c, 0xdc,
+	0xdd, 0x2c, 0x53, 0x87, 0xd9, 0x70, 0xf5, 0xf6, 0xc7, 0x96, 0x7e, 0x8c, 0x60, 0x52, 0x2e, 0x87,
+	0x5b, 0xff, 0x15, 0xe8, 0x76, 0x3c, 0x42, 0x16, 0xc5, 0xf7, 0x15, 0xb2, 0x8a, 0xfe, 0x0c, 0x4d,
+	0x2b, 0xb5, 0x6d, 0x8f, 0x8e, 0x5f, 0x82, 0x6e, 0xb2, 0x6b, 0x15, 0xcb, 0x7c, 0x96, 0x17, 0xd6,
+	0xe1, 0x90, 0xf4, 0x35, 0x8f, 0xc7, 0x87, 0x40, 0x2b, 0xa8, 0x23, 0x30, 0x5c, 0x20, 0x6f, 0xea,
+	0xb6, 0xf1, 0xd8, 0xb2, 0x2a, 0x7e, 0x30, 0xf1, 0xbf, 0x10, 0xe0, 0x30, 0x95, 0x2b, 0x4b, 0xbc,
+	0xf5, 0xbe, 0xa2, 0xb3, 0x81, 0xd4, 0xf6, 0xe4, 0x1b, 0xbf, 0x6d, 0x9c, 0x87, 0x11, 0x96, 0xe5,
+	0xb1, 0xab, 0xdb, 0xae, 0x59, 0x34, 0x77, 0x1b, 0xe6, 0xe9, 0x2a, 0x60, 0x5a, 0xf4, 0x38, 0x5c,
+	0x82, 0x5f, 0x82, 0x6c, 0x95, 0xec, 0xb9, 0x9a, 0x61, 0x3a, 0xae, 0x6d, 0x6e, 0xd5, 0xe8, 0x68,
+	0xe2, 0x01, 0x17, 0x36, 0x4a, 0x47, 0xbd, 0xf2, 0xd5, 0x50, 0x31, 0x0f, 0xbc, 0xdc, 0x81, 0xb1,
+	0xd0, 0x15, 0xa0, 0xa7, 0xb0, 0x73, 0xac, 0x8b, 0xc5, 0xef, 0x20, 0xc8, 0xc6, 0x1b, 0x0a, 0x7c,
+	0xe4, 0xa4, 0xcd, 0x48, 0x59, 0x14, 0xef, 0x9e, 0x68, 0xb5, 0x46, 0x7c, 0x8e, 0x7e, 0x7a, 0x46,
+	0xd7, 0x8b, 0x45, 0xbb, 0x46, 0x6f, 0x49, 0xdb, 0x6f, 0x74, 0xde, 0xb6, 0x7a, 0x06, 0x46, 0x98,
+	0xa3, 0xdc, 0x25, 0x7a, 0xc5, 0x2d, 0xfb, 0x9e, 0xf0, 0x3f, 0x9d, 0x90, 0x11, 0xe9, 0xc1, 0x3c,
+	0x7e, 0xca, 0x21, 0x75, 0x62, 0x9b, 0xee, 0x3e, 0xd5, 0x6a, 0x50, 0x3c, 0xa3, 0x30, 0xee, 0x0d,
+	0xce, 0x51, 0x08, 0x78, 0xf1, 0x6d, 0x3f, 0xb0, 0xe9, 0xb8, 0xde, 0xf1, 0x86, 0xf9, 0x7c, 0x56,
+	0xac, 0xea, 0x75, 0x0d, 0x6b, 0xc0, 0xdf, 0x86, 0xd3, 0x2a, 0x1b, 0x5e, 0x0d, 0xfc, 0x10, 0x46,
+	0x22, 0x41, 0x35, 0xad, 0xa2, 0x97, 0xb2, 0x9d, 0x2d, 0x35, 0x34, 0x2c, 0x06, 0xde, 0xee, 0xeb,
+	0x25, 0xfc, 0x04, 0x46, 0xac, 0x8a, 0x41, 0xbc, 0x98, 0x78, 0xcd, 0x2d, 0x59, 0x66, 0xb5, 0xa4,
+	0xb9, 0x7b, 0xfe, 0x14, 0x27, 0x64, 0x59, 0x3c, 0xe2, 0xe5, 0x9b, 0x7b, 0x4b, 0x25, 0x22, 0x36,
+	0xcb, 0x5a, 0x68, 0x30, 0x38, 0x78, 0x17, 0xa6, 0xe2, 0x89, 0xfe, 0xf4, 0xaf, 0xa1, 0x35, 0xe2,
+	0x93, 0x91, 0x4c, 0x99, 0x60, 0x63, 0x44, 0xff, 0x18, 0x34, 0x05, 0x5d, 0x10, 0xa4, 0x44, 0x5e,
+	0x07, 0x84, 0xf8, 0xf0, 0xb2, 0x97, 0xaa, 0x6e, 0x55, 0x34, 0x83, 0xec, 0xba, 0x65, 0x27, 0xdb,
+	0x13, 0x9f, 0xa3, 0xbd, 0xc1, 0xbc, 0xea, 0x95, 0x8a, 0xc6, 0xdd, 0xf5, 0xc9, 0x8e, 0xfa, 0x0b,
+	0xd0, 0x1f, 0xb6, 0x1a, 0x1e, 0x85, 0x9e, 0x2d, 0x2f, 0xde, 0xef, 0xf0, 0x95, 0x93, 0x7f, 0x09,
+	0xbd, 0xdf, 0xd1, 0x7a, 0xef, 0xab, 0xdf, 0x40, 0x30, 0x22, 0x31, 0x23, 0x1e, 0x83, 0x93, 0xee,
+	0x9e, 0x46, 0xe7, 0x7e, 0x36, 0xc4, 0x7a, 0xdc, 0xbd, 0xcd, 0x7d, 0x1e, 0x6b, 0x71, 0x2d, 0x9b,
+	0x68, 0x66, 0xd5, 0x20, 0x7b, 0xfe, 0xfa, 0x45, 0x49, 0xeb, 0x1e, 0xc5, 0x5b, 0xfe, 0xf4, 0x12,
+	0xd1, 0x38, 0x4a, 0x36, 0xda, 0x7b, 0xf5, 0x12, 0x59, 0x8e, 0x03, 0xed, 0x3a, 0x02, 0xd0, 0xef,
+	0x7b, 0x37, 0x3f, 0x89, 0xbd, 0x71, 0x84, 0xbd, 0xc5, 0x6b, 0xd0, 0x2f, 0xf4, 0x3a, 0xd5, 0xe0,
+	0xc8, 0x4f, 0x2c, 0xfa, 0x9c, 0x06, 0x04, 0x41, 0xa7, 0xce, 0x23, 0xe8, 0xf4, 0xcf, 0x08, 0x4e,
+	0x47, 0x5c, 0xa0, 0xd5, 0x6d, 0x6f, 0x06, 0xba, 0x8b, 0x34, 0xe3, 0x8e, 0x4d, 0xc2, 0xec, 0x03,
+	0xdf, 0x81, 0x1e, 0x9e, 0x88, 0xd7, 0x79, 0xac, 0x44, 0x3c, 0x5e, 0xfb, 0xb8, 0x9d, 0x74, 0xb9,
+	0x08, 0x83, 0x62, 0x19, 0x1e, 0x05, 0x7c, 0x77, 0x6d, 0xe9, 0xfe, 0xe6, 0x5d, 0x6d, 0x63, 0xed,
+	0xf5, 0xb5, 0xc2, 0xfa, 0xe6, 0x1b, 0xda, 0xa3, 0x7b, 0x43, 0x27, 0xf0, 0x04, 0x8c, 0x45, 0xe9,
+	0x9f, 0x59, 0x2a, 0x3c, 0x5c, 0x7f, 0xf8, 0xea, 0x10, 0xc2, 0x93, 0x90, 0x8d, 0x16, 0xae, 0x14,
+	0xd6, 0x37, 0xd7, 0x57, 0x96, 0xee, 0x0f, 0x75, 0x5c, 0xff, 0xc1, 0x0d, 0xe8, 0x7e, 0xcd, 0x5b,
+	0x85, 0xf1, 0x67, 0xa1, 0x87, 0xa5, 0xeb, 0xe0, 0xf1, 0xf8, 0x7b, 0x45, 0x3e, 0x61, 0x2a, 0x8a,
+	0xac, 0x88, 0xcd, 0x99, 0xaa, 0xf2, 0xd6, 0x0f, 0xfe, 0xf3, 0x2b, 0x1d, 0x19, 0x8c, 0xf3, 0xa1,
+	0x97, 0x93, 0xec, 0x81, 0x23, 0x7e, 0x0b, 0x41, 0x5f, 0x38, 0x3e, 0x36, 0x95, 0x74, 0x50, 0xe4,
+	0x72, 0xa6, 0x13, 0xcb, 0xb9, 0xb0, 0xeb, 0x54, 0xd8, 0x15, 0x7c, 0x39, 0x2c, 0xac, 0xe1, 0xb4,
+	0x4e, 0xfe, 0x20, 0xea, 0xc1, 0x87, 0xf8, 0x0b, 0x08, 0x86, 0x63, 0xcf, 0x24, 0xf1, 0xb9, 0xf8,
+	0x65, 0xe0, 0x71, 0x00, 0x9d, 0xa7, 0x80, 0xa6, 0xf1, 0xd9, 0x30, 0xa0, 0xd8, 0x1c, 0x89, 0x3f,
+	0x0f, 0x27, 0xfd, 0x98, 0x9c, 0x22, 0x0b, 0xc2, 0x71, 0x71, 0x13, 0xd2, 0x32, 0x2e, 0xea, 0xa7,
+	0xa9, 0xa8, 0x4f, 0xe1, 0xeb, 0x61, 0x51, 0x3c, 0xee, 0x90, 0x3f, 0x10, 0x1d, 0xfe, 0x30, 0x7f,
+	0x10, 0xda, 0xd5, 0x1f, 0xe2, 0x3f, 0x43, 0x30, 0x18, 0x89, 0xc8, 0xcd, 0xa6, 0x44, 0xdf, 0x38,
+	0x1c, 0x35, 0x8d, 0x85, 0xa3, 0xba, 0x4f, 0x51, 0xdd, 0xc1, 0xab, 0x61, 0x54, 0x3e, 0x0c, 0x1a,
+	0xed, 0x73, 0xf2, 0x07, 0xf1, 0x03, 0xc4, 0x61, 0x84, 0xc8, 0x71, 0xda, 0xd0, 0x1f, 0xb2, 0xb2,
+	0x83, 0x93, 0xec, 0x1f, 0x78, 0xe6, 0x4c, 0x32, 0x03, 0x07, 0x38, 0x4d, 0x01, 0x8e, 0xe3, 0xb1,
+	0x04, 0x97, 0xc1, 0xfb, 0x30, 0x20, 0x3c, 0x6b, 0xc3, 0xf2, 0x36, 0x43, 0x4f, 0xe1, 0x94, 0xd9,
+	0x14, 0x0e, 0x2e, 0x76, 0x8e, 0x8a, 0x3d, 0x8b, 0x27, 0xe4, 0x62, 0xe9, 0x23, 0x35, 0xbc, 0x05,
+	0xa7, 0x78, 0x2f, 0x3b, 0x58, 0xd6, 0xf7, 0x81, 0x9a, 0x93, 0xf2, 0x42, 0x2e, 0x6b, 0x82, 0xca,
+	0x3a, 0x83, 0x47, 0x24, 0x9e, 0x81, 0x3f, 0x0f, 0xa7, 0xc5, 0xae, 0x73, 0x70, 0x4a, 0xbf, 0x06,
+	0x12, 0xe7, 0x52, 0x79, 0xb8, 0x60, 0x95, 0x0a, 0x9e, 0xc4, 0x4a, 0x72, 0xe7, 0xe3, 0x77, 0x10,
+	0x64, 0x93, 0xde, 0x8f, 0xe2, 0x85, 0x16, 0xde, 0x88, 0x06, 0x90, 0xae, 0xb4, 0xc6, 0xcc, 0xb1,
+	0xdd, 0xa2, 0xd8, 0x5e, 0xc4, 0x37, 0x5a, 0x9f, 0x2a, 0xf2, 0xa1, 0xec, 0xd2, 0x6f, 0x21, 0xc8,
+	0xc8, 0xf2, 0x57, 0xf1, 0xc5, 0x26, 0x39, 0xaa, 0x01, 0xdc, 0xf9, 0xe6, 0x8c, 0x1c, 0xea, 0x1a,
+	0x85, 0x7a, 0x1b, 0xdf, 0x3a, 0xfa, 0xc8, 0x0e, 0x43, 0xfe, 0x21, 0x82, 0x89, 0x94, 0xdc, 0x66,
+	0x9c, 0x6b, 0x2d, 0x7f, 0x39, 0x50, 0x20, 0xdf, 0x32, 0x3f, 0xd7, 0xe3, 0x33, 0x54, 0x8f, 0xd7,
+	0xf0, 0xa3, 0x76, 0xcc, 0x05, 0x61, 0xcd, 0xfe, 0x08, 0x41, 0x46, 0xf6, 0xfe, 0x49, 0xec, 0x8c,
+	0x94, 0x47, 0x5c, 0xca, 0x7c, 0x73, 0xc6, 0xb4, 0x25, 0xa6, 0xc6, 0x6b, 0x88, 0x0e, 0xc4, 0x0f,
+	0x50, 0x87, 0xf8, 0x37, 0x11, 0x0c, 0x45, 0x5f, 0x0d, 0xe1, 0x39, 0x99, 0xc8, 0xe8, 0xc0, 0x3e,
+	0x97, 0xce, 0xc4, 0x31, 0xe5, 0x28, 0xa6, 0x79, 0x7c, 0x41, 0x8a, 0x29, 0xf0, 0x94, 0x00, 0xcf,
+	0x5f, 0x84, 0xde, 0x62, 0x45, 0x07, 0xff, 0x65, 0x99, 0xc4, 0x84, 0x49, 0x60, 0xa1, 0x25, 0x5e,
+	0x0e, 0xf2, 0x06, 0x05, 0x99, 0xc7, 0x8b, 0x52, 0x90, 0x51, 0x37, 0x08, 0xb0, 0xbe, 0x8b, 0x40,
+	0x49, 0xce, 0x9f, 0xc6, 0x8b, 0xe2, 0x3a, 0xdd, 0x24, 0x4d, 0x5b, 0xc9, 0xb5, 0xca, 0xce, 0x41,
+	0xdf, 0xa4, 0xa0, 0x6f, 0xe0, 0x17, 0xc4, 0xf5, 0xdb, 0x5b, 0xbd, 0xfd, 0x8a, 0x8d, 0x98, 0x1e,
+	0x3d, 0xb0, 0x85, 0xa0, 0x57, 0xa1, 0x2f, 0xf4, 0x96, 0x47, 0xdc, 0xdd, 0xc4, 0x9f, 0x1a, 0x29,
+	0xd3, 0x89, 0xe5, 0x1c, 0xcc, 0x14, 0x05, 0x93, 0xc5, 0xa3, 0xb1, 0x79, 0x40, 0xa3, 0x6f, 0x78,
+	0x7e, 0x1f, 0xc1, 0x50, 0xf4, 0x55, 0x8c, 0xe8, 0x66, 0x09, 0x2f, 0x73, 0x94, 0x73, 0xe9, 0x4c,
+	0x5c, 0xfe, 0x8b, 0x54, 0xfe, 0x35, 0x9c, 0x0f, 0xcb, 0xa7, 0x41, 0x08, 0x06, 0x62, 0x97, 0xf1,
+	0xc7, 0xa6, 0x24, 0x7c, 0x08, 0xfd, 0xe1, 0x74, 0x74, 0x71, 0xd9, 0x96, 0x64, 0xb5, 0x2b, 0x33,
+	0xc9, 0x0c, 0x1c, 0xcb, 0x65, 0x8a, 0xe5, 0x1c, 0x56, 0xc3, 0x58, 0x58, 0x96, 0xb7, 0x6b, 0xb1,
+	0x54, 0xf2, 0xfc, 0x01, 0xfd, 0x3e, 0xc4, 0x5f, 0x42, 0x80, 0xe3, 0xf9, 0xe7, 0x58, 0xc8, 0xf7,
+	0x4a, 0xcc, 0x69, 0x57, 0x2e, 0x34, 0x63, 0xe3, 0x88, 0x2e, 0x51, 0x44, 0x73, 0x78, 0x36, 0x8c,
+	0x88, 0x02, 0xf1, 0x10, 0x31, 0x68, 0x7c, 0xdf, 0x5b, 0x83, 0xfe, 0x70, 0x43, 0xa2, 0x3d, 0x24,
+	0x39, 0xe8, 0xca, 0x4c, 0x32, 0x43, 0xda, 0x52, 0x2b, 0x4a, 0xc7, 0x7f, 0x8c, 0x60, 0x54, 0x9e,
+	0x2a, 0x8a, 0x2f, 0xc5, 0x7c, 0x2f, 0x29, 0x31, 0x53, 0xb9, 0xdc, 0x0a, 0x2b, 0x47, 0xb5, 0x48,
+	0x51, 0x5d, 0xc4, 0xe7, 0xe3, 0x2b, 0x97, 0xa1, 0xc5, 0x72, 0x24, 0xf1, 0x37, 0xe8, 0xeb, 0x4a,
+	0x79, 0x76, 0x25, 0x8e, 0x4c, 0x36, 0xa9, 0xd9, 0xa3, 0xca, 0x95, 0xd6, 0x98, 0x39, 0xcc, 0x3c,
+	0x85, 0x79, 0x09, 0x5f, 0x14, 0xa7, 0xa6, 0x64, 0xa0, 0xbf, 0x85, 0x00, 0xc7, 0x73, 0x24, 0x45,
+	0x8f, 0x4a, 0xcc, 0xb8, 0x54, 0x2e, 0x34, 0x63, 0x4b, 0xf3, 0xf1, 0x18, 0x98, 0xfc, 0x81, 0x69,
+	0x1c, 0xe2, 0x6f, 0x23, 0x18, 0x4b, 0x48, 0xf3, 0x17, 0xa7, 0xf4, 0xf4, 0xa7, 0x05, 0xca, 0x42,
+	0x4b, 0xbc, 0x1c, 0xe0, 0x6d, 0x0a, 0xf0, 0x65, 0xfc, 0xa2, 0xe8, 0x74, 0xa1, 0x84, 0xee, 0x7c,
+	0x10, 0x35, 0xcc, 0x1f, 0xc4, 0x22, 0x8b, 0x87, 0xf8, 0x9f, 0x10, 0x4c, 0xa6, 0x25, 0xf5, 0xe3,
+	0x7c, 0x32, 0x1c, 0xe9, 0x7b, 0x02, 0xe5, 0x6a, 0xeb, 0x15, 0xb8, 0x12, 0x2b, 0x54, 0x89, 0x5b,
+	0xf8, 0x66, 0xb2, 0x12, 0x91, 0x24, 0xfa, 0xfc, 0x41, 0x84, 0x70, 0x88, 0xdf, 0xa3, 0x4f, 0x5c,
+	0x92, 0xb2, 0xf7, 0xc5, 0x55, 0xaa, 0xe9, 0xeb, 0x01, 0x25, 0xd7, 0x2a, 0x7b, 0xda, 0x06, 0x51,
+	0x54, 0x21, 0xfc, 0xe2, 0x20, 0x7f, 0x20, 0x7b, 0x9b, 0x70, 0x88, 0x5d, 0x6f, 0x5a, 0x6a, 0x08,
+	0x8b, 0x4e, 0x4b, 0xb1, 0xf7, 0x01, 0xca, 0x4c, 0x32, 0x03, 0x47, 0x36, 0x4b, 0x91, 0x4d, 0xe0,
+	0xf1, 0x44, 0x64, 0xf8, 0xaf, 0xf8, 0x02, 0x2f, 0x4f, 0xa9, 0x8d, 0x2f, 0xf0, 0xa9, 0x29, 0xc1,
+	0x4a, 0xae, 0x55, 0x76, 0x0e, 0xf0, 0x1a, 0x05, 0xb8, 0x80, 0x2f, 0xc5, 0x16, 0xf8, 0xa4, 0x6c,
+	0x61, 0x6f, 0xb7, 0x39, 0x2a, 0xcf, 0xbd, 0x15, 0xa7, 0xd1, 0xd4, 0x4c, 0x60, 0xe5, 0x72, 0x2b,
+	0xac, 0x1c, 0xe4, 0x15, 0x0a, 0xf2, 0x02, 0x3e, 0x17, 0x06, 0xc9, 0x22, 0xca, 0x75, 0xcb, 0xf5,
+	0xae, 0xb0, 0xc2, 0x20, 0xde, 0x41, 0x30, 0x9e, 0x98, 0x59, 0x89, 0xe5, 0xa7, 0xa4, 0x84, 0x6c,
+	0x4e, 0x65, 0xb1, 0x45, 0xee, 0xb4, 0x18, 0x84, 0x2c, 0xc3, 0x31, 0x7f, 0x10, 0xb1, 0xea, 0x21,
+	0x7e, 0x1b, 0x41, 0x36, 0x29, 0x61, 0x52, 0x9c, 0xfc, 0x9b, 0xa4, 0x70, 0x2a, 0x57, 0x5a, 0x63,
+	0xe6, 0x98, 0xe7, 0x29, 0x66, 0x15, 0xcf, 0x34, 0xc3, 0x8c, 0xbf, 0x88, 0x60, 0x28, 0x9a, 0x40,
+	0x28, 0xee, 0xaf, 0x12, 0x72, 0x25, 0x95, 0x73, 0xe9, 0x4c, 0x1c, 0xc9, 0x39, 0x8a, 0x64, 0x0a,
+	0x4f, 0x0a, 0xdd, 0xcc, 0xb9, 0x83, 0x03, 0xfb, 0xdb, 0xa1, 0x9c, 0xcc, 0xd4, 0xcd, 0x7b, 0x7a,
+	0x22, 0xa1, 0xb2, 0xd0, 0x12, 0x2f, 0x87, 0xb6, 0x40, 0xa1, 0x9d, 0xc7, 0x73, 0x52, 0x68, 0x91,
+	0x23, 0xbd, 0x0b, 0xfd, 0xe1, 0xeb, 0x13, 0x71, 0x1e, 0x91, 0x5c, 0xb8, 0x28, 0x33, 0xc9, 0x0c,
+	0x69, 0xf3, 0x08, 0xbf, 0x53, 0x2f, 0x33, 0x29, 0x5f, 0x45, 0x70, 0x46, 0x9a, 0x5c, 0x85, 0xe7,
+	0x9b, 0x25, 0x3e, 0x05, 0x36, 0xb9, 0xd4, 0x02, 0x67, 0xda, 0x76, 0xcf, 0xf6, 0xab, 0x08, 0x11,
+	0xa4, 0x5f, 0x43, 0xde, 0x7d, 0x63, 0x24, 0x2f, 0x49, 0x8c, 0x30, 0x26, 0xe5, 0x46, 0x29, 0xe7,
+	0x9b, 0x70, 0xa5, 0xc5, 0x19, 0x1b, 0x68, 0x7c, 0xdf, 0xf9, 0x3a, 0x0a, 0xa5, 0x61, 0x45, 0x9d,
+	0x67, 0xa1, 0x85, 0x64, 0x1b, 0xf9, 0x06, 0xab, 0x59, 0x76, 0x90, 0x7c, 0x02, 0x6b, 0xc0, 0x8b,
+	0xf8, 0xcf, 0xbb, 0x62, 0x48, 0x48, 0xc8, 0xa9, 0x48, 0x0c, 0x09, 0xc9, 0xb2, 0x3f, 0x94, 0x2b,
+	0xad, 0x31, 0x73, 0x94, 0x4b, 0x14, 0xe5, 0x4d, 0xfc, 0x72, 0x0c, 0xa5, 0xe6, 0xa7, 0x5d, 0x34,
+	0x0b, 0x26, 0xbf, 0xd3, 0x08, 0x0b, 0x89, 0xb0, 0x2f, 0x4a, 0x43, 0xb7, 0x12, 0xc8, 0xf3, 0xcd,
+	0x19, 0x39, 0xdc, 0x75, 0x0a, 0x77, 0x05, 0x2f, 0xa5, 0xc0, 0x6d, 0x31, 0xfe, 0xfb, 0xef, 0xb1,
+	0xd0, 0x90, 0x88, 0x3e, 0x97, 0x16, 0xe9, 0x95, 0x28, 0x91, 0x6f, 0x99, 0x9f, 0xeb, 0xf2, 0x59,
+	0xaa, 0xcb, 0x13, 0xbc, 0x91, 0xa2, 0xcb, 0xb1, 0xa3, 0xc6, 0x4f, 0x01, 0x1a, 0x89, 0x18, 0xf8,
+	0xac, 0x3c, 0x83, 0xc3, 0x87, 0x3e, 0x95, 0x54, 0x9c, 0x76, 0x08, 0x0f, 0xe5, 0x96, 0xfc, 0x1e,
+	0x82, 0x8c, 0x2c, 0x09, 0x42, 0xf4, 0x80, 0x94, 0x3c, 0x0e, 0x65, 0xbe, 0x39, 0x63, 0xda, 0x01,
+	0xa1, 0xb1, 0xcd, 0xe6, 0xf3, 0x23, 0x4b, 0xbb, 0xa8, 0x00, 0x34, 0xb2, 0x1b, 0x44, 0x23, 0xc4,
+	0x72, 0x21, 0x94, 0xa9, 0xa4, 0xe2, 0xb4, 0xa0, 0x39, 0xbb, 0xbc, 0xd7, 0xbc, 0x9b, 0x55, 0xfc,
+	0x65, 0x04, 0x43, 0xd1, 0x4b, 0x7e, 0x71, 0xa9, 0x4c, 0x48, 0x41, 0x50, 0xce, 0xa5, 0x33, 0x71,
+	0x00, 0x2f, 0x50, 0x00, 0x8b, 0x78, 0x21, 0x01, 0x80, 0xec, 0xb4, 0xb1, 0xfc, 0xe4, 0xbb, 0x1f,
+	0x4c, 0xa1, 0xef, 0x7d, 0x30, 0x85, 0xfe, 0xe3, 0x83, 0x29, 0xf4, 0xdb, 0xcf, 0xa6, 0x4e, 0x7c,
+	0xef, 0xd9, 0xd4, 0x89, 0x7f, 0x79, 0x36, 0x75, 0xe2, 0xe7, 0x6e, 0x86, 0x2e, 0xef, 0x76, 0x49,
+	0xa9, 0xb4, 0xff, 0x4b, 0x75, 0xbf, 0xe1, 0x45, 0x66, 0xc5, 0xfc, 0x8e, 0x65, 0xd4, 0x2a, 0x24,
+	0x5f, 0x7f, 0x21, 0xbf, 0x17, 0xc8, 0xa4, 0xb7, 0x7a, 0x5b, 0x3d, 0xf4, 0xd9, 0xcf, 0x0b, 0xff,
+	0x3f, 0x00, 0x4f, 0xff, 0x4e, 0x58, 0x29, 0x55, 0x00, 0x00,
 }
 
-func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Module parameters query
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
+	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
+	// SignerSetDiff compares the current signer set to the latest signer set
+	// tx, as the end blocker does when deciding to create a new one
+	SignerSetDiff(ctx context.Context, in *SignerSetDiffRequest, opts ...grpc.CallOption) (*SignerSetDiffResponse, error)
+	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
+	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
+	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
+	// pending ethereum signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
+	// NextBatchPreview returns the batch a batch request for the token would
+	// create in this block, without creating it
+	NextBatchPreview(ctx context.Context, in *NextBatchPreviewRequest, opts ...grpc.CallOption) (*NextBatchPreviewResponse, error)
+	// Query for info about denoms tracked by gravity
+	ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error)
+	// DenomToERC20Params implements a query that allows ERC-20 parameter
+	// information to be retrieved by a Cosmos base denomination.
+	DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error)
+	// Query for info about denoms tracked by gravity
+	DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error)
+	// Query for batch send to ethereums
+	BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error)
+	// Query for unbatched send to ethereums
+	UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error)
+	// Query for a send to ethereum by id, whether it is in the pool or a batch
+	SendToEthereumByID(ctx context.Context, in *SendToEthereumByIDRequest, opts ...grpc.CallOption) (*SendToEthereumByIDResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error)
+	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
+	LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error)
+	// EventVoteDisagreements returns the event nonces validators submitted
+	// more than one distinct event for, with the competing events and the
+	// validators that voted for each of them
+	EventVoteDisagreements(ctx context.Context, in *EventVoteDisagreementsRequest, opts ...grpc.CallOption) (*EventVoteDisagreementsResponse, error)
+	// SignerSetAtEthereumHeight returns the observed signer set that controlled
+	// the bridge contract at an Ethereum height
+	SignerSetAtEthereumHeight(ctx context.Context, in *SignerSetAtEthereumHeightRequest, opts ...grpc.CallOption) (*SignerSetAtEthereumHeightResponse, error)
+	// ObservedSignerSetHistory returns the retained history of observed signer
+	// sets in Ethereum height order
+	ObservedSignerSetHistory(ctx context.Context, in *ObservedSignerSetHistoryRequest, opts ...grpc.CallOption) (*ObservedSignerSetHistoryResponse, error)
+	// Executed*Txs return the archived outgoing txs that were executed on
+	// Ethereum, with the details of their execution
+	ExecutedBatchTxs(ctx context.Context, in *ExecutedBatchTxsRequest, opts ...grpc.CallOption) (*ExecutedBatchTxsResponse, error)
+	ExecutedContractCallTxs(ctx context.Context, in *ExecutedContractCallTxsRequest, opts ...grpc.CallOption) (*ExecutedContractCallTxsResponse, error)
+	// BridgeHealth reports the values that tell whether the bridge is working,
+	// each with a severity computed from the bridge health thresholds param
+	BridgeHealth(ctx context.Context, in *BridgeHealthRequest, opts ...grpc.CallOption) (*BridgeHealthResponse, error)
+	// Relayable*Txs return the outgoing txs whose signatures carry enough power
+	// of the last observed signer set to be submitted to Gravity.sol
+	RelayableSignerSetTxs(ctx context.Context, in *RelayableSignerSetTxsRequest, opts ...grpc.CallOption) (*RelayableSignerSetTxsResponse, error)
+	RelayableBatchTxs(ctx context.Context, in *RelayableBatchTxsRequest, opts ...grpc.CallOption) (*RelayableBatchTxsResponse, error)
+	RelayableContractCallTxs(ctx context.Context, in *RelayableContractCallTxsRequest, opts ...grpc.CallOption) (*RelayableContractCallTxsResponse, error)
+	// *RelayCalldata return the ABI encoded Gravity.sol calldata that relays an
+	// outgoing tx with the signatures of the last observed signer set
+	SignerSetTxRelayCalldata(ctx context.Context, in *SignerSetTxRelayCalldataRequest, opts ...grpc.CallOption) (*SignerSetTxRelayCalldataResponse, error)
+	BatchTxRelayCalldata(ctx context.Context, in *BatchTxRelayCalldataRequest, opts ...grpc.CallOption) (*BatchTxRelayCalldataResponse, error)
+	ContractCallTxRelayCalldata(ctx context.Context, in *ContractCallTxRelayCalldataRequest, opts ...grpc.CallOption) (*ContractCallTxRelayCalldataResponse, error)
+	// Checkpoint returns the checkpoint of an outgoing tx that signers sign,
+	// along with the digest signatures are verified against and the fields
+	// the checkpoint encodes
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	ValidatorBridgeStats(ctx context.Context, in *ValidatorBridgeStatsRequest, opts ...grpc.CallOption) (*ValidatorBridgeStatsResponse, error)
+	RewardPool(ctx context.Context, in *RewardPoolRequest, opts ...grpc.CallOption) (*RewardPoolResponse, error)
+	ValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error)
 }
 
-func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LatestSignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
+	out := new(BatchTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
+	out := new(ContractCallTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
+	out := new(SignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerSetDiff(ctx context.Context, in *SignerSetDiffRequest, opts ...grpc.CallOption) (*SignerSetDiffResponse, error) {
+	out := new(SignerSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetDiff", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
+	out := new(BatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
+	out := new(ContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnbatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
+	out := new(SignerSetTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxConfirmations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnbatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *UnbatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
+	out := new(BatchTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnbatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
+	out := new(ContractCallTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxConfirmations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnbatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
+	out := new(UnsignedSignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnbatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SendToEthereums) > 0 {
-		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
+	out := new(UnsignedBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
+	out := new(UnsignedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedContractCallTxs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error) {
+	out := new(LastSubmittedEthereumEventResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastSubmittedEthereumEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
+	out := new(BatchTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NextBatchPreview(ctx context.Context, in *NextBatchPreviewRequest, opts ...grpc.CallOption) (*NextBatchPreviewResponse, error) {
+	out := new(NextBatchPreviewResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/NextBatchPreview", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error) {
+	out := new(ERC20ToDenomResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastObservedEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastObservedEthereumHeight != nil {
-		{
-			size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error) {
+	out := new(DenomToERC20ParamsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error) {
+	out := new(DenomToERC20Response)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error) {
+	out := new(BatchedSendToEthereumsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchedSendToEthereums", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error) {
+	out := new(UnbatchedSendToEthereumsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnbatchedSendToEthereums", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+func (c *queryClient) SendToEthereumByID(ctx context.Context, in *SendToEthereumByIDRequest, opts ...grpc.CallOption) (*SendToEthereumByIDResponse, error) {
+	out := new(SendToEthereumByIDResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SendToEthereumByID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LatestSignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
+	out := new(DelegateKeysByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		l = m.SignerSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error) {
+	out := new(DelegateKeysByEthereumSignerResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByEthereumSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
+func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
+	out := new(DelegateKeysByOrchestratorResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
+	out := new(DelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ContractCallTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+func (c *queryClient) LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error) {
+	out := new(LastObservedEthereumHeightResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastObservedEthereumHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ContractCallTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		l = m.LogicCall.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) EventVoteDisagreements(ctx context.Context, in *EventVoteDisagreementsRequest, opts ...grpc.CallOption) (*EventVoteDisagreementsResponse, error) {
+	out := new(EventVoteDisagreementsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/EventVoteDisagreements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+func (c *queryClient) SignerSetAtEthereumHeight(ctx context.Context, in *SignerSetAtEthereumHeightRequest, opts ...grpc.CallOption) (*SignerSetAtEthereumHeightResponse, error) {
+	out := new(SignerSetAtEthereumHeightResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetAtEthereumHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ObservedSignerSetHistory(ctx context.Context, in *ObservedSignerSetHistoryRequest, opts ...grpc.CallOption) (*ObservedSignerSetHistoryResponse, error) {
+	out := new(ObservedSignerSetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ObservedSignerSetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ExecutedBatchTxs(ctx context.Context, in *ExecutedBatchTxsRequest, opts ...grpc.CallOption) (*ExecutedBatchTxsResponse, error) {
+	out := new(ExecutedBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ExecutedBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ExecutedContractCallTxs(ctx context.Context, in *ExecutedContractCallTxsRequest, opts ...grpc.CallOption) (*ExecutedContractCallTxsResponse, error) {
+	out := new(ExecutedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ExecutedContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) BridgeHealth(ctx context.Context, in *BridgeHealthRequest, opts ...grpc.CallOption) (*BridgeHealthResponse, error) {
+	out := new(BridgeHealthResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RelayableSignerSetTxs(ctx context.Context, in *RelayableSignerSetTxsRequest, opts ...grpc.CallOption) (*RelayableSignerSetTxsResponse, error) {
+	out := new(RelayableSignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/RelayableSignerSetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RelayableBatchTxs(ctx context.Context, in *RelayableBatchTxsRequest, opts ...grpc.CallOption) (*RelayableBatchTxsResponse, error) {
+	out := new(RelayableBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/RelayableBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RelayableContractCallTxs(ctx context.Context, in *RelayableContractCallTxsRequest, opts ...grpc.CallOption) (*RelayableContractCallTxsResponse, error) {
+	out := new(RelayableContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/RelayableContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) SignerSetTxRelayCalldata(ctx context.Context, in *SignerSetTxRelayCalldataRequest, opts ...grpc.CallOption) (*SignerSetTxRelayCalldataResponse, error) {
+	out := new(SignerSetTxRelayCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxRelayCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) BatchTxRelayCalldata(ctx context.Context, in *BatchTxRelayCalldataRequest, opts ...grpc.CallOption) (*BatchTxRelayCalldataResponse, error) {
+	out := new(BatchTxRelayCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxRelayCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedBatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractCallTxRelayCalldata(ctx context.Context, in *ContractCallTxRelayCalldataRequest, opts ...grpc.CallOption) (*ContractCallTxRelayCalldataResponse, error) {
+	out := new(ContractCallTxRelayCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxRelayCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedBatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorBridgeStats(ctx context.Context, in *ValidatorBridgeStatsRequest, opts ...grpc.CallOption) (*ValidatorBridgeStatsResponse, error) {
+	out := new(ValidatorBridgeStatsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValidatorBridgeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RewardPool(ctx context.Context, in *RewardPoolRequest, opts ...grpc.CallOption) (*RewardPoolResponse, error) {
+	out := new(RewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsignedContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error) {
+	out := new(ValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Module parameters query
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
+	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
+	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
+	// SignerSetDiff compares the current signer set to the latest signer set
+	// tx, as the end blocker does when deciding to create a new one
+	SignerSetDiff(context.Context, *SignerSetDiffRequest) (*SignerSetDiffResponse, error)
+	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
+	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
+	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
+	// pending ethereum signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedEthereumEvent(context.Context, *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
+	// NextBatchPreview returns the batch a batch request for the token would
+	// create in this block, without creating it
+	NextBatchPreview(context.Context, *NextBatchPreviewRequest) (*NextBatchPreviewResponse, error)
+	// Query for info about denoms tracked by gravity
+	ERC20ToDenom(context.Context, *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error)
+	// DenomToERC20Params implements a query that allows ERC-20 parameter
+	// information to be retrieved by a Cosmos base denomination.
+	DenomToERC20Params(context.Context, *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error)
+	// Query for info about denoms tracked by gravity
+	DenomToERC20(context.Context, *DenomToERC20Request) (*DenomToERC20Response, error)
+	// Query for batch send to ethereums
+	BatchedSendToEthereums(context.Context, *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error)
+	// Query for unbatched send to ethereums
+	UnbatchedSendToEthereums(context.Context, *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error)
+	// Query for a send to ethereum by id, whether it is in the pool or a batch
+	SendToEthereumByID(context.Context, *SendToEthereumByIDRequest) (*SendToEthereumByIDResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
+	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
+	LastObservedEthereumHeight(context.Context, *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error)
+	// EventVoteDisagreements returns the event nonces validators submitted
+	// more than one distinct event for, with the competing events and the
+	// validators that voted for each of them
+	EventVoteDisagreements(context.Context, *EventVoteDisagreementsRequest) (*EventVoteDisagreementsResponse, error)
+	// SignerSetAtEthereumHeight returns the observed signer set that controlled
+	// the bridge contract at an Ethereum height
+	SignerSetAtEthereumHeight(context.Context, *SignerSetAtEthereumHeightRequest) (*SignerSetAtEthereumHeightResponse, error)
+	// ObservedSignerSetHistory returns the retained history of observed signer
+	// sets in Ethereum height order
+	ObservedSignerSetHistory(context.Context, *ObservedSignerSetHistoryRequest) (*ObservedSignerSetHistoryResponse, error)
+	// Executed*Txs return the archived outgoing txs that were executed on
+	// Ethereum, with the details of their execution
+	ExecutedBatchTxs(context.Context, *ExecutedBatchTxsRequest) (*ExecutedBatchTxsResponse, error)
+	ExecutedContractCallTxs(context.Context, *ExecutedContractCallTxsRequest) (*ExecutedContractCallTxsResponse, error)
+	// BridgeHealth reports the values that tell whether the bridge is working,
+	// each with a severity computed from the bridge health thresholds param
+	BridgeHealth(context.Context, *BridgeHealthRequest) (*BridgeHealthResponse, error)
+	// Relayable*Txs return the outgoing txs whose signatures carry enough power
+	// of the last observed signer set to be submitted to Gravity.sol
+	RelayableSignerSetTxs(context.Context, *RelayableSignerSetTxsRequest) (*RelayableSignerSetTxsResponse, error)
+	RelayableBatchTxs(context.Context, *RelayableBatchTxsRequest) (*RelayableBatchTxsResponse, error)
+	RelayableContractCallTxs(context.Context, *RelayableContractCallTxsRequest) (*RelayableContractCallTxsResponse, error)
+	// *RelayCalldata return the ABI encoded Gravity.sol calldata that relays an
+	// outgoing tx with the signatures of the last observed signer set
+	SignerSetTxRelayCalldata(context.Context, *SignerSetTxRelayCalldataRequest) (*SignerSetTxRelayCalldataResponse, error)
+	BatchTxRelayCalldata(context.Context, *BatchTxRelayCalldataRequest) (*BatchTxRelayCalldataResponse, error)
+	ContractCallTxRelayCalldata(context.Context, *ContractCallTxRelayCalldataRequest) (*ContractCallTxRelayCalldataResponse, error)
+	// Checkpoint returns the checkpoint of an outgoing tx that signers sign,
+	// along with the digest signatures are verified against and the fields
+	// the checkpoint encodes
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	ValidatorBridgeStats(context.Context, *ValidatorBridgeStatsRequest) (*ValidatorBridgeStatsResponse, error)
+	RewardPool(context.Context, *RewardPoolRequest) (*RewardPoolResponse, error)
+	ValidatorRewards(context.Context, *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error)
 }
 
-func (m *BatchTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
 }
-
-func (m *BatchTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
 }
-
-func (m *BatchTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
 }
-
-func (m *LastSubmittedEthereumEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
 }
-
-func (m *LastSubmittedEthereumEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovQuery(uint64(m.EventNonce))
-	}
-	return n
+func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
 }
-
-func (m *ERC20ToDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) SignerSetDiff(ctx context.Context, req *SignerSetDiffRequest) (*SignerSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetDiff not implemented")
 }
-
-func (m *ERC20ToDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CosmosOriginated {
-		n += 2
-	}
-	return n
+func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
 }
-
-func (m *DenomToERC20ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
 }
-
-func (m *DenomToERC20ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Erc20Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Erc20Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Erc20Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Erc20Decimals))
-	}
-	return n
+func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) LastSubmittedEthereumEvent(ctx context.Context, req *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedEthereumEvent not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+}
+func (*UnimplementedQueryServer) NextBatchPreview(ctx context.Context, req *NextBatchPreviewRequest) (*NextBatchPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBatchPreview not implemented")
+}
+func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
+}
+func (*UnimplementedQueryServer) DenomToERC20Params(ctx context.Context, req *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20Params not implemented")
+}
+func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *DenomToERC20Request) (*DenomToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
+}
+func (*UnimplementedQueryServer) BatchedSendToEthereums(ctx context.Context, req *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToEthereums not implemented")
+}
+func (*UnimplementedQueryServer) UnbatchedSendToEthereums(ctx context.Context, req *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToEthereums not implemented")
+}
+func (*UnimplementedQueryServer) SendToEthereumByID(ctx context.Context, req *SendToEthereumByIDRequest) (*SendToEthereumByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereumByID not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByEthereumSigner(ctx context.Context, req *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByEthereumSigner not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+}
+func (*UnimplementedQueryServer) LastObservedEthereumHeight(ctx context.Context, req *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastObservedEthereumHeight not implemented")
+}
+func (*UnimplementedQueryServer) EventVoteDisagreements(ctx context.Context, req *EventVoteDisagreementsRequest) (*EventVoteDisagreementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventVoteDisagreements not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetAtEthereumHeight(ctx context.Context, req *SignerSetAtEthereumHeightRequest) (*SignerSetAtEthereumHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetAtEthereumHeight not implemented")
+}
+func (*UnimplementedQueryServer) ObservedSignerSetHistory(ctx context.Context, req *ObservedSignerSetHistoryRequest) (*ObservedSignerSetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObservedSignerSetHistory not implemented")
+}
+func (*UnimplementedQueryServer) ExecutedBatchTxs(ctx context.Context, req *ExecutedBatchTxsRequest) (*ExecutedBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) ExecutedContractCallTxs(ctx context.Context, req *ExecutedContractCallTxsRequest) (*ExecutedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) BridgeHealth(ctx context.Context, req *BridgeHealthRequest) (*BridgeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeHealth not implemented")
+}
+func (*UnimplementedQueryServer) RelayableSignerSetTxs(ctx context.Context, req *RelayableSignerSetTxsRequest) (*RelayableSignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayableSignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) RelayableBatchTxs(ctx context.Context, req *RelayableBatchTxsRequest) (*RelayableBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayableBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) RelayableContractCallTxs(ctx context.Context, req *RelayableContractCallTxsRequest) (*RelayableContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayableContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxRelayCalldata(ctx context.Context, req *SignerSetTxRelayCalldataRequest) (*SignerSetTxRelayCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxRelayCalldata not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxRelayCalldata(ctx context.Context, req *BatchTxRelayCalldataRequest) (*BatchTxRelayCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxRelayCalldata not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxRelayCalldata(ctx context.Context, req *ContractCallTxRelayCalldataRequest) (*ContractCallTxRelayCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxRelayCalldata not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBridgeStats(ctx context.Context, req *ValidatorBridgeStatsRequest) (*ValidatorBridgeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBridgeStats not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *RewardPoolRequest) (*RewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewards(ctx context.Context, req *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
 }
 
-func (m *DenomToERC20Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *DenomToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.CosmosOriginated {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTx(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestSignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
 	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LatestSignerSetTx",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByEthereumSignerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTx(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTx(ctx, in)
 	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTx",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxs(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignerSetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetDiff(ctx, in)
 	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetDiff",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetDiff(ctx, req.(*SignerSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxs(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchedSendToEthereumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BatchedSendToEthereumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for _, e := range m.SendToEthereums {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnbatchedSendToEthereumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxConfirmations",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnbatchedSendToEthereumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedSignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for _, e := range m.SendToEthereums {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedSignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedBatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSubmittedEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastSubmittedEthereumEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastSubmittedEthereumEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, req.(*LastSubmittedEthereumEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextBatchPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextBatchPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextBatchPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/NextBatchPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextBatchPreview(ctx, req.(*NextBatchPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20ToDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20ToDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*ERC20ToDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomToERC20Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomToERC20ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToERC20Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DenomToERC20Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToERC20Params(ctx, req.(*DenomToERC20ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomToERC20Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DenomToERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToERC20(ctx, req.(*DenomToERC20Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchedSendToEthereumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchedSendToEthereums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchedSendToEthereums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchedSendToEthereums(ctx, req.(*BatchedSendToEthereumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbatchedSendToEthereumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnbatchedSendToEthereums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, req.(*UnbatchedSendToEthereumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendToEthereumByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToEthereumByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendToEthereumByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SendToEthereumByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendToEthereumByID(ctx, req.(*SendToEthereumByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByEthereumSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByEthereumSignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByEthereumSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, req.(*DelegateKeysByEthereumSignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByOrchestratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByOrchestrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastObservedEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastObservedEthereumHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastObservedEthereumHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastObservedEthereumHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastObservedEthereumHeight(ctx, req.(*LastObservedEthereumHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventVoteDisagreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventVoteDisagreementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventVoteDisagreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/EventVoteDisagreements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventVoteDisagreements(ctx, req.(*EventVoteDisagreementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetAtEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetAtEthereumHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetAtEthereumHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetAtEthereumHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetAtEthereumHeight(ctx, req.(*SignerSetAtEthereumHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObservedSignerSetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObservedSignerSetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObservedSignerSetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ObservedSignerSetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObservedSignerSetHistory(ctx, req.(*ObservedSignerSetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutedBatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutedBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ExecutedBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutedBatchTxs(ctx, req.(*ExecutedBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutedContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ExecutedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutedContractCallTxs(ctx, req.(*ExecutedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeHealth(ctx, req.(*BridgeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayableSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayableSignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayableSignerSetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/RelayableSignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayableSignerSetTxs(ctx, req.(*RelayableSignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayableBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayableBatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayableBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/RelayableBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayableBatchTxs(ctx, req.(*RelayableBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayableContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayableContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayableContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/RelayableContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayableContractCallTxs(ctx, req.(*RelayableContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxRelayCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxRelayCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxRelayCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxRelayCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxRelayCalldata(ctx, req.(*SignerSetTxRelayCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxRelayCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRelayCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxRelayCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxRelayCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxRelayCalldata(ctx, req.(*BatchTxRelayCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTxRelayCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxRelayCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxRelayCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxRelayCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxRelayCalldata(ctx, req.(*ContractCallTxRelayCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBridgeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorBridgeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBridgeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ValidatorBridgeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBridgeStats(ctx, req.(*ValidatorBridgeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*RewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*ValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SignerSetTx",
+			Handler:    _Query_SignerSetTx_Handler,
+		},
+		{
+			MethodName: "LatestSignerSetTx",
+			Handler:    _Query_LatestSignerSetTx_Handler,
+		},
+		{
+			MethodName: "BatchTx",
+			Handler:    _Query_BatchTx_Handler,
+		},
+		{
+			MethodName: "ContractCallTx",
+			Handler:    _Query_ContractCallTx_Handler,
+		},
+		{
+			MethodName: "SignerSetTxs",
+			Handler:    _Query_SignerSetTxs_Handler,
+		},
+		{
+			MethodName: "SignerSetDiff",
+			Handler:    _Query_SignerSetDiff_Handler,
+		},
+		{
+			MethodName: "BatchTxs",
+			Handler:    _Query_BatchTxs_Handler,
+		},
+		{
+			MethodName: "ContractCallTxs",
+			Handler:    _Query_ContractCallTxs_Handler,
+		},
+		{
+			MethodName: "SignerSetTxConfirmations",
+			Handler:    _Query_SignerSetTxConfirmations_Handler,
+		},
+		{
+			MethodName: "BatchTxConfirmations",
+			Handler:    _Query_BatchTxConfirmations_Handler,
+		},
+		{
+			MethodName: "ContractCallTxConfirmations",
+			Handler:    _Query_ContractCallTxConfirmations_Handler,
+		},
+		{
+			MethodName: "UnsignedSignerSetTxs",
+			Handler:    _Query_UnsignedSignerSetTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedBatchTxs",
+			Handler:    _Query_UnsignedBatchTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedContractCallTxs",
+			Handler:    _Query_UnsignedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "LastSubmittedEthereumEvent",
+			Handler:    _Query_LastSubmittedEthereumEvent_Handler,
+		},
+		{
+			MethodName: "BatchTxFees",
+			Handler:    _Query_BatchTxFees_Handler,
+		},
+		{
+			MethodName: "NextBatchPreview",
+			Handler:    _Query_NextBatchPreview_Handler,
+		},
+		{
+			MethodName: "ERC20ToDenom",
+			Handler:    _Query_ERC20ToDenom_Handler,
+		},
+		{
+			MethodName: "DenomToERC20Params",
+			Handler:    _Query_DenomToERC20Params_Handler,
+		},
+		{
+			MethodName: "DenomToERC20",
+			Handler:    _Query_DenomToERC20_Handler,
+		},
+		{
+			MethodName: "BatchedSendToEthereums",
+			Handler:    _Query_BatchedSendToEthereums_Handler,
+		},
+		{
+			MethodName: "UnbatchedSendToEthereums",
+			Handler:    _Query_UnbatchedSendToEthereums_Handler,
+		},
+		{
+			MethodName: "SendToEthereumByID",
+			Handler:    _Query_SendToEthereumByID_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByValidator",
+			Handler:    _Query_DelegateKeysByValidator_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByEthereumSigner",
+			Handler:    _Query_DelegateKeysByEthereumSigner_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByOrchestrator",
+			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
+		},
+		{
+			MethodName: "DelegateKeys",
+			Handler:    _Query_DelegateKeys_Handler,
+		},
+		{
+			MethodName: "LastObservedEthereumHeight",
+			Handler:    _Query_LastObservedEthereumHeight_Handler,
+		},
+		{
+			MethodName: "EventVoteDisagreements",
+			Handler:    _Query_EventVoteDisagreements_Handler,
+		},
+		{
+			MethodName: "SignerSetAtEthereumHeight",
+			Handler:    _Query_SignerSetAtEthereumHeight_Handler,
+		},
+		{
+			MethodName: "ObservedSignerSetHistory",
+			Handler:    _Query_ObservedSignerSetHistory_Handler,
+		},
+		{
+			MethodName: "ExecutedBatchTxs",
+			Handler:    _Query_ExecutedBatchTxs_Handler,
+		},
+		{
+			MethodName: "ExecutedContractCallTxs",
+			Handler:    _Query_ExecutedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "BridgeHealth",
+			Handler:    _Query_BridgeHealth_Handler,
+		},
+		{
+			MethodName: "RelayableSignerSetTxs",
+			Handler:    _Query_RelayableSignerSetTxs_Handler,
+		},
+		{
+			MethodName: "RelayableBatchTxs",
+			Handler:    _Query_RelayableBatchTxs_Handler,
+		},
+		{
+			MethodName: "RelayableContractCallTxs",
+			Handler:    _Query_RelayableContractCallTxs_Handler,
+		},
+		{
+			MethodName: "SignerSetTxRelayCalldata",
+			Handler:    _Query_SignerSetTxRelayCalldata_Handler,
+		},
+		{
+			MethodName: "BatchTxRelayCalldata",
+			Handler:    _Query_BatchTxRelayCalldata_Handler,
+		},
+		{
+			MethodName: "ContractCallTxRelayCalldata",
+			Handler:    _Query_ContractCallTxRelayCalldata_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Query_Checkpoint_Handler,
+		},
+		{
+			MethodName: "ValidatorBridgeStats",
+			Handler:    _Query_ValidatorBridgeStats_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		{
+			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UntilNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.PowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.PowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PowerDiff.Size()
+		i -= size
+		if _, err := m.PowerDiff.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LatestSignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestSignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerPowerChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerPowerChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerPowerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PowerDelta != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerDelta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UntilNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UntilNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NextBatchPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextBatchPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextBatchPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxElements != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxElements))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NextBatchPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextBatchPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextBatchPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WouldCreate {
+		i--
+		if m.WouldCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastBatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBatchNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SendToEthereumIds) > 0 {
+		dAtA22 := make([]byte, len(m.SendToEthereumIds)*10)
+		var j21 int
+		for _, num := range m.SendToEthereumIds {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintQuery(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedEthereumEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedEthereumEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedEthereumEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedEthereumEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20Symbol) > 0 {
+		i -= len(m.Erc20Symbol)
+		copy(dAtA[i:], m.Erc20Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20Name) > 0 {
+		i -= len(m.Erc20Name)
+		copy(dAtA[i:], m.Erc20Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SendToEthereums) > 0 {
+		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SendToEthereums) > 0 {
+		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendToEthereum != nil {
+		{
+			size, err := m.SendToEthereum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObservedEthereumHeight != nil {
+		{
+			size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDisagreementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDisagreementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDisagreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDisagreementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDisagreementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDisagreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Disagreements) > 0 {
+		for iNdEx := len(m.Disagreements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disagreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDisagreement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDisagreement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDisagreement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDisagreementRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDisagreementRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDisagreementRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetAtEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetAtEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetAtEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetAtEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetAtEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetAtEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObservedSignerSet != nil {
+		{
+			size, err := m.ObservedSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedSignerSetHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedSignerSetHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedSignerSetHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedSignerSetHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedSignerSetHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedSignerSetHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObservedSignerSets) > 0 {
+		for iNdEx := len(m.ObservedSignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedSignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UntilNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntilNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UntilNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SinceNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelaySignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelaySignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelaySignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		for iNdEx := len(m.S) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.S[iNdEx])
+			copy(dAtA[i:], m.S[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.S[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.R) > 0 {
+		for iNdEx := len(m.R) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.R[iNdEx])
+			copy(dAtA[i:], m.R[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.R[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.V) > 0 {
+		dAtA47 := make([]byte, len(m.V)*10)
+		var j46 int
+		for _, num := range m.V {
+			for num >= 1<<7 {
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j46++
+			}
+			dAtA47[j46] = uint8(num)
+			j46++
+		}
+		i -= j46
+		copy(dAtA[i:], dAtA47[:j46])
+		i = encodeVarintQuery(dAtA, i, uint64(j46))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableSignerSetTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableSignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableSignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableBatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableBatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableContractCallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Signatures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LogicCall != nil {
+		{
+			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentSignerSet != nil {
+		{
+			size, err := m.CurrentSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentSignerSet != nil {
+		{
+			size, err := m.CurrentSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayableContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayableContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayableContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentSignerSet != nil {
+		{
+			size, err := m.CurrentSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxRelayCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxRelayCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxRelayCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxRelayCalldataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxRelayCalldataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxRelayCalldataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxRelayCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxRelayCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxRelayCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxRelayCalldataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxRelayCalldataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxRelayCalldataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxRelayCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxRelayCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxRelayCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxRelayCalldataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxRelayCalldataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxRelayCalldataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SignatureDigest) > 0 {
+		i -= len(m.SignatureDigest)
+		copy(dAtA[i:], m.SignatureDigest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignatureDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBridgeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBridgeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBridgeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBridgeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBridgeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBridgeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextDistributionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextDistributionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalParticipation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalParticipation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolDepths) > 0 {
+		for iNdEx := len(m.PoolDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.LatestSignerSetSignedPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.OldestOutgoingTxs) > 0 {
+		for iNdEx := len(m.OldestOutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldestOutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.EthereumHeightLag.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EventStall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Severity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxAgeHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxAgeHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxAgeHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AgeBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StoreIndex) > 0 {
+		i -= len(m.StoreIndex)
+		copy(dAtA[i:], m.StoreIndex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetSignedPowerHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetSignedPowerHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetSignedPowerHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SignedPower.Size()
+		i -= size
+		if _, err := m.SignedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDepthHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDepthHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDepthHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *LatestSignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignerSetTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *BatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *ContractCallTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		l = m.LogicCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.UntilNonce != 0 {
+		n += 1 + sovQuery(uint64(m.UntilNonce))
+	}
+	return n
+}
+
+func (m *SignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignerSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestSignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LatestSignerSetNonce))
+	}
+	l = m.PowerDiff.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PowerDiffThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerPowerChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestPower != 0 {
+		n += 1 + sovQuery(uint64(m.LatestPower))
+	}
+	if m.CurrentPower != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPower))
+	}
+	if m.PowerDelta != 0 {
+		n += 1 + sovQuery(uint64(m.PowerDelta))
+	}
+	if m.Added {
+		n += 2
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.UntilNonce != 0 {
+		n += 1 + sovQuery(uint64(m.UntilNonce))
+	}
+	return n
+}
+
+func (m *BatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.UntilNonce != 0 {
+		n += 1 + sovQuery(uint64(m.UntilNonce))
+	}
+	return n
+}
+
+func (m *ContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NextBatchPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxElements != 0 {
+		n += 1 + sovQuery(uint64(m.MaxElements))
+	}
+	return n
+}
+
+func (m *NextBatchPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SendToEthereumIds) > 0 {
+		l = 0
+		for _, e := range m.SendToEthereumIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastBatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastBatchNonce))
+	}
+	if m.WouldCreate {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastSubmittedEthereumEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastSubmittedEthereumEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *ERC20ToDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20ToDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomToERC20ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToERC20ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Erc20Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Erc20Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Erc20Decimals))
+	}
+	return n
+}
+
+func (m *DenomToERC20Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchedSendToEthereumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchedSendToEthereumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToEthereums) > 0 {
+		for _, e := range m.SendToEthereums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbatchedSendToEthereumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbatchedSendToEthereumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToEthereums) > 0 {
+		for _, e := range m.SendToEthereums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SendToEthereumByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *SendToEthereumByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendToEthereum != nil {
+		l = m.SendToEthereum.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastObservedEthereumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObservedEthereumHeight != nil {
+		l = m.LastObservedEthereumHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVoteDisagreementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVoteDisagreementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Disagreements) > 0 {
+		for _, e := range m.Disagreements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVoteDisagreement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventVoteDisagreementRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *EventVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *SignerSetAtEthereumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *SignerSetAtEthereumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObservedSignerSet != nil {
+		l = m.ObservedSignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ObservedSignerSetHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ObservedSignerSetHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObservedSignerSets) > 0 {
+		for _, e := range m.ObservedSignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.UntilNonce != 0 {
+		n += 1 + sovQuery(uint64(m.UntilNonce))
+	}
+	return n
+}
+
+func (m *ExecutedBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SinceNonce))
+	}
+	if m.UntilNonce != 0 {
+		n += 1 + sovQuery(uint64(m.UntilNonce))
+	}
+	return n
+}
+
+func (m *ExecutedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelaySignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.V) > 0 {
+		l = 0
+		for _, e := range m.V {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.R) > 0 {
+		for _, b := range m.R {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.S) > 0 {
+		for _, b := range m.S {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayableSignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Signatures.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RelayableBatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Signatures.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RelayableContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		l = m.LogicCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Signatures.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RelayableSignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayableSignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentSignerSet != nil {
+		l = m.CurrentSignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayableBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayableBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentSignerSet != nil {
+		l = m.CurrentSignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayableContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayableContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentSignerSet != nil {
+		l = m.CurrentSignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxRelayCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *SignerSetTxRelayCalldataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxRelayCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *BatchTxRelayCalldataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxRelayCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *ContractCallTxRelayCalldataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *CheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SignatureDigest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorBridgeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorBridgeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalParticipation != 0 {
+		n += 1 + sovQuery(uint64(m.TotalParticipation))
+	}
+	if m.NextDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextDistributionHeight))
+	}
+	return n
+}
+
+func (m *ValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BridgeHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		n += 1 + sovQuery(uint64(m.Severity))
+	}
+	l = m.EventStall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EthereumHeightLag.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.OldestOutgoingTxs) > 0 {
+		for _, e := range m.OldestOutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.LatestSignerSetSignedPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolDepths) > 0 {
+		for _, e := range m.PoolDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeightHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovQuery(uint64(m.Severity))
+	}
+	return n
+}
+
+func (m *OutgoingTxAgeHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StoreIndex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AgeBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AgeBlocks))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovQuery(uint64(m.Severity))
+	}
+	return n
+}
+
+func (m *SignerSetSignedPowerHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	l = m.SignedPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Severity != 0 {
+		n += 1 + sovQuery(uint64(m.Severity))
+	}
+	return n
+}
+
+func (m *PoolDepthHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Severity != 0 {
+		n += 1 + sovQuery(uint64(m.Severity))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestSignerSetTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestSignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSetTx{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &BatchTx{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogicCall == nil {
+				m.LogicCall = &ContractCallTx{}
+			}
+			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignerSetTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilNonce", wireType)
+			}
+			m.UntilNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetNonce", wireType)
+			}
+			m.LatestSignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiffThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, SignerPowerChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerPowerChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerPowerChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerPowerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPower", wireType)
+			}
+			m.LatestPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPower", wireType)
+			}
+			m.CurrentPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDelta", wireType)
+			}
+			m.PowerDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilNonce", wireType)
+			}
+			m.UntilNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &BatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilNonce", wireType)
+			}
+			m.UntilNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &ContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedBatchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedBatchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &BatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &ContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextBatchPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextBatchPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextBatchPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElements", wireType)
+			}
+			m.MaxElements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxElements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextBatchPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextBatchPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextBatchPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SendToEthereumIds = append(m.SendToEthereumIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SendToEthereumIds) == 0 {
+					m.SendToEthereumIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SendToEthereumIds = append(m.SendToEthereumIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchNonce", wireType)
+			}
+			m.LastBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WouldCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WouldCreate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &ContractCallTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &BatchTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+			}
+			m.Erc20Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
+			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
+			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SendToEthereum == nil {
+				m.SendToEthereum = &SendToEthereum{}
+			}
+			if err := m.SendToEthereum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedEthereumHeight == nil {
+				m.LastObservedEthereumHeight = &LatestEthereumBlockHeight{}
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVoteDisagreementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVoteDisagreementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVoteDisagreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVoteDisagreementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVoteDisagreementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVoteDisagreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disagreements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disagreements = append(m.Disagreements, EventVoteDisagreement{})
+			if err := m.Disagreements[len(m.Disagreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *LastObservedEthereumHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *LastObservedEthereumHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastObservedEthereumHeight != nil {
-		l = m.LastObservedEthereumHeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *EventVoteDisagreement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6099,12 +20567,65 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVoteDisagreement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVoteDisagreement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, EventVoteDisagreementRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6126,7 +20647,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *EventVoteDisagreementRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6145,19 +20666,109 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVoteDisagreementRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVoteDisagreementRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventHash = append(m.EventHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventHash == nil {
+				m.EventHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6184,10 +20795,30 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voters = append(m.Voters, EventVoter{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6209,7 +20840,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
+func (m *EventVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6232,17 +20863,17 @@ func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.SignerSetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6252,61 +20883,43 @@ func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LatestSignerSetTxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestSignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6328,7 +20941,7 @@ func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
+func (m *SignerSetAtEthereumHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6351,17 +20964,17 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetAtEthereumHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetAtEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			var msglen int
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6371,28 +20984,11 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SignerSet == nil {
-				m.SignerSet = &SignerSetTx{}
-			}
-			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6414,7 +21010,7 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
+func (m *SignerSetAtEthereumHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6437,17 +21033,17 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetAtEthereumHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetAtEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSignerSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6457,43 +21053,28 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			if m.ObservedSignerSet == nil {
+				m.ObservedSignerSet = &ObservedSignerSet{}
 			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ObservedSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6515,7 +21096,7 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
+func (m *ObservedSignerSetHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6538,15 +21119,15 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObservedSignerSetHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObservedSignerSetHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6573,10 +21154,10 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &BatchTx{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6601,7 +21182,7 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
+func (m *ObservedSignerSetHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6624,17 +21205,17 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObservedSignerSetHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObservedSignerSetHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSignerSets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6644,31 +21225,31 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			m.ObservedSignerSets = append(m.ObservedSignerSets, ObservedSignerSet{})
+			if err := m.ObservedSignerSets[len(m.ObservedSignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.InvalidationNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6678,11 +21259,28 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6704,7 +21302,7 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6727,17 +21325,53 @@ func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedBatchTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6747,28 +21381,62 @@ func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogicCall == nil {
-				m.LogicCall = &ContractCallTx{}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
 			}
-			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilNonce", wireType)
+			}
+			m.UntilNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6790,7 +21458,7 @@ func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecutedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6813,17 +21481,17 @@ func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedBatchTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			m.SignerSetNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6833,64 +21501,29 @@ func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Batches = append(m.Batches, ExecutedBatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6917,8 +21550,10 @@ func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &SignerSetTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6943,7 +21578,7 @@ func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecutedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6966,10 +21601,10 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedContractCallTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7008,6 +21643,78 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceNonce", wireType)
+			}
+			m.SinceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilNonce", wireType)
+			}
+			m.UntilNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntilNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7029,7 +21736,7 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7052,15 +21759,15 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedContractCallTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7087,8 +21794,8 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
-			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Calls = append(m.Calls, ExecutedContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7149,7 +21856,7 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *RelaySignatures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7172,17 +21879,93 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelaySignatures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelaySignatures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.V = append(m.V, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.V) == 0 {
+					m.V = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.V = append(m.V, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7192,27 +21975,55 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.R = append(m.R, make([]byte, postIndex-iNdEx))
+			copy(m.R[len(m.R)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.S = append(m.S, make([]byte, postIndex-iNdEx))
+			copy(m.S[len(m.S)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7235,7 +22046,7 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayableSignerSetTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7258,15 +22069,15 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableSignerSetTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableSignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7293,14 +22104,16 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &BatchTx{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSetTx{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7327,10 +22140,7 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7355,7 +22165,7 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *RelayableBatchTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7378,15 +22188,15 @@ func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableBatchTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7413,10 +22223,43 @@ func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Batch == nil {
+				m.Batch = &BatchTx{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7441,7 +22284,7 @@ func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayableContractCallTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7464,15 +22307,15 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableContractCallTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7499,14 +22342,16 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calls = append(m.Calls, &ContractCallTx{})
-			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LogicCall == nil {
+				m.LogicCall = &ContractCallTx{}
+			}
+			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7533,10 +22378,7 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Signatures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7561,7 +22403,7 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *RelayableSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7584,17 +22426,17 @@ func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableSignerSetTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableSignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7604,23 +22446,27 @@ func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7643,7 +22489,7 @@ func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayableSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7666,15 +22512,15 @@ func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableSignerSetTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableSignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSignerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7701,66 +22547,52 @@ func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
-			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CurrentSignerSet == nil {
+				m.CurrentSignerSet = &SignerSetTx{}
+			}
+			if err := m.CurrentSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SignerSets = append(m.SignerSets, RelayableSignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedBatchTxsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7770,23 +22602,27 @@ func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7809,7 +22645,7 @@ func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayableBatchTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7832,15 +22668,15 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedBatchTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableBatchTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7867,8 +22703,10 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &BatchTx{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7893,7 +22731,7 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *RelayableBatchTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7916,17 +22754,17 @@ func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableBatchTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSignerSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7936,77 +22774,31 @@ func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.CurrentSignerSet == nil {
+				m.CurrentSignerSet = &SignerSetTx{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CurrentSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8033,61 +22825,47 @@ func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calls = append(m.Calls, &ContractCallTx{})
-			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Batches = append(m.Batches, RelayableBatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchTxFeesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxFeesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8109,7 +22887,7 @@ func (m *BatchTxFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayableContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8132,15 +22910,15 @@ func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableContractCallTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8167,8 +22945,10 @@ func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, types.Coin{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8193,7 +22973,7 @@ func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+func (m *RelayableContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8216,17 +22996,17 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayableContractCallTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayableContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSignerSet", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8236,31 +23016,33 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			if m.CurrentSignerSet == nil {
+				m.CurrentSignerSet = &SignerSetTx{}
+			}
+			if err := m.CurrentSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			m.InvalidationNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8270,64 +23052,29 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Calls = append(m.Calls, RelayableContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8354,8 +23101,10 @@ func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &ContractCallTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8380,7 +23129,7 @@ func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxRelayCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8403,36 +23152,17 @@ func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxRelayCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxRelayCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			var stringLen uint64
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8442,24 +23172,11 @@ func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8481,7 +23198,7 @@ func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxRelayCalldataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8504,17 +23221,49 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxRelayCalldataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxRelayCalldataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8524,24 +23273,24 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &BatchTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8565,7 +23314,7 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchTxRelayCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8588,15 +23337,15 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxRelayCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxRelayCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8624,8 +23373,27 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8647,7 +23415,7 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTxRelayCalldataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8670,17 +23438,17 @@ func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxRelayCalldataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxRelayCalldataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8690,11 +23458,58 @@ func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8716,7 +23531,7 @@ func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTxRelayCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8739,17 +23554,17 @@ func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTxRelayCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTxRelayCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8759,24 +23574,45 @@ func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8798,7 +23634,7 @@ func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTxRelayCalldataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8821,15 +23657,15 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTxRelayCalldataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTxRelayCalldataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8857,13 +23693,13 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8873,12 +23709,26 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CosmosOriginated = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8900,7 +23750,7 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8923,15 +23773,34 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8959,8 +23828,80 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8982,7 +23923,7 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9005,15 +23946,15 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9041,13 +23982,13 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.GravityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9057,29 +23998,31 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Name = string(dAtA[iNdEx:postIndex])
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDigest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9089,29 +24032,31 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
+			m.SignatureDigest = append(m.SignatureDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignatureDigest == nil {
+				m.SignatureDigest = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			m.Erc20Decimals = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9121,11 +24066,26 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, CheckpointField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9147,7 +24107,7 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
+func (m *CheckpointField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9170,15 +24130,15 @@ func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9206,61 +24166,11 @@ func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9288,13 +24198,13 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9304,12 +24214,24 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CosmosOriginated = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9331,7 +24253,7 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBridgeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9354,17 +24276,17 @@ func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBridgeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBridgeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9374,23 +24296,27 @@ func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9413,7 +24339,7 @@ func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBridgeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9436,17 +24362,17 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBridgeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBridgeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9456,29 +24382,31 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.Stats = append(m.Stats, ValidatorBridgeStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9488,23 +24416,60 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9527,7 +24492,7 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
+func (m *RewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9550,44 +24515,12 @@ func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9609,7 +24542,7 @@ func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9632,17 +24565,17 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9652,29 +24585,31 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalParticipation", wireType)
 			}
-			var stringLen uint64
+			m.TotalParticipation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9684,24 +24619,30 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalParticipation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NextDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9723,7 +24664,7 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9746,15 +24687,15 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9782,7 +24723,7 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9805,7 +24746,7 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9828,17 +24769,17 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9848,29 +24789,30 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9880,23 +24822,25 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9919,7 +24863,7 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9942,10 +24886,10 @@ func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9969,7 +24913,7 @@ func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9992,15 +24936,34 @@ func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= HealthSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStall", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10027,66 +24990,48 @@ func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventStall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightLag", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EthereumHeightLag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOutgoingTxs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10096,77 +25041,62 @@ func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.OldestOutgoingTxs = append(m.OldestOutgoingTxs, OutgoingTxAgeHealth{})
+			if err := m.OldestOutgoingTxs[len(m.OldestOutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetSignedPower", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LatestSignerSetSignedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDepths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10193,8 +25123,8 @@ func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
-			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolDepths = append(m.PoolDepths, PoolDepthHealth{})
+			if err := m.PoolDepths[len(m.PoolDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10219,7 +25149,7 @@ func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+func (m *HeightHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10242,17 +25172,17 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeightHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeightHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var stringLen uint64
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10262,29 +25192,16 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Blocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
 			}
-			var msglen int
+			m.Severity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10294,28 +25211,11 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Severity |= HealthSeverity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10337,7 +25237,7 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+func (m *OutgoingTxAgeHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10360,17 +25260,17 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingTxAgeHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingTxAgeHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10380,31 +25280,29 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
-			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreIndex", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10414,28 +25312,64 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StoreIndex = append(m.StoreIndex[:0], dAtA[iNdEx:postIndex]...)
+			if m.StoreIndex == nil {
+				m.StoreIndex = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= HealthSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10457,7 +25391,7 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *SignerSetSignedPowerHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10480,12 +25414,83 @@ func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetSignedPowerHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetSignedPowerHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= HealthSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10507,7 +25512,7 @@ func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolDepthHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10530,17 +25535,17 @@ func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolDepthHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolDepthHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10550,28 +25555,96 @@ func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastObservedEthereumHeight == nil {
-				m.LastObservedEthereumHeight = &LatestEthereumBlockHeight{}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= HealthSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// The relay calldata is what a relayer sends to Gravity.sol to submit an
+// outgoing tx. The current signer set must be the one Gravity.sol last saw,
+// with its signers in the order the signatures are aligned to.
+
+// UpdateValsetCalldata returns the Gravity.sol updateValset calldata that
+// moves the bridge from the current signer set to the new one
+func UpdateValsetCalldata(newSignerSet, current *SignerSetTx, signatures RelaySignatures) ([]byte, error) {
+	sigs, err := abiEncodedValSignatures(current, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new signer set is checkpointed with its signers sorted
+	sorted := *newSignerSet
+	sorted.Signers = append(EthereumSigners(nil), newSignerSet.Signers...)
+	sorted.Signers.Sort()
+
+	return packRelayCall("updateValset", sorted.abiEncodedValsetArgs(), current.abiEncodedValsetArgs(), sigs)
+}
+
+// SubmitBatchCalldata returns the Gravity.sol submitBatch calldata for the batch
+func SubmitBatchCalldata(batch *BatchTx, current *SignerSetTx, signatures RelaySignatures) ([]byte, error) {
+	sigs, err := abiEncodedValSignatures(current, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts, destinations, fees := batch.abiEncodedTransactions()
+	return packRelayCall("submitBatch",
+		current.abiEncodedValsetArgs(),
+		sigs,
+		amounts,
+		destinations,
+		fees,
+		new(big.Int).SetUint64(batch.BatchNonce),
+		gethcommon.HexToAddress(batch.TokenContract),
+		new(big.Int).SetUint64(batch.Timeout),
+	)
+}
+
+// SubmitLogicCallCalldata returns the Gravity.sol submitLogicCall calldata for the contract call
+func SubmitLogicCallCalldata(call *ContractCallTx, current *SignerSetTx, signatures RelaySignatures) ([]byte, error) {
+	sigs, err := abiEncodedValSignatures(current, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return packRelayCall("submitLogicCall", current.abiEncodedValsetArgs(), sigs, call.abiEncodedLogicCallArgs())
+}
+
+// abiEncodedValSignatures returns the signatures as Gravity.sol ValSignatures,
+// checking they are aligned to the signers of the current signer set
+func abiEncodedValSignatures(current *SignerSetTx, signatures RelaySignatures) ([]abiEncodedValSignature, error) {
+	n := len(current.Signers)
+	if len(signatures.V) != n || len(signatures.R) != n || len(signatures.S) != n {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "%d signatures for %d signers", len(signatures.V), n)
+	}
+
+	sigs := make([]abiEncodedValSignature, n)
+	for i := range sigs {
+		if len(signatures.R[i]) > 32 || len(signatures.S[i]) > 32 || signatures.V[i] > 255 {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "malformed signature of signer %s", current.Signers[i].EthereumAddress)
+		}
+		sigs[i].V = uint8(signatures.V[i])
+		copy(sigs[i].R[32-len(signatures.R[i]):], signatures.R[i])
+		copy(sigs[i].S[32-len(signatures.S[i]):], signatures.S[i])
+	}
+
+	return sigs, nil
+}
+
+func packRelayCall(method string, args ...interface{}) ([]byte, error) {
+	relayABI, err := abi.JSON(strings.NewReader(GravityRelayABIJSON))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+	}
+
+	calldata, err := relayABI.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "packing %s: %s", method, err)
+	}
+	return calldata, nil
+}
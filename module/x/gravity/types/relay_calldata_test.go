@@ -0,0 +1,104 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayCalldata(t *testing.T) {
+	relayABI, err := abi.JSON(strings.NewReader(GravityRelayABIJSON))
+	require.NoError(t, err)
+
+	var (
+		erc20Addr = gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+		current   = &SignerSetTx{Nonce: 1, Signers: EthereumSigners{
+			{Power: 3000000000, EthereumAddress: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39"},
+			{Power: 1294967295, EthereumAddress: "0x17c1736CcF692F653c433d7aa2aB45148C016F68"},
+		}}
+		signatures = RelaySignatures{
+			V: []uint32{27, 0},
+			R: [][]byte{crypto.Keccak256([]byte("r")), nil},
+			S: [][]byte{crypto.Keccak256([]byte("s")), nil},
+		}
+	)
+
+	unpack := func(method string, calldata []byte) []interface{} {
+		require.Equal(t, relayABI.Methods[method].ID, calldata[:4])
+		args, err := relayABI.Methods[method].Inputs.Unpack(calldata[4:])
+		require.NoError(t, err)
+		return args
+	}
+	requireSignatures := func(arg interface{}) {
+		sigs := arg.([]struct {
+			V uint8    `json:"v"`
+			R [32]byte `json:"r"`
+			S [32]byte `json:"s"`
+		})
+		require.Len(t, sigs, 2)
+		require.Equal(t, uint8(27), sigs[0].V)
+		require.Equal(t, signatures.R[0], sigs[0].R[:])
+		require.Equal(t, signatures.S[0], sigs[0].S[:])
+		require.Zero(t, sigs[1].V)
+	}
+
+	// the new signer set is encoded with its signers sorted
+	newSignerSet := &SignerSetTx{Nonce: 2, Signers: EthereumSigners{current.Signers[1], current.Signers[0]}}
+	calldata, err := UpdateValsetCalldata(newSignerSet, current, signatures)
+	require.NoError(t, err)
+	args := unpack("updateValset", calldata)
+	newValset := *abi.ConvertType(args[0], new(ABIEncodedValsetArgs)).(*ABIEncodedValsetArgs)
+	require.Equal(t, big.NewInt(2), newValset.Nonce)
+	require.Equal(t, []gethcommon.Address{
+		gethcommon.HexToAddress(current.Signers[0].EthereumAddress),
+		gethcommon.HexToAddress(current.Signers[1].EthereumAddress),
+	}, newValset.Validators)
+	requireSignatures(args[2])
+
+	batch := &BatchTx{
+		BatchNonce:    7,
+		Timeout:       2111,
+		TokenContract: erc20Addr.Hex(),
+		Transactions: []*SendToEthereum{{
+			Id:                1,
+			EthereumRecipient: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39",
+			Erc20Token:        NewSDKIntERC20Token(sdk.NewInt(100), erc20Addr),
+			Erc20Fee:          NewSDKIntERC20Token(sdk.NewInt(3), erc20Addr),
+		}},
+	}
+	calldata, err = SubmitBatchCalldata(batch, current, signatures)
+	require.NoError(t, err)
+	args = unpack("submitBatch", calldata)
+	requireSignatures(args[1])
+	require.Equal(t, []*big.Int{big.NewInt(100)}, args[2])
+	require.Equal(t, []gethcommon.Address{gethcommon.HexToAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")}, args[3])
+	require.Equal(t, []*big.Int{big.NewInt(3)}, args[4])
+	require.Equal(t, big.NewInt(7), args[5])
+	require.Equal(t, erc20Addr, args[6])
+	require.Equal(t, big.NewInt(2111), args[7])
+
+	call := &ContractCallTx{
+		InvalidationScope: []byte("scope"),
+		InvalidationNonce: 4,
+		Address:           "0x17c1736CcF692F653c433d7aa2aB45148C016F68",
+		Payload:           []byte("payload"),
+		Timeout:           4766922941000,
+		Tokens:            []ERC20Token{NewSDKIntERC20Token(sdk.NewInt(1), erc20Addr)},
+	}
+	calldata, err = SubmitLogicCallCalldata(call, current, signatures)
+	require.NoError(t, err)
+	args = unpack("submitLogicCall", calldata)
+	requireSignatures(args[1])
+	callArgs := *abi.ConvertType(args[2], new(abiEncodedLogicCallArgs)).(*abiEncodedLogicCallArgs)
+	require.Equal(t, call.abiEncodedLogicCallArgs(), callArgs)
+
+	// signatures must line up with the current signers
+	_, err = SubmitBatchCalldata(batch, current, RelaySignatures{V: []uint32{27}, R: [][]byte{nil}, S: [][]byte{nil}})
+	require.Error(t, err)
+}
@@ -88,6 +88,36 @@ func (b EthereumSigners) PowerDiff(c EthereumSigners) float64 {
 	return math.Abs(float64(delta) / float64(math.MaxUint32))
 }
 
+// PowerChanges returns the signers whose power differs between the latest
+// signer set c and b, in Ethereum address order
+func (b EthereumSigners) PowerChanges(c EthereumSigners) []SignerPowerChange {
+	changes := map[string]*SignerPowerChange{}
+	for _, es := range c {
+		changes[es.EthereumAddress] = &SignerPowerChange{EthereumAddress: es.EthereumAddress, LatestPower: es.Power, Removed: true}
+	}
+	for _, bv := range b {
+		change, ok := changes[bv.EthereumAddress]
+		if !ok {
+			change = &SignerPowerChange{EthereumAddress: bv.EthereumAddress, Added: true}
+			changes[bv.EthereumAddress] = change
+		}
+		change.CurrentPower = bv.Power
+		change.Removed = false
+	}
+
+	var out []SignerPowerChange
+	for _, change := range changes {
+		change.PowerDelta = int64(change.CurrentPower) - int64(change.LatestPower)
+		if change.PowerDelta != 0 || change.Added || change.Removed {
+			out = append(out, *change)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return EthereumAddrLessThan(out[i].EthereumAddress, out[j].EthereumAddress)
+	})
+	return out
+}
+
 func absInt(x int64) int64 {
 	if x < 0 {
 		x = -x